@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/devfile/alizer/pkg/apis/model"
 	"github.com/devfile/alizer/pkg/apis/recognizer"
@@ -30,11 +31,17 @@ func NewAlizerClient(registryClient registry.Client) *Alizer {
 // DetectFramework uses the alizer library in order to detect the devfile
 // to use depending on the files in the path
 func (o *Alizer) DetectFramework(ctx context.Context, path string) (DetectedFramework, error) {
-	types := []model.DevfileType{}
 	components, err := o.registryClient.ListDevfileStacks(ctx, "", "", "", false, false)
 	if err != nil {
 		return DetectedFramework{}, err
 	}
+	return detectFrameworkFromStacks(path, components)
+}
+
+// detectFrameworkFromStacks selects the devfile stack matching the files in path
+// from an already fetched list of devfile stacks
+func detectFrameworkFromStacks(path string, components registry.DevfileStackList) (DetectedFramework, error) {
+	types := make([]model.DevfileType, 0, len(components.Items))
 	for _, component := range components.Items {
 		types = append(types, model.DevfileType{
 			Name:        component.Name,
@@ -144,6 +151,50 @@ func (o *Alizer) DetectPorts(path string) ([]int, error) {
 	return components[0].Ports, nil
 }
 
+// DetectComponents uses the alizer library to find all the component roots under path
+// (for example the services of a monorepo) and detects the devfile, the name and the ports
+// to use for each of them.
+// Component roots for which no devfile can be determined are ignored.
+func (o *Alizer) DetectComponents(ctx context.Context, path string) ([]api.DetectionResult, error) {
+	components, err := recognizer.DetectComponents(path)
+	if err != nil {
+		return nil, err
+	}
+	klog.V(4).Infof("Found components: %v", components)
+	if len(components) == 0 {
+		return nil, nil
+	}
+
+	stacks, err := o.registryClient.ListDevfileStacks(ctx, "", "", "", false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.DetectionResult, 0, len(components))
+	for _, component := range components {
+		detected, err := detectFrameworkFromStacks(component.Path, stacks)
+		if err != nil {
+			klog.V(2).Infof("unable to determine a devfile for component at path %q: %v", component.Path, err)
+			continue
+		}
+
+		name := util.GetDNS1123Name(component.Name)
+		if name == "" {
+			name = util.GetDNS1123Name(filepath.Base(component.Path))
+		}
+
+		detection := NewDetectionResult(detected.Type, detected.Registry, component.Ports, detected.DefaultVersion, name)
+		detection.Path = component.Path
+		detection.Architectures = detected.Architectures
+		result = append(result, *detection)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
+
 func NewDetectionResult(typ model.DevfileType, registry api.Registry, appPorts []int, devfileVersion, name string) *api.DetectionResult {
 	return &api.DetectionResult{
 		Devfile:          typ.Name,
@@ -2,6 +2,7 @@ package alizer
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -240,3 +241,60 @@ func TestAlizer_DetectPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestAlizer_DetectComponents(t *testing.T) {
+	copyProject := func(t *testing.T, src, dst string) {
+		entries, err := os.ReadDir(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = os.MkdirAll(dst, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for _, entry := range entries {
+			content, err := os.ReadFile(filepath.Join(src, entry.Name()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = os.WriteFile(filepath.Join(dst, entry.Name()), content, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	root := t.TempDir()
+	copyProject(t, GetTestProjectPath("nodejs"), filepath.Join(root, "services", "frontend"))
+	copyProject(t, GetTestProjectPath("python"), filepath.Join(root, "services", "backend"))
+
+	ctrl := gomock.NewController(t)
+	registryClient := registry.NewMockClient(ctrl)
+	ctx := context.Background()
+	registryClient.EXPECT().ListDevfileStacks(ctx, "", "", "", false, false).Return(list, nil).Times(1)
+	alizerClient := NewAlizerClient(registryClient)
+
+	got, err := alizerClient.DetectComponents(ctx, root)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		filepath.Join(root, "services", "backend"):  "python",
+		filepath.Join(root, "services", "frontend"): "nodejs",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d components, got %d: %v", len(want), len(got), got)
+	}
+	for _, detection := range got {
+		devfile, found := want[detection.Path]
+		if !found {
+			t.Errorf("unexpected component detected at path %q", detection.Path)
+			continue
+		}
+		if detection.Devfile != devfile {
+			t.Errorf("expected devfile %q for path %q, got %q", devfile, detection.Path, detection.Devfile)
+		}
+		if detection.Name == "" {
+			t.Errorf("expected a name for component at path %q", detection.Path)
+		}
+	}
+}
@@ -19,4 +19,7 @@ type Client interface {
 	DetectFramework(ctx context.Context, path string) (DetectedFramework, error)
 	DetectName(path string) (string, error)
 	DetectPorts(path string) ([]int, error)
+	// DetectComponents walks the directory tree from path and returns a detection result
+	// for each component root found, with the devfile, name and ports detected for it
+	DetectComponents(ctx context.Context, path string) ([]api.DetectionResult, error)
 }
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	api "github\.com/danielpickens/astra/pkg/api"
 )
 
 // MockClient is a mock of Client interface.
@@ -34,6 +35,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DetectComponents mocks base method.
+func (m *MockClient) DetectComponents(ctx context.Context, path string) ([]api.DetectionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectComponents", ctx, path)
+	ret0, _ := ret[0].([]api.DetectionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectComponents indicates an expected call of DetectComponents.
+func (mr *MockClientMockRecorder) DetectComponents(ctx, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectComponents", reflect.TypeOf((*MockClient)(nil).DetectComponents), ctx, path)
+}
+
 // DetectFramework mocks base method.
 func (m *MockClient) DetectFramework(ctx context.Context, path string) (DetectedFramework, error) {
 	m.ctrl.T.Helper()
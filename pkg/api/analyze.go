@@ -21,4 +21,6 @@ type DetectionResult struct {
 	Name string `json:"name,omitempty"`
 	// Architectures represent the architectures with which the Devfile must be compatible with.
 	Architectures []string `json:"architectures,omitempty"`
+	// Path is the root directory of the detected component, when detecting several components recursively
+	Path string `json:"path,omitempty"`
 }
@@ -27,4 +27,6 @@ type ServiceBindingStatus struct {
 	BindingFiles   []string     `json:"bindingFiles,omitempty"`
 	BindingEnvVars []string     `json:"bindingEnvVars,omitempty"`
 	RunningIn      RunningModes `json:"runningIn,omitempty"`
+	// Operatorless indicates that the binding has been processed by astra, as the Service Binding Operator is not installed on the cluster
+	Operatorless bool `json:"operatorless,omitempty"`
 }
@@ -1,5 +1,7 @@
 package api
 
+import "time"
+
 // ComponentAbstract represents a component as part of a list of components
 type ComponentAbstract struct {
 	Name             string `json:"name"`
@@ -14,6 +16,14 @@ type ComponentAbstract struct {
 	RunningOn string `json:"runningOn,omitempty"`
 	// Platform is the platform the component is running on, either cluster or podman
 	Platform string `json:"platform,omitempty"`
+	// Namespace is the namespace the component is running in, set when the components are listed from all namespaces
+	Namespace string `json:"namespace,omitempty"`
+	// DevfileName is the name of the Devfile used to deploy the component
+	DevfileName string `json:"devfileName,omitempty"`
+	// Created is the creation time of the oldest resource of the component
+	Created *time.Time `json:"created,omitempty"`
+	// LastModified is the last time a resource of the component has been created or modified
+	LastModified *time.Time `json:"lastModified,omitempty"`
 }
 
 const (
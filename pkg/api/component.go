@@ -1,5 +1,7 @@
 package api
 
+import "time"
+
 // Component describes the state of a devfile component
 type Component struct {
 	DevfilePath       string            `json:"devfilePath,omitempty"`
@@ -16,6 +18,37 @@ type Component struct {
 	Ingresses []ConnectionData        `json:"ingresses,omitempty"`
 	Routes    []ConnectionData        `json:"routes,omitempty"`
 	ManagedBy string                  `json:"managedBy"`
+	// Resources is the tree of the resources deployed for the component, returned only when requested
+	Resources []ResourceNode `json:"resources,omitempty"`
+}
+
+// GeneratedComponent is the result of the generation of a devfile from an existing description of the project
+type GeneratedComponent struct {
+	Component
+	// Unmapped are the elements of the source which could not be represented in the devfile
+	Unmapped []string `json:"unmapped,omitempty"`
+}
+
+// ResourceNode describes the state of a resource deployed for a component, and of the resources it owns
+type ResourceNode struct {
+	// Platform is the platform on which the resource is deployed, defined for the top-level resources only
+	Platform string `json:"platform,omitempty"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	// Status is a short description of the state of the resource (phase, number of ready replicas, etc)
+	Status   string `json:"status,omitempty"`
+	Ready    *bool  `json:"ready,omitempty"`
+	Restarts int32  `json:"restarts,omitempty"`
+	// Events are the last Warning events concerning the resource
+	Events   []ResourceEvent `json:"events,omitempty"`
+	Children []ResourceNode  `json:"children,omitempty"`
+}
+
+type ResourceEvent struct {
+	Reason        string    `json:"reason"`
+	Message       string    `json:"message"`
+	Count         int32     `json:"count,omitempty"`
+	LastTimestamp time.Time `json:"lastTimestamp"`
 }
 
 type ForwardedPort struct {
@@ -39,6 +72,20 @@ type DevControlPlane struct {
 	LocalPort        int    `json:"localPort"`
 	APIServerPath    string `json:"apiServerPath"`
 	WebInterfacePath string `json:"webInterfacePath,omitempty"`
+	// Secure indicates that the API server is served over TLS
+	Secure bool `json:"secure,omitempty"`
+	// Token is the token required by the API server, it is never displayed
+	Token string `json:"-"`
+	// CertFingerprint is the SHA-256 fingerprint of the certificate of the API server, if Secure is true
+	CertFingerprint string `json:"-"`
+}
+
+// Scheme returns the scheme of the URLs of the API server
+func (o DevControlPlane) Scheme() string {
+	if o.Secure {
+		return "https"
+	}
+	return "http"
 }
 
 func (o DevControlPlane) GetPlatform() string {
@@ -54,3 +101,15 @@ type Rules struct {
 	Host  string   `json:"host"`
 	Paths []string `json:"paths"`
 }
+
+// DevSession is an 'astra dev' session running in a directory
+type DevSession struct {
+	// PID is the ID of the 'astra dev' process
+	PID int `json:"pid"`
+	// Directory is the directory of the component
+	Directory string `json:"directory"`
+	// Platform is the platform on which the component is running
+	Platform string `json:"platform"`
+	// APIServer is the API server of the session, if it has been started
+	APIServer *DevControlPlane `json:"apiServer,omitempty"`
+}
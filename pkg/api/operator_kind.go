@@ -0,0 +1,27 @@
+package api
+
+import "fmt"
+
+// OperatorBackedKind describes a kind of service provided by an Operator installed in the cluster
+type OperatorBackedKind struct {
+	// Operator is the name of the ClusterServiceVersion owning the kind
+	Operator    string `json:"operator"`
+	Group       string `json:"group"`
+	Version     string `json:"version"`
+	Kind        string `json:"kind"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// APIVersion returns the apiVersion of the resources of this kind
+func (o OperatorBackedKind) APIVersion() string {
+	if o.Group == "" {
+		return o.Version
+	}
+	return o.Group + "/" + o.Version
+}
+
+// String returns the kind in the form `<kind>.<group>/<version>`, as accepted by the --kind flag of `astra add service`
+func (o OperatorBackedKind) String() string {
+	return fmt.Sprintf("%s.%s/%s", o.Kind, o.Group, o.Version)
+}
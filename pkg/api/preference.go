@@ -10,9 +10,11 @@ type PreferenceItem struct {
 	Default     interface{} `json:"default"`     // default value of the preference if the user hasn't set the value
 	Type        string      `json:"type"`        // the type of the preference, possible values int, string, bool
 	Description string      `json:"description"` // The description of the preference
+	Origin      string      `json:"origin"`      // where the value comes from, possible values default, user, project
 }
 
 type PreferenceView struct {
 	Preferences []PreferenceItem `json:"preferences,omitempty"`
+	Project     []PreferenceItem `json:"project,omitempty"` // settings of the project configuration file which are not preferences
 	Registries  []Registry       `json:"registries,omitempty"`
 }
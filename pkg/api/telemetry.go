@@ -0,0 +1,62 @@
+package api
+
+import "time"
+
+/*
+{
+  "file": "/home/user/.astra/telemetry.jsonl",
+  "from": "2024-03-01T09:12:45Z",
+  "to": "2024-03-08T17:03:10Z",
+  "events": 42,
+  "failures": 3,
+  "commands": [
+    {
+      "command": "astra dev",
+      "count": 12,
+      "failures": 2,
+      "averageDurationMs": 95321,
+      "p95DurationMs": 301234,
+      "maxDurationMs": 345678
+    }
+  ],
+  "errorTypes": [
+    {
+      "errorType": "*errors.errorString",
+      "count": 2,
+      "commands": ["astra dev"]
+    }
+  ]
+}
+*/
+
+// TelemetrySummary summarizes the telemetry data collected locally
+type TelemetrySummary struct {
+	// File is the path of the file containing the data
+	File string `json:"file"`
+	// From and To are the dates of the first and last events
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+	// Events is the number of commands executed
+	Events int `json:"events"`
+	// Failures is the number of commands which failed
+	Failures   int                  `json:"failures"`
+	Commands   []CommandTelemetry   `json:"commands,omitempty"`
+	ErrorTypes []ErrorTypeTelemetry `json:"errorTypes,omitempty"`
+}
+
+// CommandTelemetry summarizes the executions of a command
+type CommandTelemetry struct {
+	Command           string `json:"command"`
+	Count             int    `json:"count"`
+	Failures          int    `json:"failures"`
+	AverageDurationMs int64  `json:"averageDurationMs"`
+	P95DurationMs     int64  `json:"p95DurationMs"`
+	MaxDurationMs     int64  `json:"maxDurationMs"`
+}
+
+// ErrorTypeTelemetry summarizes the failures of the commands with the same type of error
+type ErrorTypeTelemetry struct {
+	ErrorType string   `json:"errorType"`
+	Count     int      `json:"count"`
+	Commands  []string `json:"commands"`
+}
@@ -0,0 +1,39 @@
+package api
+
+/*
+{
+  "server": "https://api.example.com:6443",
+  "context": "dev",
+  "username": "developer@example.com",
+  "groups": ["developers", "system:authenticated"],
+  "namespace": "my-project",
+  "permissions": [
+    {
+      "verbs": ["get", "list", "watch"],
+      "apiGroups": ["apps"],
+      "resources": ["deployments"]
+    }
+  ]
+}
+*/
+
+// WhoAmI describes the user logged in to the cluster and their permissions in the current namespace
+type WhoAmI struct {
+	Server      string       `json:"server,omitempty"`
+	Context     string       `json:"context,omitempty"`
+	Username    string       `json:"username,omitempty"`
+	Groups      []string     `json:"groups,omitempty"`
+	Namespace   string       `json:"namespace,omitempty"`
+	Permissions []Permission `json:"permissions,omitempty"`
+	// Incomplete is true if the cluster was not able to return all the permissions of the user
+	Incomplete bool `json:"incomplete,omitempty"`
+}
+
+// Permission is a rule granting verbs on resources or non-resource URLs
+type Permission struct {
+	Verbs           []string `json:"verbs"`
+	APIGroups       []string `json:"apiGroups,omitempty"`
+	Resources       []string `json:"resources,omitempty"`
+	ResourceNames   []string `json:"resourceNames,omitempty"`
+	NonResourceURLs []string `json:"nonResourceURLs,omitempty"`
+}
@@ -20,6 +20,8 @@ import (
 type DefaultApiRouter interface {
 	ComponentCommandPost(http.ResponseWriter, *http.Request)
 	ComponentGet(http.ResponseWriter, *http.Request)
+	ComponentPortsGet(http.ResponseWriter, *http.Request)
+	ComponentSyncGet(http.ResponseWriter, *http.Request)
 	DevfileGet(http.ResponseWriter, *http.Request)
 	DevfilePut(http.ResponseWriter, *http.Request)
 	InstanceDelete(http.ResponseWriter, *http.Request)
@@ -33,6 +35,7 @@ type DefaultApiRouter interface {
 type DevstateApiRouter interface {
 	DevstateApplyCommandCommandNamePatch(http.ResponseWriter, *http.Request)
 	DevstateApplyCommandPost(http.ResponseWriter, *http.Request)
+	DevstateAttributesPut(http.ResponseWriter, *http.Request)
 	DevstateChartGet(http.ResponseWriter, *http.Request)
 	DevstateCommandCommandNameDelete(http.ResponseWriter, *http.Request)
 	DevstateCommandCommandNameMovePost(http.ResponseWriter, *http.Request)
@@ -43,20 +46,38 @@ type DevstateApiRouter interface {
 	DevstateContainerContainerNameDelete(http.ResponseWriter, *http.Request)
 	DevstateContainerContainerNamePatch(http.ResponseWriter, *http.Request)
 	DevstateContainerPost(http.ResponseWriter, *http.Request)
+	DevstateDependentProjectDependentProjectNameDelete(http.ResponseWriter, *http.Request)
+	DevstateDependentProjectDependentProjectNamePatch(http.ResponseWriter, *http.Request)
+	DevstateDependentProjectPost(http.ResponseWriter, *http.Request)
 	DevstateDevfileDelete(http.ResponseWriter, *http.Request)
 	DevstateDevfileGet(http.ResponseWriter, *http.Request)
 	DevstateDevfilePut(http.ResponseWriter, *http.Request)
 	DevstateEventsPut(http.ResponseWriter, *http.Request)
 	DevstateExecCommandCommandNamePatch(http.ResponseWriter, *http.Request)
 	DevstateExecCommandPost(http.ResponseWriter, *http.Request)
+	DevstateFlattenedGet(http.ResponseWriter, *http.Request)
 	DevstateImageImageNameDelete(http.ResponseWriter, *http.Request)
 	DevstateImageImageNamePatch(http.ResponseWriter, *http.Request)
 	DevstateImagePost(http.ResponseWriter, *http.Request)
 	DevstateMetadataPut(http.ResponseWriter, *http.Request)
+	DevstateParentDelete(http.ResponseWriter, *http.Request)
+	DevstateParentPut(http.ResponseWriter, *http.Request)
+	DevstatePluginPluginNameDelete(http.ResponseWriter, *http.Request)
+	DevstatePluginPluginNamePatch(http.ResponseWriter, *http.Request)
+	DevstatePluginPost(http.ResponseWriter, *http.Request)
+	DevstateProjectPost(http.ResponseWriter, *http.Request)
+	DevstateProjectProjectNameDelete(http.ResponseWriter, *http.Request)
+	DevstateProjectProjectNamePatch(http.ResponseWriter, *http.Request)
 	DevstateQuantityValidPost(http.ResponseWriter, *http.Request)
+	DevstateRedoPost(http.ResponseWriter, *http.Request)
 	DevstateResourcePost(http.ResponseWriter, *http.Request)
 	DevstateResourceResourceNameDelete(http.ResponseWriter, *http.Request)
 	DevstateResourceResourceNamePatch(http.ResponseWriter, *http.Request)
+	DevstateStarterProjectPost(http.ResponseWriter, *http.Request)
+	DevstateStarterProjectStarterProjectNameDelete(http.ResponseWriter, *http.Request)
+	DevstateStarterProjectStarterProjectNamePatch(http.ResponseWriter, *http.Request)
+	DevstateUndoPost(http.ResponseWriter, *http.Request)
+	DevstateVariablesPut(http.ResponseWriter, *http.Request)
 	DevstateVolumePost(http.ResponseWriter, *http.Request)
 	DevstateVolumeVolumeNameDelete(http.ResponseWriter, *http.Request)
 	DevstateVolumeVolumeNamePatch(http.ResponseWriter, *http.Request)
@@ -69,6 +90,8 @@ type DevstateApiRouter interface {
 type DefaultApiServicer interface {
 	ComponentCommandPost(context.Context, ComponentCommandPostRequest) (ImplResponse, error)
 	ComponentGet(context.Context) (ImplResponse, error)
+	ComponentPortsGet(context.Context) (ImplResponse, error)
+	ComponentSyncGet(context.Context) (ImplResponse, error)
 	DevfileGet(context.Context) (ImplResponse, error)
 	DevfilePut(context.Context, DevfilePutRequest) (ImplResponse, error)
 	InstanceDelete(context.Context) (ImplResponse, error)
@@ -83,6 +106,7 @@ type DefaultApiServicer interface {
 type DevstateApiServicer interface {
 	DevstateApplyCommandCommandNamePatch(context.Context, string, DevstateApplyCommandCommandNamePatchRequest) (ImplResponse, error)
 	DevstateApplyCommandPost(context.Context, DevstateApplyCommandPostRequest) (ImplResponse, error)
+	DevstateAttributesPut(context.Context, DevstateAttributesPutRequest) (ImplResponse, error)
 	DevstateChartGet(context.Context) (ImplResponse, error)
 	DevstateCommandCommandNameDelete(context.Context, string) (ImplResponse, error)
 	DevstateCommandCommandNameMovePost(context.Context, string, DevstateCommandCommandNameMovePostRequest) (ImplResponse, error)
@@ -93,20 +117,38 @@ type DevstateApiServicer interface {
 	DevstateContainerContainerNameDelete(context.Context, string) (ImplResponse, error)
 	DevstateContainerContainerNamePatch(context.Context, string, DevstateContainerContainerNamePatchRequest) (ImplResponse, error)
 	DevstateContainerPost(context.Context, DevstateContainerPostRequest) (ImplResponse, error)
+	DevstateDependentProjectDependentProjectNameDelete(context.Context, string) (ImplResponse, error)
+	DevstateDependentProjectDependentProjectNamePatch(context.Context, string, ProjectPatch) (ImplResponse, error)
+	DevstateDependentProjectPost(context.Context, Project) (ImplResponse, error)
 	DevstateDevfileDelete(context.Context) (ImplResponse, error)
 	DevstateDevfileGet(context.Context) (ImplResponse, error)
 	DevstateDevfilePut(context.Context, DevstateDevfilePutRequest) (ImplResponse, error)
 	DevstateEventsPut(context.Context, DevstateEventsPutRequest) (ImplResponse, error)
 	DevstateExecCommandCommandNamePatch(context.Context, string, DevstateExecCommandCommandNamePatchRequest) (ImplResponse, error)
 	DevstateExecCommandPost(context.Context, DevstateExecCommandPostRequest) (ImplResponse, error)
+	DevstateFlattenedGet(context.Context) (ImplResponse, error)
 	DevstateImageImageNameDelete(context.Context, string) (ImplResponse, error)
 	DevstateImageImageNamePatch(context.Context, string, DevstateImageImageNamePatchRequest) (ImplResponse, error)
 	DevstateImagePost(context.Context, DevstateImagePostRequest) (ImplResponse, error)
 	DevstateMetadataPut(context.Context, MetadataRequest) (ImplResponse, error)
+	DevstateParentDelete(context.Context) (ImplResponse, error)
+	DevstateParentPut(context.Context, Parent) (ImplResponse, error)
+	DevstatePluginPluginNameDelete(context.Context, string) (ImplResponse, error)
+	DevstatePluginPluginNamePatch(context.Context, string, PluginPatch) (ImplResponse, error)
+	DevstatePluginPost(context.Context, Plugin) (ImplResponse, error)
+	DevstateProjectPost(context.Context, Project) (ImplResponse, error)
+	DevstateProjectProjectNameDelete(context.Context, string) (ImplResponse, error)
+	DevstateProjectProjectNamePatch(context.Context, string, ProjectPatch) (ImplResponse, error)
 	DevstateQuantityValidPost(context.Context, DevstateQuantityValidPostRequest) (ImplResponse, error)
+	DevstateRedoPost(context.Context) (ImplResponse, error)
 	DevstateResourcePost(context.Context, DevstateResourcePostRequest) (ImplResponse, error)
 	DevstateResourceResourceNameDelete(context.Context, string) (ImplResponse, error)
 	DevstateResourceResourceNamePatch(context.Context, string, DevstateResourceResourceNamePatchRequest) (ImplResponse, error)
+	DevstateStarterProjectPost(context.Context, StarterProject) (ImplResponse, error)
+	DevstateStarterProjectStarterProjectNameDelete(context.Context, string) (ImplResponse, error)
+	DevstateStarterProjectStarterProjectNamePatch(context.Context, string, StarterProjectPatch) (ImplResponse, error)
+	DevstateUndoPost(context.Context) (ImplResponse, error)
+	DevstateVariablesPut(context.Context, DevstateVariablesPutRequest) (ImplResponse, error)
 	DevstateVolumePost(context.Context, DevstateVolumePostRequest) (ImplResponse, error)
 	DevstateVolumeVolumeNameDelete(context.Context, string) (ImplResponse, error)
 	DevstateVolumeVolumeNamePatch(context.Context, string, DevstateVolumeVolumeNamePatchRequest) (ImplResponse, error)
@@ -60,6 +60,18 @@ func (c *DefaultApiController) Routes() Routes {
 			"/api/v1/component",
 			c.ComponentGet,
 		},
+		{
+			"ComponentPortsGet",
+			strings.ToUpper("Get"),
+			"/api/v1/component/ports",
+			c.ComponentPortsGet,
+		},
+		{
+			"ComponentSyncGet",
+			strings.ToUpper("Get"),
+			"/api/v1/component/sync",
+			c.ComponentSyncGet,
+		},
 		{
 			"DevfileGet",
 			strings.ToUpper("Get"),
@@ -130,6 +142,32 @@ func (c *DefaultApiController) ComponentGet(w http.ResponseWriter, r *http.Reque
 
 }
 
+// ComponentPortsGet -
+func (c *DefaultApiController) ComponentPortsGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.ComponentPortsGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// ComponentSyncGet -
+func (c *DefaultApiController) ComponentSyncGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.ComponentSyncGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DevfileGet -
 func (c *DefaultApiController) DevfileGet(w http.ResponseWriter, r *http.Request) {
 	result, err := c.service.DevfileGet(r.Context())
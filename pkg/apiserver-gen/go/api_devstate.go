@@ -62,6 +62,12 @@ func (c *DevstateApiController) Routes() Routes {
 			"/api/v1/devstate/applyCommand",
 			c.DevstateApplyCommandPost,
 		},
+		{
+			"DevstateAttributesPut",
+			strings.ToUpper("Put"),
+			"/api/v1/devstate/attributes",
+			c.DevstateAttributesPut,
+		},
 		{
 			"DevstateChartGet",
 			strings.ToUpper("Get"),
@@ -122,6 +128,24 @@ func (c *DevstateApiController) Routes() Routes {
 			"/api/v1/devstate/container",
 			c.DevstateContainerPost,
 		},
+		{
+			"DevstateDependentProjectDependentProjectNameDelete",
+			strings.ToUpper("Delete"),
+			"/api/v1/devstate/dependentProject/{dependentProjectName}",
+			c.DevstateDependentProjectDependentProjectNameDelete,
+		},
+		{
+			"DevstateDependentProjectDependentProjectNamePatch",
+			strings.ToUpper("Patch"),
+			"/api/v1/devstate/dependentProject/{dependentProjectName}",
+			c.DevstateDependentProjectDependentProjectNamePatch,
+		},
+		{
+			"DevstateDependentProjectPost",
+			strings.ToUpper("Post"),
+			"/api/v1/devstate/dependentProject",
+			c.DevstateDependentProjectPost,
+		},
 		{
 			"DevstateDevfileDelete",
 			strings.ToUpper("Delete"),
@@ -158,6 +182,12 @@ func (c *DevstateApiController) Routes() Routes {
 			"/api/v1/devstate/execCommand",
 			c.DevstateExecCommandPost,
 		},
+		{
+			"DevstateFlattenedGet",
+			strings.ToUpper("Get"),
+			"/api/v1/devstate/flattened",
+			c.DevstateFlattenedGet,
+		},
 		{
 			"DevstateImageImageNameDelete",
 			strings.ToUpper("Delete"),
@@ -182,12 +212,66 @@ func (c *DevstateApiController) Routes() Routes {
 			"/api/v1/devstate/metadata",
 			c.DevstateMetadataPut,
 		},
+		{
+			"DevstateParentDelete",
+			strings.ToUpper("Delete"),
+			"/api/v1/devstate/parent",
+			c.DevstateParentDelete,
+		},
+		{
+			"DevstateParentPut",
+			strings.ToUpper("Put"),
+			"/api/v1/devstate/parent",
+			c.DevstateParentPut,
+		},
+		{
+			"DevstatePluginPluginNameDelete",
+			strings.ToUpper("Delete"),
+			"/api/v1/devstate/plugin/{pluginName}",
+			c.DevstatePluginPluginNameDelete,
+		},
+		{
+			"DevstatePluginPluginNamePatch",
+			strings.ToUpper("Patch"),
+			"/api/v1/devstate/plugin/{pluginName}",
+			c.DevstatePluginPluginNamePatch,
+		},
+		{
+			"DevstatePluginPost",
+			strings.ToUpper("Post"),
+			"/api/v1/devstate/plugin",
+			c.DevstatePluginPost,
+		},
+		{
+			"DevstateProjectPost",
+			strings.ToUpper("Post"),
+			"/api/v1/devstate/project",
+			c.DevstateProjectPost,
+		},
+		{
+			"DevstateProjectProjectNameDelete",
+			strings.ToUpper("Delete"),
+			"/api/v1/devstate/project/{projectName}",
+			c.DevstateProjectProjectNameDelete,
+		},
+		{
+			"DevstateProjectProjectNamePatch",
+			strings.ToUpper("Patch"),
+			"/api/v1/devstate/project/{projectName}",
+			c.DevstateProjectProjectNamePatch,
+		},
 		{
 			"DevstateQuantityValidPost",
 			strings.ToUpper("Post"),
 			"/api/v1/devstate/quantityValid",
 			c.DevstateQuantityValidPost,
 		},
+		{
+			"DevstateRedoPost",
+			strings.ToUpper("Post"),
+			"/api/v1/devstate/redo",
+			c.DevstateRedoPost,
+		},
 		{
 			"DevstateResourcePost",
 			strings.ToUpper("Post"),
@@ -206,6 +290,36 @@ func (c *DevstateApiController) Routes() Routes {
 			"/api/v1/devstate/resource/{resourceName}",
 			c.DevstateResourceResourceNamePatch,
 		},
+		{
+			"DevstateStarterProjectPost",
+			strings.ToUpper("Post"),
+			"/api/v1/devstate/starterProject",
+			c.DevstateStarterProjectPost,
+		},
+		{
+			"DevstateStarterProjectStarterProjectNameDelete",
+			strings.ToUpper("Delete"),
+			"/api/v1/devstate/starterProject/{starterProjectName}",
+			c.DevstateStarterProjectStarterProjectNameDelete,
+		},
+		{
+			"DevstateStarterProjectStarterProjectNamePatch",
+			strings.ToUpper("Patch"),
+			"/api/v1/devstate/starterProject/{starterProjectName}",
+			c.DevstateStarterProjectStarterProjectNamePatch,
+		},
+		{
+			"DevstateUndoPost",
+			strings.ToUpper("Post"),
+			"/api/v1/devstate/undo",
+			c.DevstateUndoPost,
+		},
+		{
+			"DevstateVariablesPut",
+			strings.ToUpper("Put"),
+			"/api/v1/devstate/variables",
+			c.DevstateVariablesPut,
+		},
 		{
 			"DevstateVolumePost",
 			strings.ToUpper("Post"),
@@ -277,6 +391,30 @@ func (c *DevstateApiController) DevstateApplyCommandPost(w http.ResponseWriter,
 
 }
 
+// DevstateAttributesPut -
+func (c *DevstateApiController) DevstateAttributesPut(w http.ResponseWriter, r *http.Request) {
+	devstateAttributesPutRequestParam := DevstateAttributesPutRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&devstateAttributesPutRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertDevstateAttributesPutRequestRequired(devstateAttributesPutRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateAttributesPut(r.Context(), devstateAttributesPutRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DevstateChartGet -
 func (c *DevstateApiController) DevstateChartGet(w http.ResponseWriter, r *http.Request) {
 	result, err := c.service.DevstateChartGet(r.Context())
@@ -487,6 +625,71 @@ func (c *DevstateApiController) DevstateContainerPost(w http.ResponseWriter, r *
 
 }
 
+// DevstateDependentProjectDependentProjectNameDelete -
+func (c *DevstateApiController) DevstateDependentProjectDependentProjectNameDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	dependentProjectNameParam := params["dependentProjectName"]
+	result, err := c.service.DevstateDependentProjectDependentProjectNameDelete(r.Context(), dependentProjectNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateDependentProjectDependentProjectNamePatch -
+func (c *DevstateApiController) DevstateDependentProjectDependentProjectNamePatch(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	dependentProjectNameParam := params["dependentProjectName"]
+	projectPatchParam := ProjectPatch{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&projectPatchParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertProjectPatchRequired(projectPatchParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateDependentProjectDependentProjectNamePatch(r.Context(), dependentProjectNameParam, projectPatchParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateDependentProjectPost -
+func (c *DevstateApiController) DevstateDependentProjectPost(w http.ResponseWriter, r *http.Request) {
+	projectParam := Project{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&projectParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertProjectRequired(projectParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateDependentProjectPost(r.Context(), projectParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DevstateDevfileDelete -
 func (c *DevstateApiController) DevstateDevfileDelete(w http.ResponseWriter, r *http.Request) {
 	result, err := c.service.DevstateDevfileDelete(r.Context())
@@ -611,6 +814,19 @@ func (c *DevstateApiController) DevstateExecCommandPost(w http.ResponseWriter, r
 
 }
 
+// DevstateFlattenedGet -
+func (c *DevstateApiController) DevstateFlattenedGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.DevstateFlattenedGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DevstateImageImageNameDelete -
 func (c *DevstateApiController) DevstateImageImageNameDelete(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -700,6 +916,173 @@ func (c *DevstateApiController) DevstateMetadataPut(w http.ResponseWriter, r *ht
 
 }
 
+// DevstateParentDelete -
+func (c *DevstateApiController) DevstateParentDelete(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.DevstateParentDelete(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateParentPut -
+func (c *DevstateApiController) DevstateParentPut(w http.ResponseWriter, r *http.Request) {
+	parentParam := Parent{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&parentParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertParentRequired(parentParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateParentPut(r.Context(), parentParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstatePluginPluginNameDelete -
+func (c *DevstateApiController) DevstatePluginPluginNameDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	pluginNameParam := params["pluginName"]
+	result, err := c.service.DevstatePluginPluginNameDelete(r.Context(), pluginNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstatePluginPluginNamePatch -
+func (c *DevstateApiController) DevstatePluginPluginNamePatch(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	pluginNameParam := params["pluginName"]
+	pluginPatchParam := PluginPatch{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&pluginPatchParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertPluginPatchRequired(pluginPatchParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstatePluginPluginNamePatch(r.Context(), pluginNameParam, pluginPatchParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstatePluginPost -
+func (c *DevstateApiController) DevstatePluginPost(w http.ResponseWriter, r *http.Request) {
+	pluginParam := Plugin{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&pluginParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertPluginRequired(pluginParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstatePluginPost(r.Context(), pluginParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateProjectPost -
+func (c *DevstateApiController) DevstateProjectPost(w http.ResponseWriter, r *http.Request) {
+	projectParam := Project{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&projectParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertProjectRequired(projectParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateProjectPost(r.Context(), projectParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateProjectProjectNameDelete -
+func (c *DevstateApiController) DevstateProjectProjectNameDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	projectNameParam := params["projectName"]
+	result, err := c.service.DevstateProjectProjectNameDelete(r.Context(), projectNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateProjectProjectNamePatch -
+func (c *DevstateApiController) DevstateProjectProjectNamePatch(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	projectNameParam := params["projectName"]
+	projectPatchParam := ProjectPatch{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&projectPatchParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertProjectPatchRequired(projectPatchParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateProjectProjectNamePatch(r.Context(), projectNameParam, projectPatchParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DevstateQuantityValidPost -
 func (c *DevstateApiController) DevstateQuantityValidPost(w http.ResponseWriter, r *http.Request) {
 	devstateQuantityValidPostRequestParam := DevstateQuantityValidPostRequest{}
@@ -724,6 +1107,19 @@ func (c *DevstateApiController) DevstateQuantityValidPost(w http.ResponseWriter,
 
 }
 
+// DevstateRedoPost -
+func (c *DevstateApiController) DevstateRedoPost(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.DevstateRedoPost(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DevstateResourcePost -
 func (c *DevstateApiController) DevstateResourcePost(w http.ResponseWriter, r *http.Request) {
 	devstateResourcePostRequestParam := DevstateResourcePostRequest{}
@@ -789,6 +1185,108 @@ func (c *DevstateApiController) DevstateResourceResourceNamePatch(w http.Respons
 
 }
 
+// DevstateStarterProjectPost -
+func (c *DevstateApiController) DevstateStarterProjectPost(w http.ResponseWriter, r *http.Request) {
+	starterProjectParam := StarterProject{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&starterProjectParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertStarterProjectRequired(starterProjectParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateStarterProjectPost(r.Context(), starterProjectParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateStarterProjectStarterProjectNameDelete -
+func (c *DevstateApiController) DevstateStarterProjectStarterProjectNameDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	starterProjectNameParam := params["starterProjectName"]
+	result, err := c.service.DevstateStarterProjectStarterProjectNameDelete(r.Context(), starterProjectNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateStarterProjectStarterProjectNamePatch -
+func (c *DevstateApiController) DevstateStarterProjectStarterProjectNamePatch(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	starterProjectNameParam := params["starterProjectName"]
+	starterProjectPatchParam := StarterProjectPatch{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&starterProjectPatchParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertStarterProjectPatchRequired(starterProjectPatchParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateStarterProjectStarterProjectNamePatch(r.Context(), starterProjectNameParam, starterProjectPatchParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateUndoPost -
+func (c *DevstateApiController) DevstateUndoPost(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.DevstateUndoPost(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DevstateVariablesPut -
+func (c *DevstateApiController) DevstateVariablesPut(w http.ResponseWriter, r *http.Request) {
+	devstateVariablesPutRequestParam := DevstateVariablesPutRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&devstateVariablesPutRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertDevstateVariablesPutRequestRequired(devstateVariablesPutRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DevstateVariablesPut(r.Context(), devstateVariablesPutRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DevstateVolumePost -
 func (c *DevstateApiController) DevstateVolumePost(w http.ResponseWriter, r *http.Request) {
 	devstateVolumePostRequestParam := DevstateVolumePostRequest{}
@@ -0,0 +1,32 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type DevstateAttributesPutRequest struct {
+	// Attributes of the Devfile, indexed by name
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// AssertDevstateAttributesPutRequestRequired checks if the required fields are not zero-ed
+func AssertDevstateAttributesPutRequestRequired(obj DevstateAttributesPutRequest) error {
+	return nil
+}
+
+// AssertRecurseDevstateAttributesPutRequestRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of DevstateAttributesPutRequest (e.g. [][]DevstateAttributesPutRequest), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseDevstateAttributesPutRequestRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aDevstateAttributesPutRequest, ok := obj.(DevstateAttributesPutRequest)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertDevstateAttributesPutRequestRequired(aDevstateAttributesPutRequest)
+	})
+}
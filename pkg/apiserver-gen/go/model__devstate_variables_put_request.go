@@ -0,0 +1,32 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type DevstateVariablesPutRequest struct {
+	// Variables of the Devfile, indexed by name
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// AssertDevstateVariablesPutRequestRequired checks if the required fields are not zero-ed
+func AssertDevstateVariablesPutRequestRequired(obj DevstateVariablesPutRequest) error {
+	return nil
+}
+
+// AssertRecurseDevstateVariablesPutRequestRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of DevstateVariablesPutRequest (e.g. [][]DevstateVariablesPutRequest), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseDevstateVariablesPutRequestRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aDevstateVariablesPutRequest, ok := obj.(DevstateVariablesPutRequest)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertDevstateVariablesPutRequestRequired(aDevstateVariablesPutRequest)
+	})
+}
@@ -27,6 +27,23 @@ type DevfileContent struct {
 	Events Events `json:"events"`
 
 	Metadata Metadata `json:"metadata"`
+
+	// Problems found in the Devfile by the validation run after each change
+	Diagnostics []Diagnostic `json:"diagnostics,omitempty"`
+
+	Parent Parent `json:"parent,omitempty"`
+
+	Variables map[string]string `json:"variables,omitempty"`
+
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	Projects []Project `json:"projects,omitempty"`
+
+	StarterProjects []StarterProject `json:"starterProjects,omitempty"`
+
+	DependentProjects []Project `json:"dependentProjects,omitempty"`
+
+	Plugins []Plugin `json:"plugins,omitempty"`
 }
 
 // AssertDevfileContentRequired checks if the required fields are not zero-ed
@@ -79,6 +96,34 @@ func AssertDevfileContentRequired(obj DevfileContent) error {
 	if err := AssertMetadataRequired(obj.Metadata); err != nil {
 		return err
 	}
+	for _, el := range obj.Diagnostics {
+		if err := AssertDiagnosticRequired(el); err != nil {
+			return err
+		}
+	}
+	if err := AssertParentRequired(obj.Parent); err != nil {
+		return err
+	}
+	for _, el := range obj.Projects {
+		if err := AssertProjectRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.StarterProjects {
+		if err := AssertStarterProjectRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.DependentProjects {
+		if err := AssertProjectRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Plugins {
+		if err := AssertPluginRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,55 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type Diagnostic struct {
+
+	// Severity of the diagnostic, error or warning
+	Severity string `json:"severity"`
+
+	// Kind of the element concerned by the diagnostic (container, image, resource, volume, command, event, project, starterProject, plugin, devfile)
+	Kind string `json:"kind"`
+
+	// Name of the element concerned by the diagnostic, empty if the diagnostic concerns the whole section
+	Name string `json:"name,omitempty"`
+
+	// Field of the element concerned by the diagnostic, if known
+	Field string `json:"field,omitempty"`
+
+	Message string `json:"message"`
+}
+
+// AssertDiagnosticRequired checks if the required fields are not zero-ed
+func AssertDiagnosticRequired(obj Diagnostic) error {
+	elements := map[string]interface{}{
+		"severity": obj.Severity,
+		"kind":     obj.Kind,
+		"message":  obj.Message,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseDiagnosticRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of Diagnostic (e.g. [][]Diagnostic), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseDiagnosticRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aDiagnostic, ok := obj.(Diagnostic)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertDiagnosticRequired(aDiagnostic)
+	})
+}
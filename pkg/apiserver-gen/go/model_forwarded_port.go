@@ -0,0 +1,59 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type ForwardedPort struct {
+	Platform string `json:"platform,omitempty"`
+
+	ContainerName string `json:"containerName"`
+
+	PortName string `json:"portName"`
+
+	IsDebug bool `json:"isDebug"`
+
+	LocalAddress string `json:"localAddress"`
+
+	LocalPort int32 `json:"localPort"`
+
+	ContainerPort int32 `json:"containerPort"`
+
+	Exposure string `json:"exposure,omitempty"`
+}
+
+// AssertForwardedPortRequired checks if the required fields are not zero-ed
+func AssertForwardedPortRequired(obj ForwardedPort) error {
+	elements := map[string]interface{}{
+		"containerName": obj.ContainerName,
+		"portName":      obj.PortName,
+		"isDebug":       obj.IsDebug,
+		"localAddress":  obj.LocalAddress,
+		"localPort":     obj.LocalPort,
+		"containerPort": obj.ContainerPort,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseForwardedPortRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ForwardedPort (e.g. [][]ForwardedPort), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseForwardedPortRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aForwardedPort, ok := obj.(ForwardedPort)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertForwardedPortRequired(aForwardedPort)
+	})
+}
@@ -0,0 +1,41 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type Parent struct {
+	// URI of the parent Devfile
+	Uri string `json:"uri,omitempty"`
+
+	// Id of the parent Devfile in a registry
+	Id string `json:"id,omitempty"`
+
+	// URL of the registry containing the parent Devfile
+	RegistryUrl string `json:"registryUrl,omitempty"`
+
+	// Version of the parent Devfile in the registry
+	Version string `json:"version,omitempty"`
+}
+
+// AssertParentRequired checks if the required fields are not zero-ed
+func AssertParentRequired(obj Parent) error {
+	return nil
+}
+
+// AssertRecurseParentRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of Parent (e.g. [][]Parent), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseParentRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aParent, ok := obj.(Parent)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertParentRequired(aParent)
+	})
+}
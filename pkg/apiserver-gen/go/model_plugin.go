@@ -0,0 +1,53 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type Plugin struct {
+	// Name of the plugin component
+	Name string `json:"name"`
+
+	// URI of the plugin Devfile
+	Uri string `json:"uri,omitempty"`
+
+	// Id of the plugin Devfile in a registry
+	Id string `json:"id,omitempty"`
+
+	// URL of the registry containing the plugin Devfile
+	RegistryUrl string `json:"registryUrl,omitempty"`
+
+	// Version of the plugin Devfile in the registry
+	Version string `json:"version,omitempty"`
+}
+
+// AssertPluginRequired checks if the required fields are not zero-ed
+func AssertPluginRequired(obj Plugin) error {
+	elements := map[string]interface{}{
+		"name": obj.Name,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecursePluginRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of Plugin (e.g. [][]Plugin), otherwise ErrTypeAssertionError is thrown.
+func AssertRecursePluginRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aPlugin, ok := obj.(Plugin)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertPluginRequired(aPlugin)
+	})
+}
@@ -0,0 +1,41 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type PluginPatch struct {
+	// URI of the plugin Devfile
+	Uri string `json:"uri,omitempty"`
+
+	// Id of the plugin Devfile in a registry
+	Id string `json:"id,omitempty"`
+
+	// URL of the registry containing the plugin Devfile
+	RegistryUrl string `json:"registryUrl,omitempty"`
+
+	// Version of the plugin Devfile in the registry
+	Version string `json:"version,omitempty"`
+}
+
+// AssertPluginPatchRequired checks if the required fields are not zero-ed
+func AssertPluginPatchRequired(obj PluginPatch) error {
+	return nil
+}
+
+// AssertRecursePluginPatchRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of PluginPatch (e.g. [][]PluginPatch), otherwise ErrTypeAssertionError is thrown.
+func AssertRecursePluginPatchRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aPluginPatch, ok := obj.(PluginPatch)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertPluginPatchRequired(aPluginPatch)
+	})
+}
@@ -0,0 +1,47 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type ProjectPatch struct {
+	// Path, relative to the projects root, in which the project is cloned
+	ClonePath string `json:"clonePath,omitempty"`
+
+	// Type of the source of the project (git or zip)
+	Type string `json:"type,omitempty"`
+
+	// Git remotes of the project, indexed by name
+	Remotes map[string]string `json:"remotes,omitempty"`
+
+	// Remote from which the project is checked out
+	Remote string `json:"remote,omitempty"`
+
+	// Revision (branch, tag or commit) checked out
+	Revision string `json:"revision,omitempty"`
+
+	// Location of the zip archive of the project
+	ZipLocation string `json:"zipLocation,omitempty"`
+}
+
+// AssertProjectPatchRequired checks if the required fields are not zero-ed
+func AssertProjectPatchRequired(obj ProjectPatch) error {
+	return nil
+}
+
+// AssertRecurseProjectPatchRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ProjectPatch (e.g. [][]ProjectPatch), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseProjectPatchRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aProjectPatch, ok := obj.(ProjectPatch)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertProjectPatchRequired(aProjectPatch)
+	})
+}
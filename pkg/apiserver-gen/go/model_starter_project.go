@@ -0,0 +1,60 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type StarterProject struct {
+	Name string `json:"name"`
+
+	Description string `json:"description,omitempty"`
+
+	// Subdirectory of the source used as the root of the starter project
+	SubDir string `json:"subDir,omitempty"`
+
+	// Type of the source of the project (git or zip)
+	Type string `json:"type,omitempty"`
+
+	// Git remotes of the project, indexed by name
+	Remotes map[string]string `json:"remotes,omitempty"`
+
+	// Remote from which the project is checked out
+	Remote string `json:"remote,omitempty"`
+
+	// Revision (branch, tag or commit) checked out
+	Revision string `json:"revision,omitempty"`
+
+	// Location of the zip archive of the project
+	ZipLocation string `json:"zipLocation,omitempty"`
+}
+
+// AssertStarterProjectRequired checks if the required fields are not zero-ed
+func AssertStarterProjectRequired(obj StarterProject) error {
+	elements := map[string]interface{}{
+		"name": obj.Name,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseStarterProjectRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of StarterProject (e.g. [][]StarterProject), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseStarterProjectRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aStarterProject, ok := obj.(StarterProject)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertStarterProjectRequired(aStarterProject)
+	})
+}
@@ -0,0 +1,49 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+type StarterProjectPatch struct {
+	Description string `json:"description,omitempty"`
+
+	// Subdirectory of the source used as the root of the starter project
+	SubDir string `json:"subDir,omitempty"`
+
+	// Type of the source of the project (git or zip)
+	Type string `json:"type,omitempty"`
+
+	// Git remotes of the project, indexed by name
+	Remotes map[string]string `json:"remotes,omitempty"`
+
+	// Remote from which the project is checked out
+	Remote string `json:"remote,omitempty"`
+
+	// Revision (branch, tag or commit) checked out
+	Revision string `json:"revision,omitempty"`
+
+	// Location of the zip archive of the project
+	ZipLocation string `json:"zipLocation,omitempty"`
+}
+
+// AssertStarterProjectPatchRequired checks if the required fields are not zero-ed
+func AssertStarterProjectPatchRequired(obj StarterProjectPatch) error {
+	return nil
+}
+
+// AssertRecurseStarterProjectPatchRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of StarterProjectPatch (e.g. [][]StarterProjectPatch), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseStarterProjectPatchRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aStarterProjectPatch, ok := obj.(StarterProjectPatch)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertStarterProjectPatchRequired(aStarterProjectPatch)
+	})
+}
@@ -0,0 +1,57 @@
+/*
+ * astra dev
+ *
+ * API interface for 'astra dev'
+ *
+ * API version: 0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+type SyncStatus struct {
+
+	// Time of the last synchronization, absent if the files have never been synchronized
+	LastSync *time.Time `json:"lastSync,omitempty"`
+
+	// Files synchronized with the component, relative to the directory of the component
+	SyncedFiles []string `json:"syncedFiles"`
+
+	// Files created or modified locally since the last synchronization
+	PendingFiles []string `json:"pendingFiles"`
+
+	// Files deleted locally since the last synchronization
+	DeletedFiles []string `json:"deletedFiles"`
+}
+
+// AssertSyncStatusRequired checks if the required fields are not zero-ed
+func AssertSyncStatusRequired(obj SyncStatus) error {
+	elements := map[string]interface{}{
+		"syncedFiles":  obj.SyncedFiles,
+		"pendingFiles": obj.PendingFiles,
+		"deletedFiles": obj.DeletedFiles,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseSyncStatusRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of SyncStatus (e.g. [][]SyncStatus), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseSyncStatusRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aSyncStatus, ok := obj.(SyncStatus)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertSyncStatusRequired(aSyncStatus)
+	})
+}
@@ -78,6 +78,8 @@ func (s *DefaultApiService) ComponentCommandPost(ctx context.Context, componentC
 			Message: fmt.Sprintf("command name %q not supported. Supported values are: %q", componentCommandPostRequest.Name, "push"),
 		}), nil
 	}
+}
+
 // ComponentGet -
 func (s *DefaultApiService) ComponentGet(ctx context.Context) (openapi.ImplResponse, error) {
 	value, _, err := describe.DescribeDevfileComponent(ctx, s.kubeClient, s.podmanClient, s.stateClient)
@@ -86,15 +88,42 @@ func (s *DefaultApiService) ComponentGet(ctx context.Context) (openapi.ImplRespo
 			Message: fmt.Sprintf("error getting the description of the component: %s", err),
 		}), nil
 	}
+	return openapi.Response(http.StatusOK, value), nil
+}
 
-	// Check for another status response in openapi.Response property object
-	if value.Status != "success" {
-		return openapi.Response(http.StatusConflict, openapi.GeneralError{
-			Message: fmt.Sprintf("component description status is not successful: %s", value.Status),
+// ComponentPortsGet -
+func (s *DefaultApiService) ComponentPortsGet(ctx context.Context) (openapi.ImplResponse, error) {
+	fwPorts, err := s.stateClient.GetForwardedPorts(ctx)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("error getting the forwarded ports: %s", err),
 		}), nil
 	}
+	result := make([]openapi.ForwardedPort, 0, len(fwPorts))
+	for _, fwPort := range fwPorts {
+		result = append(result, openapi.ForwardedPort{
+			Platform:      fwPort.Platform,
+			ContainerName: fwPort.ContainerName,
+			PortName:      fwPort.PortName,
+			IsDebug:       fwPort.IsDebug,
+			LocalAddress:  fwPort.LocalAddress,
+			LocalPort:     int32(fwPort.LocalPort),
+			ContainerPort: int32(fwPort.ContainerPort),
+			Exposure:      fwPort.Exposure,
+		})
+	}
+	return openapi.Response(http.StatusOK, result), nil
+}
 
-	return openapi.Response(http.StatusOK, value), nil
+// ComponentSyncGet -
+func (s *DefaultApiService) ComponentSyncGet(ctx context.Context) (openapi.ImplResponse, error) {
+	status, err := getSyncStatus(astracontext.GetWorkingDirectory(ctx))
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("error getting the status of the synchronization: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, status), nil
 }
 
 // InstanceDelete -
@@ -0,0 +1,136 @@
+package apiserver_impl
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	openapi "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+const (
+	// TokenQueryParam is the query parameter used to pass the token to the web console
+	TokenQueryParam = "token"
+	// tokenCookie is the name of the cookie set for the web console once the token has been passed as query parameter
+	tokenCookie = "astra-api-token"
+)
+
+// NewToken returns a random token, to be used as bearer token by the clients of the API server during a session
+func NewToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// authHandler protects the API server against requests not coming from the user:
+//   - the Host header must be a local address, to prevent DNS rebinding attacks,
+//   - the Origin header, when present, must be a local address, to prevent cross-site requests from browsers,
+//   - the requests modifying the state (all methods except GET, HEAD and OPTIONS) must be authenticated with the token,
+//     passed in an `Authorization: Bearer` header, or in the cookie set for the web console.
+type authHandler struct {
+	token string
+	next  http.Handler
+}
+
+func newAuthHandler(token string, next http.Handler) http.Handler {
+	return &authHandler{
+		token: token,
+		next:  next,
+	}
+}
+
+func (o *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !isLocalHost(r.Host) {
+		writeError(w, http.StatusForbidden, "invalid Host header")
+		return
+	}
+	if origin := r.Header.Get("Origin"); origin != "" {
+		u, err := url.Parse(origin)
+		if err != nil || !isLocalHost(u.Host) {
+			writeError(w, http.StatusForbidden, "cross-origin requests are not allowed")
+			return
+		}
+	}
+
+	// The web console is opened with the token as query parameter; the token is moved to a cookie,
+	// so the requests sent by the console to the API are authenticated, and the token does not stay in the address bar
+	if r.Method == http.MethodGet && !strings.HasPrefix(r.URL.Path, "/api/") && r.URL.Query().Has(TokenQueryParam) {
+		if !o.isValid(r.URL.Query().Get(TokenQueryParam)) {
+			writeError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		http.SetCookie(w, &http.Cookie{
+			Name:     tokenCookie,
+			Value:    o.token,
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   r.TLS != nil,
+			SameSite: http.SameSiteStrictMode,
+		})
+		query := r.URL.Query()
+		query.Del(TokenQueryParam)
+		redirect := *r.URL
+		redirect.RawQuery = query.Encode()
+		http.Redirect(w, r, redirect.RequestURI(), http.StatusFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		token, found := getRequestToken(r)
+		if !found {
+			writeError(w, http.StatusUnauthorized, "a token is required, pass it with the header 'Authorization: Bearer <token>'")
+			return
+		}
+		if !o.isValid(token) {
+			writeError(w, http.StatusForbidden, "invalid token")
+			return
+		}
+	}
+	o.next.ServeHTTP(w, r)
+}
+
+func (o *authHandler) isValid(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(o.token)) == 1
+}
+
+// getRequestToken returns the token passed in the Authorization header, or in the cookie set for the web console
+func getRequestToken(r *http.Request) (string, bool) {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if !strings.HasPrefix(auth, "Bearer ") {
+			return "", false
+		}
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer ")), true
+	}
+	if cookie, err := r.Cookie(tokenCookie); err == nil {
+		return cookie.Value, true
+	}
+	return "", false
+}
+
+// isLocalHost returns true if host (with an optional port) designates the local machine
+func isLocalHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	_ = openapi.EncodeJSONResponse(openapi.GeneralError{
+		Message: message,
+	}, &status, w)
+}
@@ -0,0 +1,181 @@
+package apiserver_impl
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+const testToken = "a-token"
+
+func TestAuthHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := newAuthHandler(testToken, next)
+
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		host         string
+		header       map[string]string
+		cookie       *http.Cookie
+		wantStatus   int
+		wantLocation string
+	}{
+		{
+			name:       "GET without token",
+			method:     http.MethodGet,
+			target:     "/api/v1/component",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "POST without token",
+			method:     http.MethodPost,
+			target:     "/api/v1/component/command",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "POST with invalid token",
+			method:     http.MethodPost,
+			target:     "/api/v1/component/command",
+			header:     map[string]string{"Authorization": "Bearer another-token"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "POST with token in header",
+			method:     http.MethodPost,
+			target:     "/api/v1/component/command",
+			header:     map[string]string{"Authorization": "Bearer " + testToken},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "PUT with token in cookie",
+			method:     http.MethodPut,
+			target:     "/api/v1/devstate/devfile",
+			cookie:     &http.Cookie{Name: tokenCookie, Value: testToken},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "non local Host",
+			method:     http.MethodGet,
+			target:     "/api/v1/component",
+			host:       "attacker.example.com:20000",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "non local Origin",
+			method:     http.MethodPost,
+			target:     "/api/v1/component/command",
+			header:     map[string]string{"Authorization": "Bearer " + testToken, "Origin": "http://attacker.example.com"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "local Origin",
+			method:     http.MethodDelete,
+			target:     "/api/v1/devstate/container/runtime",
+			header:     map[string]string{"Authorization": "Bearer " + testToken, "Origin": "http://127.0.0.1:20000"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "web console opened with token",
+			method:       http.MethodGet,
+			target:       "/?token=" + testToken + "&tab=yaml",
+			wantStatus:   http.StatusFound,
+			wantLocation: "/?tab=yaml",
+		},
+		{
+			name:       "web console opened with invalid token",
+			method:     http.MethodGet,
+			target:     "/?token=another-token",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			req.Host = "localhost:20000"
+			if tt.host != "" {
+				req.Host = tt.host
+			}
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantLocation != "" {
+				if got := rec.Header().Get("Location"); got != tt.wantLocation {
+					t.Errorf("Location = %q, want %q", got, tt.wantLocation)
+				}
+				cookies := rec.Result().Cookies()
+				if len(cookies) != 1 || cookies[0].Name != tokenCookie || cookies[0].Value != testToken || !cookies[0].HttpOnly {
+					t.Errorf("expected the token to be set in an HttpOnly cookie, got %v", cookies)
+				}
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	cert, fingerprint, err := newSelfSignedCertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewUnstartedServer(newAuthHandler(testToken, next))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.StartTLS()
+	defer server.Close()
+	url := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	tests := []struct {
+		name         string
+		controlPlane api.DevControlPlane
+		wantErr      bool
+		wantStatus   int
+	}{
+		{
+			name:         "valid token and fingerprint",
+			controlPlane: api.DevControlPlane{Secure: true, Token: testToken, CertFingerprint: fingerprint},
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "invalid token",
+			controlPlane: api.DevControlPlane{Secure: true, Token: "another-token", CertFingerprint: fingerprint},
+			wantStatus:   http.StatusForbidden,
+		},
+		{
+			name:         "certificate not matching the fingerprint",
+			controlPlane: api.DevControlPlane{Secure: true, Token: testToken, CertFingerprint: "0123"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewClient(tt.controlPlane).Post(url+"/api/v1/component/command", "application/json", strings.NewReader("{}"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package apiserver_impl
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+// NewClient returns an HTTP client to communicate with the API server of a dev session,
+// as returned by state.Client.GetAPIServerPorts.
+// The client authenticates with the token of the session and, if the API server is served over TLS,
+// accepts only the self-signed certificate of the session
+func NewClient(controlPlane api.DevControlPlane) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if controlPlane.Secure {
+		fingerprint := controlPlane.CertFingerprint
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			// The certificate is self-signed and cannot be verified against a CA, its fingerprint is verified instead
+			InsecureSkipVerify: true, //nolint:gosec
+			VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+				if len(rawCerts) == 0 {
+					return errors.New("no certificate presented by the API server")
+				}
+				if CertificateFingerprint(rawCerts[0]) != fingerprint {
+					return errors.New("the certificate presented by the API server does not match the certificate of the session")
+				}
+				return nil
+			},
+		}
+	}
+	return &http.Client{
+		Transport: &tokenTransport{
+			token: controlPlane.Token,
+			next:  transport,
+		},
+	}
+}
+
+// BaseURL returns the URL of the API of a dev session, with a trailing slash
+func BaseURL(controlPlane api.DevControlPlane) string {
+	return fmt.Sprintf("%s://localhost:%d/%s", controlPlane.Scheme(), controlPlane.LocalPort, strings.TrimPrefix(controlPlane.APIServerPath, "/"))
+}
+
+// tokenTransport adds the token to the requests sent to the API server
+type tokenTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (o *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if o.token == "" {
+		return o.next.RoundTrip(req)
+	}
+	// A RoundTripper must not modify the request
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+o.token)
+	return o.next.RoundTrip(r)
+}
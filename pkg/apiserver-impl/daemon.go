@@ -0,0 +1,266 @@
+package apiserver_impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	openapi "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+	"github\.com/danielpickens/astra/pkg/devsessions"
+	"github\.com/danielpickens/astra/pkg/log"
+)
+
+// Daemon serves the API managing the dev sessions running in the registered project directories,
+// and forwards the requests sent to /api/v1/sessions/{pid}/api/ to the API server of the session
+type Daemon struct {
+	devSessionsClient devsessions.Client
+}
+
+// projectRequest is the body of the requests registering a project directory
+type projectRequest struct {
+	Directory string `json:"directory"`
+}
+
+// sessionRequest is the body of the requests starting a dev session
+type sessionRequest struct {
+	Directory string `json:"directory"`
+	// Args are the arguments passed to `astra dev`
+	Args []string `json:"args,omitempty"`
+}
+
+// sessionResponse is the response of the requests starting a dev session
+type sessionResponse struct {
+	PID int `json:"pid"`
+}
+
+// StartDaemon starts the API server in daemon mode, until ctx is done
+func StartDaemon(
+	ctx context.Context,
+	cancelFunc context.CancelFunc,
+	randomPort bool,
+	port int,
+	withTLS bool,
+	devSessionsClient devsessions.Client,
+) error {
+	daemon := &Daemon{
+		devSessionsClient: devSessionsClient,
+	}
+	router := openapi.NewRouter(daemon)
+
+	fSysSwagger, err := fs.Sub(swaggerFiles, "swagger-ui")
+	if err != nil {
+		// Assertion, error can only happen if the path "swagger-ui" is not valid
+		panic(err)
+	}
+	router.PathPrefix("/swagger-ui/").Handler(http.StripPrefix("/swagger-ui/", http.FileServer(http.FS(fSysSwagger))))
+
+	srv, err := listenAndServe(ctx, cancelFunc, randomPort, port, withTLS, router)
+	if err != nil {
+		return err
+	}
+
+	log.Spinner(fmt.Sprintf("API Server started in daemon mode at %s://localhost:%d/api/v1", srv.scheme, srv.port)).End(true)
+	log.Spinner(fmt.Sprintf("API token (required to modify the state of the sessions): %s", srv.token)).End(true)
+	if withTLS {
+		log.Spinner(fmt.Sprintf("API Server certificate SHA-256 fingerprint: %s", srv.certFingerprint)).End(true)
+	}
+	log.Spinner(fmt.Sprintf("API documentation accessible at %s://localhost:%d/swagger-ui/", srv.scheme, srv.port)).End(true)
+	return nil
+}
+
+func (o *Daemon) Routes() openapi.Routes {
+	routes := openapi.Routes{
+		{
+			Name:        "ProjectsGet",
+			Method:      http.MethodGet,
+			Pattern:     "/api/v1/projects",
+			HandlerFunc: o.projectsGetHandler,
+		},
+		{
+			Name:        "ProjectsPost",
+			Method:      http.MethodPost,
+			Pattern:     "/api/v1/projects",
+			HandlerFunc: o.projectsPostHandler,
+		},
+		{
+			Name:        "ProjectsDelete",
+			Method:      http.MethodDelete,
+			Pattern:     "/api/v1/projects",
+			HandlerFunc: o.projectsDeleteHandler,
+		},
+		{
+			Name:        "SessionsGet",
+			Method:      http.MethodGet,
+			Pattern:     "/api/v1/sessions",
+			HandlerFunc: o.sessionsGetHandler,
+		},
+		{
+			Name:        "SessionsPost",
+			Method:      http.MethodPost,
+			Pattern:     "/api/v1/sessions",
+			HandlerFunc: o.sessionsPostHandler,
+		},
+		{
+			Name:        "SessionsPidDelete",
+			Method:      http.MethodDelete,
+			Pattern:     "/api/v1/sessions/{pid:[0-9]+}",
+			HandlerFunc: o.sessionDeleteHandler,
+		},
+	}
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		routes = append(routes, openapi.Route{
+			Name:        "SessionsPidApi" + method,
+			Method:      method,
+			Pattern:     "/api/v1/sessions/{pid:[0-9]+}/api/{path:.*}",
+			HandlerFunc: o.sessionProxyHandler,
+		})
+	}
+	return routes
+}
+
+func (o *Daemon) projectsGetHandler(w http.ResponseWriter, r *http.Request) {
+	directories, err := o.devSessionsClient.ListDirectories()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("error getting the list of projects: %s", err))
+		return
+	}
+	if directories == nil {
+		directories = []string{}
+	}
+	_ = openapi.EncodeJSONResponse(directories, nil, w)
+}
+
+func (o *Daemon) projectsPostHandler(w http.ResponseWriter, r *http.Request) {
+	var params projectRequest
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil || params.Directory == "" {
+		writeError(w, http.StatusBadRequest, "a directory is required")
+		return
+	}
+	err = o.devSessionsClient.RegisterDirectory(params.Directory)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("error registering the project: %s", err))
+		return
+	}
+	_ = openapi.EncodeJSONResponse(openapi.GeneralSuccess{
+		Message: fmt.Sprintf("project %q registered", params.Directory),
+	}, nil, w)
+}
+
+func (o *Daemon) projectsDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	directory := r.URL.Query().Get("directory")
+	if directory == "" {
+		writeError(w, http.StatusBadRequest, "the directory query parameter is required")
+		return
+	}
+	err := o.devSessionsClient.UnregisterDirectory(directory)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("error unregistering the project: %s", err))
+		return
+	}
+	_ = openapi.EncodeJSONResponse(openapi.GeneralSuccess{
+		Message: fmt.Sprintf("project %q unregistered", directory),
+	}, nil, w)
+}
+
+func (o *Daemon) sessionsGetHandler(w http.ResponseWriter, r *http.Request) {
+	sessions, err := o.devSessionsClient.ListSessions(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("error getting the list of sessions: %s", err))
+		return
+	}
+	_ = openapi.EncodeJSONResponse(sessions, nil, w)
+}
+
+func (o *Daemon) sessionsPostHandler(w http.ResponseWriter, r *http.Request) {
+	var params sessionRequest
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil || params.Directory == "" {
+		writeError(w, http.StatusBadRequest, "a directory is required")
+		return
+	}
+	pid, err := o.devSessionsClient.StartSession(r.Context(), params.Directory, params.Args)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("error starting the session: %s", err))
+		return
+	}
+	// The session is starting, it is listed once its state file is written
+	status := http.StatusAccepted
+	_ = openapi.EncodeJSONResponse(sessionResponse{PID: pid}, &status, w)
+}
+
+func (o *Daemon) sessionDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	pid, err := strconv.Atoi(mux.Vars(r)["pid"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid pid: %s", err))
+		return
+	}
+	err = o.devSessionsClient.StopSession(r.Context(), pid)
+	if err != nil {
+		writeError(w, sessionErrorStatus(err), fmt.Sprintf("error stopping the session: %s", err))
+		return
+	}
+	_ = openapi.EncodeJSONResponse(openapi.GeneralSuccess{
+		Message: fmt.Sprintf("session %d is stopping", pid),
+	}, nil, w)
+}
+
+// sessionProxyHandler forwards the request to the API server of the session
+func (o *Daemon) sessionProxyHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	pid, err := strconv.Atoi(vars["pid"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid pid: %s", err))
+		return
+	}
+	session, err := o.devSessionsClient.GetSession(r.Context(), pid)
+	if err != nil {
+		writeError(w, sessionErrorStatus(err), err.Error())
+		return
+	}
+	if session.APIServer == nil {
+		writeError(w, http.StatusServiceUnavailable, fmt.Sprintf("the API server of session %d is not running", pid))
+		return
+	}
+
+	target, err := url.Parse(BaseURL(*session.APIServer))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	path := vars["path"]
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			req.URL.Path = target.Path + path
+			req.URL.RawPath = ""
+			req.Host = ""
+			// The credentials of the daemon are replaced with the token of the session by the transport
+			req.Header.Del("Authorization")
+			req.Header.Del("Cookie")
+		},
+		Transport: NewClient(*session.APIServer).Transport,
+		// Server-Sent Events must be sent to the client as soon as they are received
+		FlushInterval: -1,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, http.StatusBadGateway, fmt.Sprintf("error contacting the API server of session %d: %s", pid, err))
+		},
+	}
+	proxy.ServeHTTP(w, r)
+}
+
+func sessionErrorStatus(err error) int {
+	if errors.As(err, &devsessions.ErrSessionNotFound{}) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
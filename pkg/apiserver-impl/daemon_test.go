@@ -0,0 +1,105 @@
+package apiserver_impl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	openapi "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+	"github\.com/danielpickens/astra/pkg/devsessions"
+)
+
+func TestDaemon_sessionProxyHandler(t *testing.T) {
+	var gotPath, gotAuthorization string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuthorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"message":"ok"}`))
+	}))
+	defer backend.Close()
+	backendURL, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backendPort, err := strconv.Atoi(backendURL.Port())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := gomock.NewController(t)
+	devSessionsClient := devsessions.NewMockClient(ctrl)
+	devSessionsClient.EXPECT().GetSession(gomock.Any(), 12).Return(api.DevSession{
+		PID:       12,
+		Directory: "/projects/a",
+		APIServer: &api.DevControlPlane{
+			LocalPort:     backendPort,
+			APIServerPath: "/api/v1/",
+			Token:         "session-token",
+		},
+	}, nil)
+	devSessionsClient.EXPECT().GetSession(gomock.Any(), 13).Return(api.DevSession{}, devsessions.NewErrSessionNotFound(13))
+
+	router := openapi.NewRouter(&Daemon{devSessionsClient: devSessionsClient})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/sessions/12/api/component/command", nil)
+	req.Header.Set("Authorization", "Bearer daemon-token")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	if gotPath != "/api/v1/component/command" {
+		t.Errorf("expected the request to be forwarded to /api/v1/component/command, got %q", gotPath)
+	}
+	if gotAuthorization != "Bearer session-token" {
+		t.Errorf("expected the request to be authenticated with the token of the session, got %q", gotAuthorization)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/sessions/13/api/component", nil)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected status %d for an unknown session, got %d", http.StatusNotFound, rec.Code)
+	}
+}
+
+func TestDaemon_sessionsGetHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	devSessionsClient := devsessions.NewMockClient(ctrl)
+	sessions := []api.DevSession{
+		{
+			PID:       12,
+			Directory: "/projects/a",
+			Platform:  "cluster",
+			APIServer: &api.DevControlPlane{
+				LocalPort:     20000,
+				APIServerPath: "/api/v1/",
+				Token:         "session-token",
+			},
+		},
+	}
+	devSessionsClient.EXPECT().ListSessions(gomock.Any()).Return(sessions, nil)
+
+	router := openapi.NewRouter(&Daemon{devSessionsClient: devSessionsClient})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/sessions", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var got []api.DevSession
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	// the tokens of the sessions are never returned
+	sessions[0].APIServer.Token = ""
+	if diff := cmp.Diff(sessions, got); diff != "" {
+		t.Errorf("sessions mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -2,6 +2,7 @@ package apiserver_impl
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -280,6 +281,33 @@ func (s *DevstateApiService) DevstateDevfilePut(ctx context.Context, params open
 	return openapi.Response(http.StatusOK, newContent), nil
 }
 
+func (s *DevstateApiService) DevstateUndoPost(context.Context) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.Undo()
+	if err != nil {
+		return openapi.Response(historyErrorStatus(err), openapi.GeneralError{
+			Message: fmt.Sprintf("Error undoing the last operation: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateRedoPost(context.Context) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.Redo()
+	if err != nil {
+		return openapi.Response(historyErrorStatus(err), openapi.GeneralError{
+			Message: fmt.Sprintf("Error redoing the last operation undone: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func historyErrorStatus(err error) int {
+	if errors.Is(err, devstate.ErrNothingToUndo) || errors.Is(err, devstate.ErrNothingToRedo) {
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
 func (s *DevstateApiService) DevstateDevfileGet(context.Context) (openapi.ImplResponse, error) {
 	newContent, err := s.devfileState.GetContent()
 	if err != nil {
@@ -415,3 +443,173 @@ func (s *DevstateApiService) DevstateContainerContainerNamePatch(ctx context.Con
 	}
 	return openapi.Response(http.StatusOK, newContent), nil
 }
+
+func (s *DevstateApiService) DevstateParentPut(ctx context.Context, parent openapi.Parent) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.SetParent(parent)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error setting the parent: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateParentDelete(context.Context) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.UnsetParent()
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error removing the parent: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateFlattenedGet(context.Context) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.GetFlattenedContent()
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error getting the flattened Devfile content: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateVariablesPut(ctx context.Context, params openapi.DevstateVariablesPutRequest) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.SetVariables(params.Variables)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error updating the variables: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateAttributesPut(ctx context.Context, params openapi.DevstateAttributesPutRequest) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.SetAttributes(params.Attributes)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error updating the attributes: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateProjectPost(ctx context.Context, project openapi.Project) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.AddProject(project)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error adding the project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateProjectProjectNamePatch(ctx context.Context, name string, patch openapi.ProjectPatch) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.PatchProject(name, patch)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error updating the project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateProjectProjectNameDelete(ctx context.Context, name string) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.DeleteProject(name)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error deleting the project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateStarterProjectPost(ctx context.Context, project openapi.StarterProject) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.AddStarterProject(project)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error adding the starter project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateStarterProjectStarterProjectNamePatch(ctx context.Context, name string, patch openapi.StarterProjectPatch) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.PatchStarterProject(name, patch)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error updating the starter project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateStarterProjectStarterProjectNameDelete(ctx context.Context, name string) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.DeleteStarterProject(name)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error deleting the starter project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateDependentProjectPost(ctx context.Context, project openapi.Project) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.AddDependentProject(project)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error adding the dependent project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateDependentProjectDependentProjectNamePatch(ctx context.Context, name string, patch openapi.ProjectPatch) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.PatchDependentProject(name, patch)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error updating the dependent project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstateDependentProjectDependentProjectNameDelete(ctx context.Context, name string) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.DeleteDependentProject(name)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error deleting the dependent project: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstatePluginPost(ctx context.Context, plugin openapi.Plugin) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.AddPlugin(plugin)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error adding the plugin: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstatePluginPluginNamePatch(ctx context.Context, name string, patch openapi.PluginPatch) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.PatchPlugin(name, patch)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error updating the plugin: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
+
+func (s *DevstateApiService) DevstatePluginPluginNameDelete(ctx context.Context, name string) (openapi.ImplResponse, error) {
+	newContent, err := s.devfileState.DeletePlugin(name)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, openapi.GeneralError{
+			Message: fmt.Sprintf("Error deleting the plugin: %s", err),
+		}), nil
+	}
+	return openapi.Response(http.StatusOK, newContent), nil
+}
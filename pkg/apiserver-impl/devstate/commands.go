@@ -24,7 +24,7 @@ func (o *DevfileState) AddExecCommand(name string, component string, commandLine
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("AddExecCommand")
 }
 
 func (o *DevfileState) PatchExecCommand(name string, component string, commandLine string, workingDir string, hotReloadCapable bool) (DevfileContent, error) {
@@ -50,7 +50,7 @@ func (o *DevfileState) PatchExecCommand(name string, component string, commandLi
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("PatchExecCommand")
 }
 
 func (o *DevfileState) AddApplyCommand(name string, component string) (DevfileContent, error) {
@@ -66,7 +66,7 @@ func (o *DevfileState) AddApplyCommand(name string, component string) (DevfileCo
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("AddApplyCommand")
 }
 
 func (o *DevfileState) PatchApplyCommand(name string, component string) (DevfileContent, error) {
@@ -89,7 +89,7 @@ func (o *DevfileState) PatchApplyCommand(name string, component string) (Devfile
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("PatchApplyCommand")
 }
 
 func (o *DevfileState) AddCompositeCommand(name string, parallel bool, commands []string) (DevfileContent, error) {
@@ -106,7 +106,7 @@ func (o *DevfileState) AddCompositeCommand(name string, parallel bool, commands
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("AddCompositeCommand")
 }
 
 func (o *DevfileState) PatchCompositeCommand(name string, parallel bool, commands []string) (DevfileContent, error) {
@@ -130,7 +130,7 @@ func (o *DevfileState) PatchCompositeCommand(name string, parallel bool, command
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("PatchCompositeCommand")
 }
 
 func (o *DevfileState) DeleteCommand(name string) (DevfileContent, error) {
@@ -142,7 +142,7 @@ func (o *DevfileState) DeleteCommand(name string) (DevfileContent, error) {
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("DeleteCommand")
 }
 
 func (o *DevfileState) checkCommandUsed(name string) error {
@@ -189,7 +189,7 @@ func (o *DevfileState) MoveCommand(previousGroup, newGroup string, previousIndex
 			return DevfileContent{}, err
 		}
 	}
-	return o.GetContent()
+	return o.record("MoveCommand")
 }
 
 func subMoveCommand(commands []v1alpha2.Command, previousGroup, newGroup string, previousIndex, newIndex int) (map[string][]v1alpha2.Command, error) {
@@ -235,7 +235,7 @@ func (o *DevfileState) SetDefaultCommand(commandName string, group string) (Devf
 			}
 		}
 	}
-	return o.GetContent()
+	return o.record("SetDefaultCommand")
 }
 
 func (o *DevfileState) UnsetDefaultCommand(commandName string) (DevfileContent, error) {
@@ -254,5 +254,5 @@ func (o *DevfileState) UnsetDefaultCommand(commandName string) (DevfileContent,
 			break
 		}
 	}
-	return o.GetContent()
+	return o.record("UnsetDefaultCommand")
 }
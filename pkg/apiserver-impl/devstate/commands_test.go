@@ -678,6 +678,15 @@ schemaVersion: 2.2.0
 				Images:     []Image{},
 				Resources:  []Resource{},
 				Volumes:    []Volume{},
+				Diagnostics: []Diagnostic{
+					{
+						Severity: SeverityError,
+						Kind:     KindCommand,
+						Name:     "an-exec-command",
+						Field:    "component",
+						Message:  `container "a-container" not found`,
+					},
+				},
 			},
 		},
 		// Tastra: Add test cases.
@@ -766,6 +775,15 @@ schemaVersion: 2.2.0
 				Images:     []Image{},
 				Resources:  []Resource{},
 				Volumes:    []Volume{},
+				Diagnostics: []Diagnostic{
+					{
+						Severity: SeverityError,
+						Kind:     KindCommand,
+						Name:     "an-exec-command",
+						Field:    "component",
+						Message:  `container "a-container" not found`,
+					},
+				},
 			},
 		},
 		// Tastra: Add test cases.
@@ -856,6 +874,15 @@ schemaVersion: 2.2.0
 				Images:     []Image{},
 				Resources:  []Resource{},
 				Volumes:    []Volume{},
+				Diagnostics: []Diagnostic{
+					{
+						Severity: SeverityError,
+						Kind:     KindCommand,
+						Name:     "an-exec-command",
+						Field:    "component",
+						Message:  `container "a-container" not found`,
+					},
+				},
 			},
 		},
 		// Tastra: Add test cases.
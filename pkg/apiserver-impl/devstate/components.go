@@ -56,7 +56,7 @@ func (o *DevfileState) AddContainer(
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("AddContainer")
 }
 
 func (o *DevfileState) PatchContainer(
@@ -113,7 +113,7 @@ func (o *DevfileState) PatchContainer(
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("PatchContainer")
 }
 
 func tov1alpha2EnvVars(envs []Env) []v1alpha2.EnvVar {
@@ -181,7 +181,7 @@ func (o *DevfileState) DeleteContainer(name string) (DevfileContent, error) {
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("DeleteContainer")
 }
 
 func (o *DevfileState) checkContainerUsed(name string) error {
@@ -233,7 +233,7 @@ func (o *DevfileState) AddImage(name string, imageName string, args []string, bu
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("AddImage")
 }
 
 func (o *DevfileState) PatchImage(name string, imageName string, args []string, buildContext string, rootRequired bool, uri string, autoBuild string) (DevfileContent, error) {
@@ -272,7 +272,7 @@ func (o *DevfileState) PatchImage(name string, imageName string, args []string,
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("PatchImage")
 }
 
 func (o *DevfileState) DeleteImage(name string) (DevfileContent, error) {
@@ -288,7 +288,7 @@ func (o *DevfileState) DeleteImage(name string) (DevfileContent, error) {
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("DeleteImage")
 }
 
 func (o *DevfileState) checkImageUsed(name string) error {
@@ -335,7 +335,7 @@ func (o *DevfileState) AddResource(name string, inlined string, uri string, depl
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("AddResource")
 }
 
 func (o *DevfileState) PatchResource(name string, inlined string, uri string, deployByDefault string) (DevfileContent, error) {
@@ -370,7 +370,7 @@ func (o *DevfileState) PatchResource(name string, inlined string, uri string, de
 		return DevfileContent{}, err
 	}
 
-	return o.GetContent()
+	return o.record("PatchResource")
 }
 
 func (o *DevfileState) DeleteResource(name string) (DevfileContent, error) {
@@ -385,7 +385,7 @@ func (o *DevfileState) DeleteResource(name string) (DevfileContent, error) {
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("DeleteResource")
 }
 
 func (o *DevfileState) checkResourceUsed(name string) error {
@@ -421,7 +421,7 @@ func (o *DevfileState) AddVolume(name string, ephemeral bool, size string) (Devf
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("AddVolume")
 }
 
 func (o *DevfileState) PatchVolume(name string, ephemeral bool, size string) (DevfileContent, error) {
@@ -446,7 +446,7 @@ func (o *DevfileState) PatchVolume(name string, ephemeral bool, size string) (De
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("PatchVolume")
 }
 
 func (o *DevfileState) DeleteVolume(name string) (DevfileContent, error) {
@@ -460,7 +460,7 @@ func (o *DevfileState) DeleteVolume(name string) (DevfileContent, error) {
 	if err != nil {
 		return DevfileContent{}, err
 	}
-	return o.GetContent()
+	return o.record("DeleteVolume")
 }
 
 func (o *DevfileState) checkVolumeUsed(name string) error {
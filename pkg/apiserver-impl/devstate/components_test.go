@@ -106,6 +106,15 @@ schemaVersion: 2.2.0
 				Resources: []Resource{},
 				Volumes:   []Volume{},
 				Events:    Events{},
+				Diagnostics: []Diagnostic{
+					{
+						Severity: SeverityError,
+						Kind:     KindContainer,
+						Name:     "a-name",
+						Field:    "volumeMounts[0]",
+						Message:  `volume "vol1" not found`,
+					},
+				},
 			},
 		},
 		{
@@ -179,6 +188,15 @@ schemaVersion: 2.2.0
 				Resources: []Resource{},
 				Volumes:   []Volume{},
 				Events:    Events{},
+				Diagnostics: []Diagnostic{
+					{
+						Severity: SeverityError,
+						Kind:     KindContainer,
+						Name:     "a-name",
+						Field:    "volumeMounts[0]",
+						Message:  `volume "vol1" not found`,
+					},
+				},
 			},
 		},
 		// Tastra: Add test cases.
@@ -709,6 +727,14 @@ schemaVersion: 2.2.0
 					},
 				},
 				Events: Events{},
+				Diagnostics: []Diagnostic{
+					{
+						Severity: SeverityWarning,
+						Kind:     KindVolume,
+						Name:     "a-name",
+						Message:  "the volume is not mounted by any container",
+					},
+				},
 			},
 		},
 	}
@@ -53,16 +53,49 @@ func (o *DevfileState) GetContent() (DevfileContent, error) {
 		return DevfileContent{}, errors.New("error getting volumes")
 	}
 
+	projects, err := o.getProjects()
+	if err != nil {
+		return DevfileContent{}, errors.New("error getting projects")
+	}
+
+	starterProjects, err := o.getStarterProjects()
+	if err != nil {
+		return DevfileContent{}, errors.New("error getting starter projects")
+	}
+
+	plugins, err := o.getPlugins()
+	if err != nil {
+		return DevfileContent{}, errors.New("error getting plugins")
+	}
+
+	attributes, err := o.getAttributes()
+	if err != nil {
+		return DevfileContent{}, fmt.Errorf("error getting attributes: %w", err)
+	}
+
+	diagnostics, err := o.getDiagnostics()
+	if err != nil {
+		return DevfileContent{}, fmt.Errorf("error validating the Devfile: %w", err)
+	}
+
 	return DevfileContent{
-		Content:    string(result),
-		Version:    o.Devfile.Data.GetSchemaVersion(),
-		Commands:   commands,
-		Containers: containers,
-		Images:     images,
-		Resources:  resources,
-		Volumes:    volumes,
-		Events:     o.getEvents(),
-		Metadata:   o.getMetadata(),
+		Content:           string(result),
+		Version:           o.Devfile.Data.GetSchemaVersion(),
+		Commands:          commands,
+		Containers:        containers,
+		Images:            images,
+		Resources:         resources,
+		Volumes:           volumes,
+		Events:            o.getEvents(),
+		Metadata:          o.getMetadata(),
+		Diagnostics:       diagnostics,
+		Parent:            o.getParent(),
+		Variables:         o.getVariables(),
+		Attributes:        attributes,
+		Projects:          projects,
+		StarterProjects:   starterProjects,
+		DependentProjects: o.getDependentProjects(),
+		Plugins:           plugins,
 	}, nil
 }
 
@@ -336,3 +369,101 @@ func (o *DevfileState) getEvents() Events {
 		PostStop:  events.PostStop,
 	}
 }
+
+func (o *DevfileState) getParent() Parent {
+	parent := o.Devfile.Data.GetParent()
+	if parent == nil {
+		return Parent{}
+	}
+	return Parent{
+		Uri:         parent.Uri,
+		Id:          parent.Id,
+		RegistryUrl: parent.RegistryUrl,
+		Version:     parent.Version,
+	}
+}
+
+func (o *DevfileState) getVariables() map[string]string {
+	variables := o.Devfile.Data.GetDevfileWorkspaceSpecContent().Variables
+	if len(variables) == 0 {
+		return nil
+	}
+	return variables
+}
+
+func (o *DevfileState) getAttributes() (map[string]interface{}, error) {
+	attributes := o.Devfile.Data.GetDevfileWorkspaceSpecContent().Attributes
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]interface{}, len(attributes))
+	for key := range attributes {
+		var err error
+		result[key] = attributes.Get(key, &err)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (o *DevfileState) getProjects() ([]Project, error) {
+	projects, err := o.Devfile.Data.GetProjects(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return getProjectList(projects), nil
+}
+
+func (o *DevfileState) getDependentProjects() []Project {
+	return getProjectList(o.Devfile.Data.GetDevfileWorkspaceSpecContent().DependentProjects)
+}
+
+func getProjectList(projects []v1alpha2.Project) []Project {
+	var result []Project
+	for _, project := range projects {
+		newProject := Project{
+			Name:      project.Name,
+			ClonePath: project.ClonePath,
+		}
+		newProject.Type, newProject.Remotes, newProject.Remote, newProject.Revision, newProject.ZipLocation = getProjectSource(project.ProjectSource)
+		result = append(result, newProject)
+	}
+	return result
+}
+
+func (o *DevfileState) getStarterProjects() ([]StarterProject, error) {
+	starterProjects, err := o.Devfile.Data.GetStarterProjects(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var result []StarterProject
+	for _, project := range starterProjects {
+		newProject := StarterProject{
+			Name:        project.Name,
+			Description: project.Description,
+			SubDir:      project.SubDir,
+		}
+		newProject.Type, newProject.Remotes, newProject.Remote, newProject.Revision, newProject.ZipLocation = getProjectSource(project.ProjectSource)
+		result = append(result, newProject)
+	}
+	return result, nil
+}
+
+func getProjectSource(source v1alpha2.ProjectSource) (projectType string, remotes map[string]string, remote string, revision string, zipLocation string) {
+	switch {
+	case source.Git != nil:
+		projectType = projectTypeGit
+		remotes = source.Git.Remotes
+		if source.Git.CheckoutFrom != nil {
+			remote = source.Git.CheckoutFrom.Remote
+			revision = source.Git.CheckoutFrom.Revision
+		}
+	case source.Zip != nil:
+		projectType = projectTypeZip
+		zipLocation = source.Zip.Location
+	case source.Custom != nil:
+		projectType = projectTypeCustom
+	}
+	return projectType, remotes, remote, revision, zipLocation
+}
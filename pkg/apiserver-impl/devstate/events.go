@@ -15,5 +15,5 @@ func (o *DevfileState) UpdateEvents(event string, commands []string) (DevfileCon
 	case "preStop":
 		o.Devfile.Data.UpdateEvents(nil, nil, nil, commands)
 	}
-	return o.GetContent()
+	return o.record("UpdateEvents")
 }
@@ -44,6 +44,15 @@ schemaVersion: 2.2.0
 				Events: Events{
 					PreStart: []string{"command1"},
 				},
+				Diagnostics: []Diagnostic{
+					{
+						Severity: SeverityError,
+						Kind:     KindEvent,
+						Name:     "preStart",
+						Field:    "commands[0]",
+						Message:  `command "command1" not found`,
+					},
+				},
 			},
 		}, {
 			name: "set postStart event when preStart is already set",
@@ -78,6 +87,22 @@ schemaVersion: 2.2.0
 					PreStart:  []string{"command1"},
 					PostStart: []string{"command2"},
 				},
+				Diagnostics: []Diagnostic{
+					{
+						Severity: SeverityError,
+						Kind:     KindEvent,
+						Name:     "preStart",
+						Field:    "commands[0]",
+						Message:  `command "command1" not found`,
+					},
+					{
+						Severity: SeverityError,
+						Kind:     KindEvent,
+						Name:     "postStart",
+						Field:    "commands[0]",
+						Message:  `command "command2" not found`,
+					},
+				},
 			},
 		},
 		// Tastra: Add test cases.
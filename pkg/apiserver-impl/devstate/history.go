@@ -0,0 +1,106 @@
+package devstate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	context "github.com/devfile/library/v2/pkg/devfile/parser/context"
+	"k8s.io/utils/pointer"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+// maxOperations is the maximum number of operations kept in the history
+const maxOperations = 100
+
+var (
+	ErrNothingToUndo = errors.New("no operation to undo")
+	ErrNothingToRedo = errors.New("no operation to redo")
+)
+
+// operation is a mutation of the Devfile, recorded in the history of the state
+type operation struct {
+	// name is the name of the method having modified the Devfile (AddContainer, MoveCommand, etc)
+	name string
+	// content is the YAML content of the Devfile after the operation
+	content string
+}
+
+// history contains the operations applied to the Devfile.
+// The first operation is the initial content of the Devfile, and cannot be undone
+type history struct {
+	operations []operation
+	// current is the index of the operation corresponding to the current content of the Devfile;
+	// the operations after current have been undone and can be redone
+	current int
+}
+
+// record returns the content of the Devfile after a mutation, and records the mutation in the history.
+// The operations undone before the mutation cannot be redone anymore
+func (o *DevfileState) record(name string) (DevfileContent, error) {
+	content, err := o.GetContent()
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	op := operation{
+		name:    name,
+		content: content.Content,
+	}
+	if len(o.history.operations) == 0 {
+		o.history.operations = []operation{op}
+		o.history.current = 0
+		return content, nil
+	}
+	o.history.operations = append(o.history.operations[:o.history.current+1], op)
+	if len(o.history.operations) > maxOperations {
+		o.history.operations = o.history.operations[len(o.history.operations)-maxOperations:]
+	}
+	o.history.current = len(o.history.operations) - 1
+	return content, nil
+}
+
+// Undo reverts the last operation applied to the Devfile
+func (o *DevfileState) Undo() (DevfileContent, error) {
+	if o.history.current == 0 {
+		return DevfileContent{}, ErrNothingToUndo
+	}
+	err := o.restore(o.history.operations[o.history.current-1].content)
+	if err != nil {
+		return DevfileContent{}, fmt.Errorf("unable to undo operation %s: %w", o.history.operations[o.history.current].name, err)
+	}
+	o.history.current--
+	return o.GetContent()
+}
+
+// Redo applies again the last operation undone
+func (o *DevfileState) Redo() (DevfileContent, error) {
+	if o.history.current >= len(o.history.operations)-1 {
+		return DevfileContent{}, ErrNothingToRedo
+	}
+	op := o.history.operations[o.history.current+1]
+	err := o.restore(op.content)
+	if err != nil {
+		return DevfileContent{}, fmt.Errorf("unable to redo operation %s: %w", op.name, err)
+	}
+	o.history.current++
+	return o.GetContent()
+}
+
+// restore replaces the Devfile with a content recorded in the history.
+// The content is not validated, as the Devfile can be in an invalid state between two operations
+func (o *DevfileState) restore(content string) error {
+	parserArgs := parser.ParserArgs{
+		Data:                          []byte(content),
+		FlattenedDevfile:              pointer.Bool(false),
+		ConvertKubernetesContentInUri: pointer.Bool(false),
+		SetBooleanDefaults:            pointer.Bool(false),
+	}
+	devfile, err := parser.ParseDevfile(parserArgs)
+	if err != nil {
+		return fmt.Errorf("error parsing devfile YAML: %w", err)
+	}
+	o.Devfile = devfile
+	o.Devfile.Ctx = context.FakeContext(o.FS, o.Devfile.Ctx.GetAbsPath())
+	return nil
+}
@@ -0,0 +1,93 @@
+package devstate
+
+import (
+	"errors"
+	"testing"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+func TestDevfileState_UndoRedo(t *testing.T) {
+	state := NewDevfileState()
+	getNames := func(content DevfileContent) (containers []string, volumes []string) {
+		for _, c := range content.Containers {
+			containers = append(containers, c.Name)
+		}
+		for _, v := range content.Volumes {
+			volumes = append(volumes, v.Name)
+		}
+		return containers, volumes
+	}
+	check := func(content DevfileContent, err error, wantContainers int, wantVolumes int) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		containers, volumes := getNames(content)
+		if len(containers) != wantContainers || len(volumes) != wantVolumes {
+			t.Errorf("expected %d containers and %d volumes, got %v and %v", wantContainers, wantVolumes, containers, volumes)
+		}
+	}
+
+	_, err := state.Undo()
+	if !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("expected ErrNothingToUndo on a new state, got %v", err)
+	}
+
+	content, err := state.AddContainer("a-container", "an-image", nil, nil, nil, "", "", "", "", nil, false, false, "", Annotation{}, nil)
+	check(content, err, 1, 0)
+	content, err = state.AddVolume("a-volume", false, "1Gi")
+	check(content, err, 1, 1)
+
+	content, err = state.Undo()
+	check(content, err, 1, 0)
+	content, err = state.Undo()
+	check(content, err, 0, 0)
+	_, err = state.Undo()
+	if !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("expected ErrNothingToUndo once all the operations are undone, got %v", err)
+	}
+
+	content, err = state.Redo()
+	check(content, err, 1, 0)
+
+	// a new operation discards the operations undone
+	content, err = state.AddVolume("another-volume", true, "")
+	check(content, err, 1, 1)
+	_, err = state.Redo()
+	if !errors.Is(err, ErrNothingToRedo) {
+		t.Errorf("expected ErrNothingToRedo after a new operation, got %v", err)
+	}
+	content, err = state.Undo()
+	check(content, err, 1, 0)
+}
+
+func TestDevfileState_UndoAfterLoad(t *testing.T) {
+	state := NewDevfileState()
+	_, err := state.SetDevfileContent(`schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: an-image
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the loaded Devfile is the initial content of the history
+	_, err = state.Undo()
+	if !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("expected ErrNothingToUndo after loading a Devfile, got %v", err)
+	}
+
+	_, err = state.AddVolume("a-volume", false, "1Gi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := state.Undo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(content.Containers) != 1 || len(content.Volumes) != 0 {
+		t.Errorf("expected the loaded Devfile after undo, got %d containers and %d volumes", len(content.Containers), len(content.Volumes))
+	}
+}
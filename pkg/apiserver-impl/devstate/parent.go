@@ -0,0 +1,90 @@
+package devstate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	context "github.com/devfile/library/v2/pkg/devfile/parser/context"
+	"github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	"k8s.io/utils/pointer"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+// SetParent sets the reference to the parent Devfile.
+// The overrides of the elements of the parent defined in the Devfile are kept
+func (o *DevfileState) SetParent(parent Parent) (DevfileContent, error) {
+	reference, err := toImportReference("parent", parent.Uri, parent.Id, parent.RegistryUrl, parent.Version)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	newParent := &v1alpha2.Parent{}
+	if current := o.Devfile.Data.GetParent(); current != nil {
+		newParent.ParentOverrides = current.ParentOverrides
+	}
+	newParent.ImportReference = reference
+	o.Devfile.Data.SetParent(newParent)
+	return o.record("SetParent")
+}
+
+// toImportReference returns the reference to the Devfile of a parent or of a plugin, referenced either by URI or by Id in a registry
+func toImportReference(kind string, uri string, id string, registryUrl string, version string) (v1alpha2.ImportReference, error) {
+	if (uri == "") == (id == "") {
+		return v1alpha2.ImportReference{}, fmt.Errorf("either a URI or an Id must be defined for the %s", kind)
+	}
+	if uri != "" && (registryUrl != "" || version != "") {
+		return v1alpha2.ImportReference{}, fmt.Errorf("the registry URL and version can be defined only when the %s is referenced by Id", kind)
+	}
+	return v1alpha2.ImportReference{
+		ImportReferenceUnion: v1alpha2.ImportReferenceUnion{
+			Uri: uri,
+			Id:  id,
+		},
+		RegistryUrl: registryUrl,
+		Version:     version,
+	}, nil
+}
+
+// UnsetParent removes the reference to the parent Devfile, and the overrides of its elements
+func (o *DevfileState) UnsetParent() (DevfileContent, error) {
+	if o.Devfile.Data.GetParent() == nil {
+		return DevfileContent{}, errors.New("the Devfile has no parent")
+	}
+	o.Devfile.Data.SetParent(nil)
+	return o.record("UnsetParent")
+}
+
+// GetFlattenedContent returns the content of the Devfile after the elements of its parent and of its plugins
+// have been merged with the elements and overrides defined locally.
+// The parent and plugin Devfiles are fetched each time this method is called.
+// The diagnostics are computed on the flattened Devfile, including the references to commands, components and volumes
+func (o *DevfileState) GetFlattenedContent() (DevfileContent, error) {
+	content, err := o.GetContent()
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	if o.Devfile.Data.GetParent() == nil && len(content.Plugins) == 0 {
+		return content, nil
+	}
+
+	parserArgs := parser.ParserArgs{
+		Data:                          []byte(content.Content),
+		FlattenedDevfile:              pointer.Bool(true),
+		ConvertKubernetesContentInUri: pointer.Bool(false),
+		SetBooleanDefaults:            pointer.Bool(false),
+		// only the content of the imported Devfiles is needed, not the resources of their repositories
+		DownloadGitResources: pointer.Bool(false),
+	}
+	devfile, err := parser.ParseDevfile(parserArgs)
+	if err != nil {
+		return DevfileContent{}, fmt.Errorf("error flattening the Devfile: %w", err)
+	}
+	flattened := DevfileState{
+		Devfile: devfile,
+		FS:      filesystem.NewFakeFs(),
+	}
+	flattened.Devfile.Ctx = context.FakeContext(flattened.FS, flattened.Devfile.Ctx.GetAbsPath())
+	return flattened.GetContent()
+}
@@ -0,0 +1,115 @@
+package devstate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+func TestDevfileState_SetParent(t *testing.T) {
+	state := NewDevfileState()
+	_, err := state.SetDevfileContent(`schemaVersion: 2.2.0
+parent:
+  uri: https://example.com/parent.yaml
+  components:
+  - name: runtime
+    container:
+      image: an-image
+variables:
+  VERSION: "1.0"
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: ./run --version {{VERSION}}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := state.SetParent(Parent{Id: "nodejs", RegistryUrl: "https://registry.devfile.io", Version: "2.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the overrides of the parent and the references to variables are kept
+	wantContent := `commands:
+- exec:
+    commandLine: ./run --version {{VERSION}}
+    component: runtime
+  id: run
+metadata: {}
+parent:
+  components:
+  - container:
+      image: an-image
+    name: runtime
+  id: nodejs
+  registryUrl: https://registry.devfile.io
+  version: 2.1.1
+schemaVersion: 2.2.0
+variables:
+  VERSION: "1.0"
+`
+	if diff := cmp.Diff(wantContent, got.Content); diff != "" {
+		t.Errorf("Content mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(Parent{Id: "nodejs", RegistryUrl: "https://registry.devfile.io", Version: "2.1.1"}, got.Parent); diff != "" {
+		t.Errorf("Parent mismatch (-want +got):\n%s", diff)
+	}
+	// the command referencing a component of the parent is not reported
+	if len(got.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostic, got %v", got.Diagnostics)
+	}
+
+	_, err = state.SetParent(Parent{Uri: "https://example.com/parent.yaml", Version: "2.1.1"})
+	if err == nil {
+		t.Errorf("expected an error setting a version for a parent referenced by URI")
+	}
+
+	got, err = state.UnsetParent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Parent != (Parent{}) {
+		t.Errorf("expected no parent, got %v", got.Parent)
+	}
+	_, err = state.UnsetParent()
+	if err == nil {
+		t.Errorf("expected an error removing a parent not defined")
+	}
+}
+
+func TestDevfileState_SetVariablesAndAttributes(t *testing.T) {
+	state := NewDevfileState()
+	_, err := state.SetVariables(map[string]string{"VERSION": "1.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := state.SetAttributes(map[string]interface{}{"an-attribute": "a value"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantContent := `attributes:
+  an-attribute: a value
+metadata: {}
+schemaVersion: 2.2.0
+variables:
+  VERSION: "1.0"
+`
+	if diff := cmp.Diff(wantContent, got.Content); diff != "" {
+		t.Errorf("Content mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"an-attribute": "a value"}, got.Attributes); diff != "" {
+		t.Errorf("Attributes mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err = state.SetVariables(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Variables != nil {
+		t.Errorf("expected no variable, got %v", got.Variables)
+	}
+}
@@ -0,0 +1,111 @@
+package devstate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+// AddPlugin adds a plugin component, referencing a Devfile whose elements are merged into the Devfile when it is flattened.
+// Plugin components are defined by the schema version 2.0 only
+func (o *DevfileState) AddPlugin(plugin Plugin) (DevfileContent, error) {
+	if version := o.Devfile.Data.GetSchemaVersion(); !strings.HasPrefix(version, "2.0.") {
+		return DevfileContent{}, fmt.Errorf("plugin components are not supported by the schema version %s of the Devfile, only by the schema version 2.0", version)
+	}
+	reference, err := toImportReference("plugin", plugin.Uri, plugin.Id, plugin.RegistryUrl, plugin.Version)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	err = o.Devfile.Data.AddComponents([]v1alpha2.Component{
+		{
+			Name: plugin.Name,
+			ComponentUnion: v1alpha2.ComponentUnion{
+				Plugin: &v1alpha2.PluginComponent{
+					ImportReference: reference,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("AddPlugin")
+}
+
+// PatchPlugin changes the reference to the Devfile of a plugin component.
+// The overrides of the elements of the plugin defined in the Devfile are kept
+func (o *DevfileState) PatchPlugin(name string, patch PluginPatch) (DevfileContent, error) {
+	reference, err := toImportReference("plugin", patch.Uri, patch.Id, patch.RegistryUrl, patch.Version)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	found, err := o.Devfile.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{
+			ComponentType: v1alpha2.PluginComponentType,
+		},
+		FilterByName: name,
+	})
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	if len(found) != 1 {
+		return DevfileContent{}, fmt.Errorf("%d Plugin found with name %q", len(found), name)
+	}
+
+	plugin := found[0]
+	plugin.Plugin.ImportReference = reference
+
+	err = o.Devfile.Data.UpdateComponent(plugin)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("PatchPlugin")
+}
+
+// DeletePlugin removes a plugin component and the overrides of its elements
+func (o *DevfileState) DeletePlugin(name string) (DevfileContent, error) {
+	found, err := o.Devfile.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{
+			ComponentType: v1alpha2.PluginComponentType,
+		},
+		FilterByName: name,
+	})
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	if len(found) != 1 {
+		return DevfileContent{}, fmt.Errorf("%d Plugin found with name %q", len(found), name)
+	}
+
+	err = o.Devfile.Data.DeleteComponent(name)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("DeletePlugin")
+}
+
+func (o *DevfileState) getPlugins() ([]Plugin, error) {
+	plugins, err := o.Devfile.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{
+			ComponentType: v1alpha2.PluginComponentType,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result []Plugin
+	for _, plugin := range plugins {
+		result = append(result, Plugin{
+			Name:        plugin.Name,
+			Uri:         plugin.Plugin.Uri,
+			Id:          plugin.Plugin.Id,
+			RegistryUrl: plugin.Plugin.RegistryUrl,
+			Version:     plugin.Plugin.Version,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package devstate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+func TestDevfileState_Plugins(t *testing.T) {
+	state := NewDevfileState()
+	_, err := state.AddPlugin(Plugin{Name: "node", Id: "nodejs"})
+	if err == nil {
+		t.Errorf("expected an error adding a plugin to a Devfile with the schema version 2.2.0")
+	}
+
+	_, err = state.SetDevfileContent(`schemaVersion: 2.0.0
+components:
+- name: java
+  plugin:
+    uri: https://example.com/java-plugin.yaml
+    components:
+    - name: jdk
+      container:
+        image: an-image
+commands:
+- id: build
+  exec:
+    component: jdk
+    commandLine: mvn package
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := state.AddPlugin(Plugin{Name: "node", Id: "nodejs", RegistryUrl: "https://registry.devfile.io"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPlugins := []Plugin{
+		{Name: "java", Uri: "https://example.com/java-plugin.yaml"},
+		{Name: "node", Id: "nodejs", RegistryUrl: "https://registry.devfile.io"},
+	}
+	if diff := cmp.Diff(wantPlugins, got.Plugins); diff != "" {
+		t.Errorf("Plugins mismatch (-want +got):\n%s", diff)
+	}
+	// the command referencing a component of a plugin is not reported
+	if len(got.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostic, got %v", got.Diagnostics)
+	}
+
+	got, err = state.PatchPlugin("java", PluginPatch{Id: "java-maven", Version: "1.2.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the overrides of the plugin are kept
+	wantContent := `commands:
+- exec:
+    commandLine: mvn package
+    component: jdk
+  id: build
+components:
+- name: java
+  plugin:
+    components:
+    - container:
+        image: an-image
+      name: jdk
+    id: java-maven
+    version: 1.2.0
+- name: node
+  plugin:
+    id: nodejs
+    registryUrl: https://registry.devfile.io
+metadata: {}
+schemaVersion: 2.0.0
+`
+	if diff := cmp.Diff(wantContent, got.Content); diff != "" {
+		t.Errorf("Content mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = state.PatchPlugin("java", PluginPatch{Uri: "https://example.com/java-plugin.yaml", Id: "java-maven"})
+	if err == nil {
+		t.Errorf("expected an error referencing a plugin by both URI and Id")
+	}
+	_, err = state.AddPlugin(Plugin{Name: "node", Id: "nodejs"})
+	if err == nil {
+		t.Errorf("expected an error adding a plugin with the name of an existing component")
+	}
+
+	got, err = state.DeletePlugin("node")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]Plugin{{Name: "java", Id: "java-maven", Version: "1.2.0"}}, got.Plugins); diff != "" {
+		t.Errorf("Plugins mismatch (-want +got):\n%s", diff)
+	}
+	_, err = state.DeletePlugin("node")
+	if err == nil {
+		t.Errorf("expected an error deleting a plugin not defined")
+	}
+}
+
+func TestDevfileState_GetFlattenedContent_diagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`schemaVersion: 2.0.0
+components:
+- name: jdk
+  container:
+    image: an-image
+`))
+	}))
+	defer server.Close()
+
+	state := NewDevfileState()
+	content, err := state.SetDevfileContent(`schemaVersion: 2.0.0
+components:
+- name: java
+  plugin:
+    uri: ` + server.URL + `/plugin.yaml
+commands:
+- id: build
+  exec:
+    component: jdk
+    commandLine: mvn package
+- id: test
+  exec:
+    component: missing
+    commandLine: mvn test
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the references are not checked before the Devfile is flattened
+	if len(content.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostic, got %v", content.Diagnostics)
+	}
+
+	flattened, err := state.GetFlattenedContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, d := range flattened.Diagnostics {
+		names = append(names, d.Kind+"/"+d.Name)
+	}
+	if diff := cmp.Diff([]string{"command/test"}, names); diff != "" {
+		t.Errorf("Diagnostics mismatch (-want +got):\n%s", diff)
+	}
+}
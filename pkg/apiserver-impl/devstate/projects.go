@@ -0,0 +1,217 @@
+package devstate
+
+import (
+	"fmt"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+const (
+	projectTypeGit    = "git"
+	projectTypeZip    = "zip"
+	projectTypeCustom = "custom"
+)
+
+func (o *DevfileState) AddProject(project Project) (DevfileContent, error) {
+	source, err := tov1alpha2ProjectSource(project.Type, project.Remotes, project.Remote, project.Revision, project.ZipLocation)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	err = o.Devfile.Data.AddProjects([]v1alpha2.Project{
+		{
+			Name:          project.Name,
+			ClonePath:     project.ClonePath,
+			ProjectSource: source,
+		},
+	})
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("AddProject")
+}
+
+func (o *DevfileState) PatchProject(name string, patch ProjectPatch) (DevfileContent, error) {
+	found, err := o.Devfile.Data.GetProjects(common.DevfileOptions{
+		FilterByName: name,
+	})
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	if len(found) != 1 {
+		return DevfileContent{}, fmt.Errorf("%d Project found with name %q", len(found), name)
+	}
+
+	project := found[0]
+	project.ClonePath = patch.ClonePath
+	project.ProjectSource, err = tov1alpha2ProjectSource(patch.Type, patch.Remotes, patch.Remote, patch.Revision, patch.ZipLocation)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+
+	err = o.Devfile.Data.UpdateProject(project)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("PatchProject")
+}
+
+func (o *DevfileState) DeleteProject(name string) (DevfileContent, error) {
+	err := o.Devfile.Data.DeleteProject(name)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("DeleteProject")
+}
+
+func (o *DevfileState) AddStarterProject(project StarterProject) (DevfileContent, error) {
+	source, err := tov1alpha2ProjectSource(project.Type, project.Remotes, project.Remote, project.Revision, project.ZipLocation)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	err = o.Devfile.Data.AddStarterProjects([]v1alpha2.StarterProject{
+		{
+			Name:          project.Name,
+			Description:   project.Description,
+			SubDir:        project.SubDir,
+			ProjectSource: source,
+		},
+	})
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("AddStarterProject")
+}
+
+func (o *DevfileState) PatchStarterProject(name string, patch StarterProjectPatch) (DevfileContent, error) {
+	found, err := o.Devfile.Data.GetStarterProjects(common.DevfileOptions{
+		FilterByName: name,
+	})
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	if len(found) != 1 {
+		return DevfileContent{}, fmt.Errorf("%d Starter Project found with name %q", len(found), name)
+	}
+
+	project := found[0]
+	project.Description = patch.Description
+	project.SubDir = patch.SubDir
+	project.ProjectSource, err = tov1alpha2ProjectSource(patch.Type, patch.Remotes, patch.Remote, patch.Revision, patch.ZipLocation)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+
+	err = o.Devfile.Data.UpdateStarterProject(project)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("PatchStarterProject")
+}
+
+func (o *DevfileState) DeleteStarterProject(name string) (DevfileContent, error) {
+	err := o.Devfile.Data.DeleteStarterProject(name)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	return o.record("DeleteStarterProject")
+}
+
+// The Devfile library does not give access to the dependent projects,
+// they are modified directly in the content of the Devfile
+
+func (o *DevfileState) AddDependentProject(project Project) (DevfileContent, error) {
+	content := o.Devfile.Data.GetDevfileWorkspaceSpecContent()
+	for _, dependentProject := range content.DependentProjects {
+		if dependentProject.Name == project.Name {
+			return DevfileContent{}, fmt.Errorf("dependent project %q already exists", project.Name)
+		}
+	}
+	source, err := tov1alpha2ProjectSource(project.Type, project.Remotes, project.Remote, project.Revision, project.ZipLocation)
+	if err != nil {
+		return DevfileContent{}, err
+	}
+	content.DependentProjects = append(content.DependentProjects, v1alpha2.Project{
+		Name:          project.Name,
+		ClonePath:     project.ClonePath,
+		ProjectSource: source,
+	})
+	return o.record("AddDependentProject")
+}
+
+func (o *DevfileState) PatchDependentProject(name string, patch ProjectPatch) (DevfileContent, error) {
+	content := o.Devfile.Data.GetDevfileWorkspaceSpecContent()
+	for i := range content.DependentProjects {
+		project := &content.DependentProjects[i]
+		if project.Name != name {
+			continue
+		}
+		source, err := tov1alpha2ProjectSource(patch.Type, patch.Remotes, patch.Remote, patch.Revision, patch.ZipLocation)
+		if err != nil {
+			return DevfileContent{}, err
+		}
+		project.ClonePath = patch.ClonePath
+		project.ProjectSource = source
+		return o.record("PatchDependentProject")
+	}
+	return DevfileContent{}, fmt.Errorf("dependent project %q not found", name)
+}
+
+func (o *DevfileState) DeleteDependentProject(name string) (DevfileContent, error) {
+	content := o.Devfile.Data.GetDevfileWorkspaceSpecContent()
+	for i, project := range content.DependentProjects {
+		if project.Name != name {
+			continue
+		}
+		content.DependentProjects = append(content.DependentProjects[:i], content.DependentProjects[i+1:]...)
+		if len(content.DependentProjects) == 0 {
+			content.DependentProjects = nil
+		}
+		return o.record("DeleteDependentProject")
+	}
+	return DevfileContent{}, fmt.Errorf("dependent project %q not found", name)
+}
+
+// tov1alpha2ProjectSource returns the source of a project. If the type is not specified,
+// the source is a zip archive if a zip location is given, or a Git repository otherwise
+func tov1alpha2ProjectSource(
+	projectType string,
+	remotes map[string]string,
+	remote string,
+	revision string,
+	zipLocation string,
+) (v1alpha2.ProjectSource, error) {
+	if projectType == "" {
+		projectType = projectTypeGit
+		if zipLocation != "" {
+			projectType = projectTypeZip
+		}
+	}
+	switch projectType {
+	case projectTypeGit:
+		source := v1alpha2.ProjectSource{
+			Git: &v1alpha2.GitProjectSource{
+				GitLikeProjectSource: v1alpha2.GitLikeProjectSource{
+					Remotes: remotes,
+				},
+			},
+		}
+		if remote != "" || revision != "" {
+			source.Git.CheckoutFrom = &v1alpha2.CheckoutFrom{
+				Remote:   remote,
+				Revision: revision,
+			}
+		}
+		return source, nil
+	case projectTypeZip:
+		return v1alpha2.ProjectSource{
+			Zip: &v1alpha2.ZipProjectSource{
+				Location: zipLocation,
+			},
+		}, nil
+	default:
+		return v1alpha2.ProjectSource{}, fmt.Errorf("unsupported project type %q, must be %q or %q", projectType, projectTypeGit, projectTypeZip)
+	}
+}
@@ -0,0 +1,123 @@
+package devstate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+func TestDevfileState_Projects(t *testing.T) {
+	state := NewDevfileState()
+
+	_, err := state.AddProject(Project{
+		Name:    "a-project",
+		Remotes: map[string]string{"origin": "https://github.com/org/a-project.git"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = state.AddProject(Project{
+		Name:        "another-project",
+		ClonePath:   "src/another",
+		ZipLocation: "https://example.com/another.zip",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := state.PatchProject("a-project", ProjectPatch{
+		Remotes:  map[string]string{"origin": "https://github.com/org/a-project.git"},
+		Remote:   "origin",
+		Revision: "main",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Project{
+		{
+			Name:     "a-project",
+			Type:     "git",
+			Remotes:  map[string]string{"origin": "https://github.com/org/a-project.git"},
+			Remote:   "origin",
+			Revision: "main",
+		},
+		{
+			Name:        "another-project",
+			ClonePath:   "src/another",
+			Type:        "zip",
+			ZipLocation: "https://example.com/another.zip",
+		},
+	}
+	if diff := cmp.Diff(want, got.Projects); diff != "" {
+		t.Errorf("Projects mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err = state.DeleteProject("another-project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[:1], got.Projects); diff != "" {
+		t.Errorf("Projects mismatch after deletion (-want +got):\n%s", diff)
+	}
+
+	_, err = state.AddProject(Project{Name: "a-custom-project", Type: "svn"})
+	if err == nil {
+		t.Errorf("expected an error adding a project with an unsupported type")
+	}
+}
+
+func TestDevfileState_DependentProjects(t *testing.T) {
+	state := NewDevfileState()
+
+	got, err := state.AddDependentProject(Project{
+		Name:    "a-dependency",
+		Remotes: map[string]string{"origin": "https://github.com/org/a-dependency.git"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantContent := `dependentProjects:
+- git:
+    remotes:
+      origin: https://github.com/org/a-dependency.git
+  name: a-dependency
+metadata: {}
+schemaVersion: 2.2.0
+`
+	if diff := cmp.Diff(wantContent, got.Content); diff != "" {
+		t.Errorf("Content mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = state.AddDependentProject(Project{Name: "a-dependency"})
+	if err == nil {
+		t.Errorf("expected an error adding a dependent project twice")
+	}
+
+	got, err = state.PatchDependentProject("a-dependency", ProjectPatch{ZipLocation: "https://example.com/a-dependency.zip"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Project{
+		{
+			Name:        "a-dependency",
+			Type:        "zip",
+			ZipLocation: "https://example.com/a-dependency.zip",
+		},
+	}
+	if diff := cmp.Diff(want, got.DependentProjects); diff != "" {
+		t.Errorf("DependentProjects mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err = state.DeleteDependentProject("a-dependency")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.DependentProjects != nil {
+		t.Errorf("expected no dependent project, got %v", got.DependentProjects)
+	}
+	_, err = state.DeleteDependentProject("a-dependency")
+	if err == nil {
+		t.Errorf("expected an error deleting a dependent project not found")
+	}
+}
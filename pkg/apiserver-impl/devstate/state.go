@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	apidevfile "github.com/devfile/api/v2/pkg/devfile"
 	"github.com/devfile/library/v2/pkg/devfile"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	context "github.com/devfile/library/v2/pkg/devfile/parser/context"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
 	"github.com/devfile/library/v2/pkg/testingutil/filesystem"
 
 	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
@@ -18,6 +20,8 @@ import (
 type DevfileState struct {
 	Devfile parser.DevfileObj
 	FS      filesystem.Filesystem
+
+	history history
 }
 
 func NewDevfileState() DevfileState {
@@ -30,21 +34,41 @@ func NewDevfileState() DevfileState {
 }
 
 // SetDevfileContent replaces the devfile with a new content
-// If an error occurs, the Devfile is not modified
+// If an error occurs, the Devfile is not modified.
+// The history is reset, the new content being its initial content
 func (o *DevfileState) SetDevfileContent(content string) (DevfileContent, error) {
 	parserArgs := parser.ParserArgs{
-		Data:                          []byte(content),
+		Data: []byte(content),
+		// The parent is kept, so its elements can be overridden
+		FlattenedDevfile:              pointer.Bool(false),
 		ConvertKubernetesContentInUri: pointer.Bool(false),
 		SetBooleanDefaults:            pointer.Bool(false),
 	}
-	var err error
-	devfile, _, err := devfile.ParseDevfileAndValidate(parserArgs)
+	// The references to the variables are kept in the edited Devfile
+	rawDevfile, err := parser.ParseDevfile(parserArgs)
+	if err != nil {
+		return DevfileContent{}, fmt.Errorf("error parsing devfile YAML: %w", err)
+	}
+	plugins, err := rawDevfile.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{
+			ComponentType: v1alpha2.PluginComponentType,
+		},
+	})
 	if err != nil {
 		return DevfileContent{}, fmt.Errorf("error parsing devfile YAML: %w", err)
 	}
-	o.Devfile = devfile
+	if rawDevfile.Data.GetParent() == nil && len(plugins) == 0 {
+		// A Devfile with a parent or plugins cannot be validated before being flattened,
+		// its problems are reported by the diagnostics
+		_, _, err = devfile.ParseDevfileAndValidate(parserArgs)
+		if err != nil {
+			return DevfileContent{}, fmt.Errorf("error parsing devfile YAML: %w", err)
+		}
+	}
+	o.Devfile = rawDevfile
 	o.Devfile.Ctx = context.FakeContext(o.FS, o.Devfile.Ctx.GetAbsPath())
-	return o.GetContent()
+	o.history = history{}
+	return o.record("SetDevfileContent")
 }
 
 func (o *DevfileState) SetMetadata(
@@ -77,7 +101,7 @@ func (o *DevfileState) SetMetadata(
 		Provider:          provider,
 		SupportUrl:        supportUrl,
 	})
-	return o.GetContent()
+	return o.record("SetMetadata")
 }
 func splitArchitectures(architectures string) []apidevfile.Architecture {
 	if architectures == "" {
@@ -0,0 +1,337 @@
+package devstate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Kinds of the elements concerned by a diagnostic
+const (
+	KindContainer      = "container"
+	KindImage          = "image"
+	KindResource       = "resource"
+	KindVolume         = "volume"
+	KindCommand        = "command"
+	KindEvent          = "event"
+	KindProject        = "project"
+	KindStarterProject = "starterProject"
+	KindPlugin         = "plugin"
+	KindDevfile        = "devfile"
+)
+
+// getDiagnostics validates the Devfile against the Devfile schema rules, and runs astra-specific checks:
+// references to missing commands, components and volumes, unused volumes, and endpoints exposing the same port.
+// The astra-specific checks are more precise than the schema validation, so the schema errors concerning
+// an element already reported by an astra-specific check are not returned.
+// When the Devfile has a parent or plugins, the references are not checked, as they can target elements of the imported Devfiles;
+// they are checked in the diagnostics returned by GetFlattenedContent, computed on the Devfile merged with its parent and plugins
+func (o *DevfileState) getDiagnostics() ([]Diagnostic, error) {
+	components, err := o.Devfile.Data.GetComponents(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	commands, err := o.Devfile.Data.GetCommands(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	projects, err := o.Devfile.Data.GetProjects(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	starterProjects, err := o.Devfile.Data.GetStarterProjects(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	events := o.Devfile.Data.GetEvents()
+
+	hasImports := o.Devfile.Data.GetParent() != nil
+	for _, component := range components {
+		if component.Plugin != nil {
+			hasImports = true
+		}
+	}
+
+	var result []Diagnostic
+	if !hasImports {
+		result = append(result, checkCommandReferences(commands, components)...)
+		result = append(result, checkEventReferences(events, commands)...)
+		result = append(result, checkVolumes(components)...)
+	} else {
+		// The commands, events and volume mounts can reference elements defined in the parent or plugin Devfiles:
+		// the schema rules checking the references are not run either
+		commands = nil
+		events = v1alpha2.Events{}
+	}
+	portCollisions := checkPortCollisions(components)
+	result = append(result, portCollisions...)
+
+	reported := map[string]bool{}
+	for _, d := range result {
+		if d.Severity == SeverityError {
+			reported[d.Kind+"/"+d.Name] = true
+		}
+	}
+	for _, d := range getSchemaDiagnostics(components, commands, events, projects, starterProjects, len(portCollisions) > 0) {
+		if reported[d.Kind+"/"+d.Name] {
+			continue
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+func getSchemaDiagnostics(
+	components []v1alpha2.Component,
+	commands []v1alpha2.Command,
+	events v1alpha2.Events,
+	projects []v1alpha2.Project,
+	starterProjects []v1alpha2.StarterProject,
+	portCollisionsReported bool,
+) []Diagnostic {
+	var result []Diagnostic
+
+	componentNames := make([]string, 0, len(components))
+	componentKinds := make(map[string]string, len(components))
+	for _, component := range components {
+		componentNames = append(componentNames, component.Name)
+		componentKinds[component.Name] = getComponentKind(component)
+	}
+	for _, err := range flattenErrors(validation.ValidateComponents(components)) {
+		var endpointErr *validation.InvalidEndpointError
+		if portCollisionsReported && errors.As(err, &endpointErr) {
+			// the schema error does not give the containers concerned by the collision
+			continue
+		}
+		name := findElement(err.Error(), componentNames)
+		kind, found := componentKinds[name]
+		if !found {
+			kind = KindDevfile
+		}
+		result = append(result, Diagnostic{Severity: SeverityError, Kind: kind, Name: name, Message: err.Error()})
+	}
+
+	commandNames := make([]string, 0, len(commands))
+	for _, command := range commands {
+		commandNames = append(commandNames, command.Id)
+	}
+	for _, err := range flattenErrors(validation.ValidateCommands(commands, components)) {
+		result = append(result, Diagnostic{Severity: SeverityError, Kind: KindCommand, Name: findElement(err.Error(), commandNames), Message: err.Error()})
+	}
+
+	eventTypes := []string{"preStart", "postStart", "preStop", "postStop"}
+	for _, err := range flattenErrors(validation.ValidateEvents(events, commands)) {
+		result = append(result, Diagnostic{Severity: SeverityError, Kind: KindEvent, Name: findElement(err.Error(), eventTypes), Message: err.Error()})
+	}
+
+	projectNames := make([]string, 0, len(projects))
+	for _, project := range projects {
+		projectNames = append(projectNames, project.Name)
+	}
+	for _, err := range flattenErrors(validation.ValidateProjects(projects)) {
+		result = append(result, Diagnostic{Severity: SeverityError, Kind: KindProject, Name: findElement(err.Error(), projectNames), Message: err.Error()})
+	}
+
+	starterProjectNames := make([]string, 0, len(starterProjects))
+	for _, project := range starterProjects {
+		starterProjectNames = append(starterProjectNames, project.Name)
+	}
+	for _, err := range flattenErrors(validation.ValidateStarterProjects(starterProjects)) {
+		result = append(result, Diagnostic{Severity: SeverityError, Kind: KindStarterProject, Name: findElement(err.Error(), starterProjectNames), Message: err.Error()})
+	}
+	return result
+}
+
+// checkCommandReferences checks that the components and commands referenced by the commands exist and have the expected type
+func checkCommandReferences(commands []v1alpha2.Command, components []v1alpha2.Component) []Diagnostic {
+	componentsByName := make(map[string]v1alpha2.Component, len(components))
+	for _, component := range components {
+		componentsByName[component.Name] = component
+	}
+	commandsByName := make(map[string]bool, len(commands))
+	for _, command := range commands {
+		commandsByName[command.Id] = true
+	}
+
+	var result []Diagnostic
+	for _, command := range commands {
+		switch {
+		case command.Exec != nil:
+			component, found := componentsByName[command.Exec.Component]
+			if !found {
+				result = append(result, newError(KindCommand, command.Id, "component", fmt.Sprintf("container %q not found", command.Exec.Component)))
+			} else if component.Container == nil {
+				result = append(result, newError(KindCommand, command.Id, "component", fmt.Sprintf("component %q is not a container", command.Exec.Component)))
+			}
+		case command.Apply != nil:
+			component, found := componentsByName[command.Apply.Component]
+			if !found {
+				result = append(result, newError(KindCommand, command.Id, "component", fmt.Sprintf("component %q not found", command.Apply.Component)))
+			} else if component.Image == nil && component.Kubernetes == nil && component.Openshift == nil {
+				result = append(result, newError(KindCommand, command.Id, "component", fmt.Sprintf("component %q is not an image or a Kubernetes resource", command.Apply.Component)))
+			}
+		case command.Composite != nil:
+			for i, subcommand := range command.Composite.Commands {
+				if !commandsByName[subcommand] {
+					result = append(result, newError(KindCommand, command.Id, fmt.Sprintf("commands[%d]", i), fmt.Sprintf("command %q not found", subcommand)))
+				}
+			}
+		}
+	}
+	return result
+}
+
+// checkEventReferences checks that the commands referenced by the events exist
+func checkEventReferences(events v1alpha2.Events, commands []v1alpha2.Command) []Diagnostic {
+	commandsByName := make(map[string]bool, len(commands))
+	for _, command := range commands {
+		commandsByName[command.Id] = true
+	}
+
+	var result []Diagnostic
+	for _, event := range []struct {
+		name     string
+		commands []string
+	}{
+		{name: "preStart", commands: events.PreStart},
+		{name: "postStart", commands: events.PostStart},
+		{name: "preStop", commands: events.PreStop},
+		{name: "postStop", commands: events.PostStop},
+	} {
+		for i, command := range event.commands {
+			if !commandsByName[command] {
+				result = append(result, newError(KindEvent, event.name, fmt.Sprintf("commands[%d]", i), fmt.Sprintf("command %q not found", command)))
+			}
+		}
+	}
+	return result
+}
+
+// checkVolumes checks that the volumes mounted by the containers exist, and that all the volumes are mounted
+func checkVolumes(components []v1alpha2.Component) []Diagnostic {
+	volumes := map[string]bool{}
+	for _, component := range components {
+		if component.Volume != nil {
+			volumes[component.Name] = true
+		}
+	}
+
+	var result []Diagnostic
+	mounted := map[string]bool{}
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		for i, volumeMount := range component.Container.VolumeMounts {
+			mounted[volumeMount.Name] = true
+			if !volumes[volumeMount.Name] {
+				result = append(result, newError(KindContainer, component.Name, fmt.Sprintf("volumeMounts[%d]", i), fmt.Sprintf("volume %q not found", volumeMount.Name)))
+			}
+		}
+	}
+	for _, component := range components {
+		if component.Volume != nil && !mounted[component.Name] {
+			result = append(result, Diagnostic{
+				Severity: SeverityWarning,
+				Kind:     KindVolume,
+				Name:     component.Name,
+				Message:  "the volume is not mounted by any container",
+			})
+		}
+	}
+	return result
+}
+
+// checkPortCollisions checks that a port is exposed by a single endpoint
+func checkPortCollisions(components []v1alpha2.Component) []Diagnostic {
+	type exposedPort struct {
+		container string
+		endpoint  string
+	}
+	ports := map[int]exposedPort{}
+
+	var result []Diagnostic
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		for i, endpoint := range component.Container.Endpoints {
+			if previous, found := ports[endpoint.TargetPort]; found {
+				result = append(result, newError(KindContainer, component.Name, fmt.Sprintf("endpoints[%d].targetPort", i),
+					fmt.Sprintf("port %d is already exposed by endpoint %q of container %q", endpoint.TargetPort, previous.endpoint, previous.container)))
+				continue
+			}
+			ports[endpoint.TargetPort] = exposedPort{
+				container: component.Name,
+				endpoint:  endpoint.Name,
+			}
+		}
+	}
+	return result
+}
+
+func newError(kind, name, field, message string) Diagnostic {
+	return Diagnostic{
+		Severity: SeverityError,
+		Kind:     kind,
+		Name:     name,
+		Field:    field,
+		Message:  message,
+	}
+}
+
+func getComponentKind(component v1alpha2.Component) string {
+	switch {
+	case component.Container != nil:
+		return KindContainer
+	case component.Image != nil:
+		return KindImage
+	case component.Volume != nil:
+		return KindVolume
+	case component.Plugin != nil:
+		return KindPlugin
+	default:
+		return KindResource
+	}
+}
+
+// flattenErrors returns the errors aggregated in err by the validation functions
+func flattenErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if multi, ok := err.(interface{ WrappedErrors() []error }); ok {
+		var result []error
+		for _, e := range multi.WrappedErrors() {
+			result = append(result, flattenErrors(e)...)
+		}
+		return result
+	}
+	return []error{err}
+}
+
+// findElement returns the longest name found as a whole word in message, or an empty string
+func findElement(message string, names []string) string {
+	var result string
+	for _, name := range names {
+		if len(name) <= len(result) {
+			continue
+		}
+		re := regexp.MustCompile(`(^|[^a-zA-Z0-9-])` + regexp.QuoteMeta(name) + `([^a-zA-Z0-9-]|$)`)
+		if re.MatchString(message) {
+			result = name
+		}
+	}
+	return result
+}
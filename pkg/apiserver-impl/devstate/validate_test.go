@@ -0,0 +1,88 @@
+package devstate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+func TestDevfileState_getDiagnostics(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Diagnostic
+	}{
+		{
+			name: "valid devfile",
+			content: `schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: an-image
+    volumeMounts:
+    - name: cache
+      path: /cache
+- name: cache
+  volume: {}
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: ./run
+`,
+		},
+		{
+			name: "dangling references, unused volume and port collision",
+			content: `schemaVersion: 2.2.0
+components:
+- name: runtime
+  container:
+    image: an-image
+    endpoints:
+    - name: http
+      targetPort: 8080
+- name: sidecar
+  container:
+    image: another-image
+    endpoints:
+    - name: admin
+      targetPort: 8080
+- name: cache
+  volume: {}
+commands:
+- id: build
+  exec:
+    component: runtime
+    commandLine: ./build
+- id: all
+  composite:
+    commands:
+    - build
+    - test
+`,
+			want: []Diagnostic{
+				{Severity: SeverityError, Kind: KindCommand, Name: "all", Field: "commands[1]", Message: `command "test" not found`},
+				{Severity: SeverityWarning, Kind: KindVolume, Name: "cache", Message: "the volume is not mounted by any container"},
+				{Severity: SeverityError, Kind: KindContainer, Name: "sidecar", Field: "endpoints[0].targetPort", Message: `port 8080 is already exposed by endpoint "http" of container "runtime"`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := NewDevfileState()
+			err := state.restore(tt.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := state.getDiagnostics()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getDiagnostics() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package devstate
+
+import (
+	"github.com/devfile/api/v2/pkg/attributes"
+
+	. "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+)
+
+// SetVariables replaces the variables of the Devfile.
+// The references to the variables ({{name}}) in the Devfile are kept, and are replaced with their values when the Devfile is used
+func (o *DevfileState) SetVariables(variables map[string]string) (DevfileContent, error) {
+	if len(variables) == 0 {
+		variables = nil
+	}
+	o.Devfile.Data.GetDevfileWorkspaceSpecContent().Variables = variables
+	return o.record("SetVariables")
+}
+
+// SetAttributes replaces the top-level attributes of the Devfile
+func (o *DevfileState) SetAttributes(values map[string]interface{}) (DevfileContent, error) {
+	var newAttributes attributes.Attributes
+	if len(values) > 0 {
+		var err error
+		newAttributes = attributes.Attributes{}.FromMap(values, &err)
+		if err != nil {
+			return DevfileContent{}, err
+		}
+	}
+	o.Devfile.Data.GetDevfileWorkspaceSpecContent().Attributes = newAttributes
+	return o.record("SetAttributes")
+}
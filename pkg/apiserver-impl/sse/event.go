@@ -11,11 +11,21 @@ type EventType int
 const (
 	Heartbeat EventType = iota + 1
 	DevfileUpdated
+	// DevSession is an event published by the Dev session, named after its type (see package events)
+	DevSession
+	// Log is a line of the logs of a container of the component
+	Log
+	// CommandOutput is a line of the output of a command
+	CommandOutput
+	// CommandExit is sent once a command has terminated
+	CommandExit
 )
 
 type Event struct {
 	eventType EventType
-	data      interface{}
+	// name is the name of a DevSession event
+	name string
+	data interface{}
 }
 
 func (e Event) toSseString() (string, error) {
@@ -25,6 +35,14 @@ func (e Event) toSseString() (string, error) {
 		return ": heartbeat\n\n", nil
 	case DevfileUpdated:
 		eventName = "DevfileUpdated"
+	case DevSession:
+		eventName = e.name
+	case Log:
+		eventName = "Log"
+	case CommandOutput:
+		eventName = "Output"
+	case CommandExit:
+		eventName = "Exit"
 	default:
 		return "", fmt.Errorf("unrecognized event type:%v", e.eventType)
 	}
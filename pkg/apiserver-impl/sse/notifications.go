@@ -9,6 +9,7 @@ import (
 	"k8s.io/klog"
 
 	openapi "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+	"github\.com/danielpickens/astra/pkg/events"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -33,7 +34,7 @@ type Notifier struct {
 	cancelSubscriptionChan chan (<-chan Event)
 }
 
-func NewNotifier(ctx context.Context, fsys filesystem.Filesystem, devfilePath string, devfileFiles []string) (*Notifier, error) {
+func NewNotifier(ctx context.Context, fsys filesystem.Filesystem, devfilePath string, devfileFiles []string, eventsClient *events.EventsClient) (*Notifier, error) {
 	notifier := Notifier{
 		fsys:                   fsys,
 		devfilePath:            devfilePath,
@@ -50,6 +51,8 @@ func NewNotifier(ctx context.Context, fsys filesystem.Filesystem, devfilePath st
 
 	go notifier.manageSubscriptions(ctx)
 
+	go notifier.forwardDevSessionEvents(ctx, eventsClient.Subscribe(ctx))
+
 	// Heartbeat as a keep-alive mechanism to prevent some clients from closing inactive connections (notifications might not be sent regularly).
 	go func() {
 		ticker := time.NewTicker(7 * time.Second)
@@ -68,6 +71,29 @@ func NewNotifier(ctx context.Context, fsys filesystem.Filesystem, devfilePath st
 	return &notifier, nil
 }
 
+// forwardDevSessionEvents broadcasts the events published by the Dev session
+func (n *Notifier) forwardDevSessionEvents(ctx context.Context, devSessionEvents <-chan events.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-devSessionEvents:
+			if !ok {
+				return
+			}
+			select {
+			case n.eventsChan <- Event{
+				eventType: DevSession,
+				name:      string(ev.Type),
+				data:      ev.Data,
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
 func (n *Notifier) manageSubscriptions(ctx context.Context) {
 	defer func() {
 		for _, listener := range n.subscribers {
@@ -147,6 +173,17 @@ func (n *Notifier) Routes() openapi.Routes {
 }
 
 func (n *Notifier) handler(w http.ResponseWriter, r *http.Request) {
+	newListener := make(chan Event)
+	n.newSubscriptionChan <- newListener
+	defer func() {
+		n.cancelSubscriptionChan <- newListener
+	}()
+
+	writeEvents(w, r, newListener)
+}
+
+// writeEvents writes the events received on ch to w, until ch is closed or the request is done
+func writeEvents(w http.ResponseWriter, r *http.Request, ch <-chan Event) {
 	flusher, ok := w.(http.Flusher)
 
 	if !ok {
@@ -154,12 +191,6 @@ func (n *Notifier) handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	newListener := make(chan Event)
-	n.newSubscriptionChan <- newListener
-	defer func() {
-		n.cancelSubscriptionChan <- newListener
-	}()
-
 	w.Header().Set("Content-Type", "text/event-stream")
 	w.Header().Set("Cache-Control", "no-cache")
 	w.Header().Set("Connection", "keep-alive")
@@ -169,7 +200,10 @@ func (n *Notifier) handler(w http.ResponseWriter, r *http.Request) {
 
 	for {
 		select {
-		case ev := <-newListener:
+		case ev, ok := <-ch:
+			if !ok {
+				return
+			}
 			func() {
 				defer flusher.Flush()
 				dataToWrite, err := ev.toSseString()
@@ -0,0 +1,428 @@
+package sse
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/gorilla/mux"
+	"k8s.io/klog"
+
+	openapi "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+	"github\.com/danielpickens/astra/pkg/devfile"
+	"github\.com/danielpickens/astra/pkg/events"
+	"github\.com/danielpickens/astra/pkg/exec"
+	"github\.com/danielpickens/astra/pkg/labels"
+	"github\.com/danielpickens/astra/pkg/logs"
+	fcontext "github\.com/danielpickens/astra/pkg/astra/commonflags/context"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+	"github\.com/danielpickens/astra/pkg/platform"
+	"github\.com/danielpickens/astra/pkg/remotecmd"
+)
+
+// commandStatusInterval is the interval at which the status of the process executing a command is checked
+const commandStatusInterval = 2 * time.Second
+
+// logData is the data of Log and Output events
+type logData struct {
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Line      string `json:"line"`
+}
+
+// exitData is the data of Exit events
+type exitData struct {
+	Status   string `json:"status"`
+	ExitCode int    `json:"exitCode"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Streamer serves the logs of the component and the output of its commands as Server-Sent Events
+type Streamer struct {
+	logsClient     logs.Client
+	platformClient platform.Client
+	execClient     exec.Client
+	eventsClient   *events.EventsClient
+	// namespace is the namespace of the component, empty when running on Podman
+	namespace string
+}
+
+func NewStreamer(
+	logsClient logs.Client,
+	platformClient platform.Client,
+	execClient exec.Client,
+	eventsClient *events.EventsClient,
+	namespace string,
+) *Streamer {
+	return &Streamer{
+		logsClient:     logsClient,
+		platformClient: platformClient,
+		execClient:     execClient,
+		eventsClient:   eventsClient,
+		namespace:      namespace,
+	}
+}
+
+func (o *Streamer) Routes() openapi.Routes {
+	return openapi.Routes{
+		{
+			Name:        "ComponentLogsGet",
+			Method:      http.MethodGet,
+			Pattern:     "/api/v1/component/logs",
+			HandlerFunc: o.logsHandler,
+		},
+		{
+			Name:        "ComponentCommandOutputGet",
+			Method:      http.MethodGet,
+			Pattern:     "/api/v1/component/command/{commandName}/output",
+			HandlerFunc: o.commandOutputHandler,
+		},
+	}
+}
+
+func (o *Streamer) logsHandler(w http.ResponseWriter, r *http.Request) {
+	if o.logsClient == nil {
+		writeError(w, http.StatusServiceUnavailable, "logs are not available, no Dev session is running")
+		return
+	}
+
+	var mode string
+	switch m := r.URL.Query().Get("mode"); m {
+	case "", "dev":
+		mode = labels.ComponentDevMode
+	case "deploy":
+		mode = labels.ComponentDeployMode
+	case "all":
+		mode = labels.ComponentAnyMode
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid mode %q, supported values are: dev, deploy, all", m))
+		return
+	}
+	follow, err := getFollowParam(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	logEvents, err := o.logsClient.GetLogsForMode(ctx, mode, astracontext.GetComponentName(ctx), o.namespace, follow)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("error getting the logs of the component: %s", err))
+		return
+	}
+
+	ch := make(chan Event)
+	go forwardLogs(ctx, logEvents, follow, ch)
+	writeEvents(w, r, ch)
+}
+
+// forwardLogs sends the lines of the logs received on logEvents to ch, as Log events.
+// ch is closed once all the logs have been sent, if follow is false, or when ctx is done
+func forwardLogs(ctx context.Context, logEvents logs.Events, follow bool, ch chan<- Event) {
+	var wg sync.WaitGroup
+	defer func() {
+		wg.Wait()
+		close(ch)
+	}()
+	// stop streaming the logs of all the containers when returning
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// the logs of the same container can be received several times when following the logs
+	streamed := map[string]struct{}{}
+	finished := make(chan string)
+	for {
+		select {
+		case containerLogs := <-logEvents.Logs:
+			if containerLogs.Logs == nil {
+				// the error has been sent to logEvents.Err
+				continue
+			}
+			key := containerLogs.PodName + "/" + containerLogs.ContainerName
+			if _, ok := streamed[key]; ok {
+				continue
+			}
+			if !follow {
+				sendLines(ctx, Log, containerLogs.PodName, containerLogs.ContainerName, containerLogs.Logs, ch)
+				continue
+			}
+			streamed[key] = struct{}{}
+			wg.Add(1)
+			go func(containerLogs logs.ContainerLogs) {
+				defer wg.Done()
+				sendLines(ctx, Log, containerLogs.PodName, containerLogs.ContainerName, containerLogs.Logs, ch)
+				select {
+				case finished <- containerLogs.PodName + "/" + containerLogs.ContainerName:
+				case <-ctx.Done():
+				}
+			}(containerLogs)
+		case key := <-finished:
+			// the container can be restarted
+			delete(streamed, key)
+		case err := <-logEvents.Err:
+			send(ctx, ch, Event{
+				eventType: DevSession,
+				name:      string(events.Error),
+				data:      events.ErrorData{Message: err.Error()},
+			})
+			return
+		case <-logEvents.Done:
+			if !follow {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (o *Streamer) commandOutputHandler(w http.ResponseWriter, r *http.Request) {
+	if o.platformClient == nil || o.execClient == nil {
+		writeError(w, http.StatusServiceUnavailable, "the output of the commands is not available, no Dev session is running")
+		return
+	}
+
+	follow, err := getFollowParam(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	commandName := mux.Vars(r)["commandName"]
+	command, found, err := getCommand(ctx, commandName)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("error getting command %q: %s", commandName, err))
+		return
+	}
+	if !found {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("command %q not found in the Devfile", commandName))
+		return
+	}
+	if command.Exec == nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("command %q is not an exec command", commandName))
+		return
+	}
+
+	pod, err := o.platformClient.GetPodUsingComponentName(astracontext.GetComponentName(ctx))
+	if err != nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("the component is not running: %s", err))
+		return
+	}
+
+	// Subscribe before getting the output, not to miss the end of the command
+	devSessionEvents := o.eventsClient.Subscribe(ctx)
+
+	output := o.getCommandOutput(ctx, command, pod.GetName(), follow)
+
+	ch := make(chan Event)
+	go o.forwardCommandOutput(ctx, command, pod.GetName(), output, follow, devSessionEvents, ch)
+	writeEvents(w, r, ch)
+}
+
+// getCommandOutput returns the output of the command, read from the file to which it is written in the container,
+// in addition to the logs of the container shared with the other commands (see remotecmd.StartProcessForCommand).
+// Closing the returned reader stops reading the output.
+func (o *Streamer) getCommandOutput(ctx context.Context, command v1alpha2.Command, podName string, follow bool) io.ReadCloser {
+	execCtx, cancel := context.WithCancel(ctx)
+	rd, wr := io.Pipe()
+	go func() {
+		cmd := remotecmd.GetOutputCommandForCommand(remotecmd.CommandDefinition{Id: command.Id}, follow)
+		err := o.platformClient.ExecCMDInContainer(execCtx, command.Exec.Component, podName, cmd, wr, io.Discard, nil, false)
+		if err != nil {
+			klog.V(4).Infof("error reading the output of command %q: %v", command.Id, err)
+		}
+		_ = wr.CloseWithError(err)
+	}()
+	return cancelReadCloser{ReadCloser: rd, cancel: cancel}
+}
+
+// cancelReadCloser cancels a context when closed
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (o cancelReadCloser) Close() error {
+	o.cancel()
+	return o.ReadCloser.Close()
+}
+
+// forwardCommandOutput sends the lines of output to ch, as Output events, followed by an Exit event once the command has terminated.
+// ch is closed once the Exit event is sent, when all the output has been sent if follow is false, or when ctx is done
+func (o *Streamer) forwardCommandOutput(
+	ctx context.Context,
+	command v1alpha2.Command,
+	podName string,
+	output io.ReadCloser,
+	follow bool,
+	devSessionEvents <-chan events.Event,
+	ch chan<- Event,
+) {
+	defer close(ch)
+	processHandler := remotecmd.NewKubeExecProcessHandler(o.execClient)
+
+	if !follow {
+		sendLines(ctx, CommandOutput, podName, command.Exec.Component, output, ch)
+		if exit, terminated := getCommandExit(ctx, processHandler, command, podName); terminated {
+			send(ctx, ch, Event{eventType: CommandExit, data: exit})
+		}
+		return
+	}
+
+	outputCtx, cancelOutput := context.WithCancel(ctx)
+	outputDone := make(chan struct{})
+	go func() {
+		sendLines(outputCtx, CommandOutput, podName, command.Exec.Component, output, ch)
+		close(outputDone)
+	}()
+	stopOutput := func() {
+		cancelOutput()
+		<-outputDone
+	}
+
+	ticker := time.NewTicker(commandStatusInterval)
+	defer ticker.Stop()
+
+	var exit exitData
+loop:
+	for {
+		select {
+		case ev, ok := <-devSessionEvents:
+			if !ok {
+				stopOutput()
+				return
+			}
+			data, isCommandData := ev.Data.(events.CommandData)
+			if ev.Type != events.CommandExited || !isCommandData || data.Name != command.Id {
+				continue
+			}
+			exit = exitData{
+				Status:   remotecmd.Stopped,
+				ExitCode: data.ExitCode,
+				Error:    data.Error,
+			}
+			if data.ExitCode != 0 || data.Error != "" {
+				exit.Status = remotecmd.Errored
+			}
+			break loop
+		case <-ticker.C:
+			var terminated bool
+			if exit, terminated = getCommandExit(ctx, processHandler, command, podName); terminated {
+				break loop
+			}
+		case <-outputDone:
+			// the container has been stopped
+			return
+		case <-ctx.Done():
+			stopOutput()
+			return
+		}
+	}
+
+	// Give some time to the last lines of output to be received
+	select {
+	case <-time.After(time.Second):
+	case <-ctx.Done():
+	}
+	stopOutput()
+	send(ctx, ch, Event{eventType: CommandExit, data: exit})
+}
+
+// getCommandExit returns the exit status of the command, if the process executing it has terminated
+func getCommandExit(ctx context.Context, processHandler remotecmd.RemoteProcessHandler, command v1alpha2.Command, podName string) (exitData, bool) {
+	info, err := processHandler.GetProcessInfoForCommand(ctx, remotecmd.CommandDefinition{Id: command.Id}, podName, command.Exec.Component)
+	if err != nil {
+		klog.V(4).Infof("unable to get the status of the process for command %q: %v", command.Id, err)
+		return exitData{}, false
+	}
+	// A zero PID means that the process has never been started
+	if info.Pid == 0 || (info.Status != remotecmd.Stopped && info.Status != remotecmd.Errored) {
+		return exitData{}, false
+	}
+	return exitData{
+		Status:   string(info.Status),
+		ExitCode: info.ExitCode,
+	}, true
+}
+
+// getCommand returns the command named commandName from the current content of the Devfile
+func getCommand(ctx context.Context, commandName string) (v1alpha2.Command, bool, error) {
+	devfileObj, err := devfile.ParseAndValidateFromFileWithVariables(filepath.Dir(astracontext.GetDevfilePath(ctx)), fcontext.GetVariables(ctx), "", false)
+	if err != nil {
+		return v1alpha2.Command{}, false, err
+	}
+	commands, err := devfileObj.Data.GetCommands(common.DevfileOptions{FilterByName: commandName})
+	if err != nil {
+		return v1alpha2.Command{}, false, err
+	}
+	if len(commands) == 0 {
+		return v1alpha2.Command{}, false, nil
+	}
+	return commands[0], true, nil
+}
+
+// sendLines sends each line read from rd to ch, as events of type eventType, until rd is closed or ctx is done
+func sendLines(ctx context.Context, eventType EventType, podName string, containerName string, rd io.ReadCloser, ch chan<- Event) {
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		// unblock the scanner when ctx is done
+		select {
+		case <-ctx.Done():
+		case <-stop:
+		}
+		_ = rd.Close()
+	}()
+
+	scanner := bufio.NewScanner(rd)
+	for scanner.Scan() {
+		if !send(ctx, ch, Event{
+			eventType: eventType,
+			data: logData{
+				Pod:       podName,
+				Container: containerName,
+				Line:      scanner.Text(),
+			},
+		}) {
+			return
+		}
+	}
+}
+
+// send sends ev to ch, and returns false if ctx is done before ev can be sent
+func send(ctx context.Context, ch chan<- Event, ev Event) bool {
+	select {
+	case ch <- ev:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func getFollowParam(r *http.Request) (bool, error) {
+	value := r.URL.Query().Get("follow")
+	if value == "" {
+		return false, nil
+	}
+	follow, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for parameter follow: %w", value, err)
+	}
+	return follow, nil
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	err := openapi.EncodeJSONResponse(openapi.GeneralError{Message: message}, &status, w)
+	if err != nil {
+		klog.V(2).Infof("error writing error response: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package apiserver_impl
 
 import (
 	"context"
+	"crypto/tls"
 	"embed"
 	"fmt"
 	"io/fs"
@@ -12,10 +13,17 @@ import (
 
 	openapi "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
 	"github\.com/danielpickens/astra/pkg/apiserver-impl/sse"
+	"github\.com/danielpickens/astra/pkg/events"
+	"github\.com/danielpickens/astra/pkg/exec"
 	"github\.com/danielpickens/astra/pkg/informer"
 	"github\.com/danielpickens/astra/pkg/kclient"
 	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/logs"
 	"github\.com/danielpickens/astra/pkg/astra/cli/feature"
+	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	fcontext "github\.com/danielpickens/astra/pkg/astra/commonflags/context"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+	"github\.com/danielpickens/astra/pkg/platform"
 	"github\.com/danielpickens/astra/pkg/podman"
 	"github\.com/danielpickens/astra/pkg/preference"
 	"github\.com/danielpickens/astra/pkg/state"
@@ -40,6 +48,7 @@ func StartServer(
 	cancelFunc context.CancelFunc,
 	randomPort bool,
 	port int,
+	withTLS bool,
 	devfilePath string,
 	devfileFiles []string,
 	fsys filesystem.Filesystem,
@@ -48,6 +57,9 @@ func StartServer(
 	stateClient state.Client,
 	preferenceClient preference.Client,
 	informerClient *informer.InformerClient,
+	eventsClient *events.EventsClient,
+	logsClient logs.Client,
+	execClient exec.Client,
 ) (ApiServer, error) {
 	pushWatcher := make(chan struct{})
 	defaultApiService := NewDefaultApiService(
@@ -69,12 +81,29 @@ func StartServer(
 	)
 	devstateApiController := openapi.NewDevstateApiController(devstateApiService)
 
-	sseNotifier, err := sse.NewNotifier(ctx, fsys, devfilePath, devfileFiles)
+	sseNotifier, err := sse.NewNotifier(ctx, fsys, devfilePath, devfileFiles, eventsClient)
 	if err != nil {
 		return ApiServer{}, err
 	}
 
-	router := openapi.NewRouter(sseNotifier, defaultApiController, devstateApiController)
+	var (
+		platformClient platform.Client
+		namespace      string
+	)
+	switch fcontext.GetPlatform(ctx, commonflags.PlatformCluster) {
+	case commonflags.PlatformPodman:
+		if podmanClient != nil {
+			platformClient = podmanClient
+		}
+	default:
+		if kubernetesClient != nil {
+			platformClient = kubernetesClient
+			namespace = astracontext.GetNamespace(ctx)
+		}
+	}
+	sseStreamer := sse.NewStreamer(logsClient, platformClient, execClient, eventsClient, namespace)
+
+	router := openapi.NewRouter(sseNotifier, sseStreamer, defaultApiController, devstateApiController)
 
 	fSysSwagger, err := fs.Sub(swaggerFiles, "swagger-ui")
 	if err != nil {
@@ -96,6 +125,57 @@ func StartServer(
 		router.PathPrefix("/").Handler(staticServer)
 	}
 
+	srv, err := listenAndServe(ctx, cancelFunc, randomPort, port, withTLS, router)
+	if err != nil {
+		return ApiServer{}, err
+	}
+	err = stateClient.SetAPIServerPort(ctx, srv.port)
+	if err != nil {
+		klog.V(0).Infof("Unable to start the API server; encountered error: %v", err)
+		cancelFunc()
+	}
+	err = stateClient.SetAPIServerCredentials(ctx, srv.token, srv.certFingerprint)
+	if err != nil {
+		klog.V(0).Infof("Unable to start the API server; encountered error: %v", err)
+		cancelFunc()
+	}
+
+	if feature.IsEnabled(ctx, feature.UIServer) {
+		info := fmt.Sprintf("Web console accessible at %s://localhost:%d/?%s=%s", srv.scheme, srv.port, TokenQueryParam, srv.token)
+		log.Spinner(info).End(true)
+		informerClient.AppendInfo(info + "\n")
+	}
+	log.Spinner(fmt.Sprintf("API Server started at %s://localhost:%d/api/v1", srv.scheme, srv.port)).End(true)
+	log.Spinner(fmt.Sprintf("API token (required to modify the state of the session): %s", srv.token)).End(true)
+	if withTLS {
+		log.Spinner(fmt.Sprintf("API Server certificate SHA-256 fingerprint: %s", srv.certFingerprint)).End(true)
+	}
+	log.Spinner(fmt.Sprintf("API documentation accessible at %s://localhost:%d/swagger-ui/", srv.scheme, srv.port)).End(true)
+
+	return ApiServer{
+		PushWatcher: pushWatcher,
+	}, nil
+}
+
+// listeningServer describes an API server started by listenAndServe
+type listeningServer struct {
+	port            int
+	scheme          string
+	token           string
+	certFingerprint string
+}
+
+// listenAndServe starts serving handler on a local port, protected by a new token, until ctx is done.
+// If the server stops with an error, cancelFunc is called
+func listenAndServe(
+	ctx context.Context,
+	cancelFunc context.CancelFunc,
+	randomPort bool,
+	port int,
+	withTLS bool,
+	handler http.Handler,
+) (listeningServer, error) {
+	var err error
 	addr := "127.0.0.1"
 	if port == 0 && !randomPort {
 		port, err = util.NextFreePort(20000, 30001, nil, addr)
@@ -107,17 +187,42 @@ func StartServer(
 
 	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", addr, port))
 	if err != nil {
-		return ApiServer{}, fmt.Errorf("unable to start API Server listener on port %d: %w", port, err)
+		return listeningServer{}, fmt.Errorf("unable to start API Server listener on port %d: %w", port, err)
+	}
+
+	token, err := NewToken()
+	if err != nil {
+		return listeningServer{}, fmt.Errorf("unable to generate a token for the API Server: %w", err)
 	}
 
 	server := &http.Server{
 		BaseContext: func(net.Listener) context.Context {
 			return ctx
 		},
-		Handler: router,
+		Handler: newAuthHandler(token, handler),
+	}
+
+	scheme := "http"
+	var certFingerprint string
+	if withTLS {
+		var cert tls.Certificate
+		cert, certFingerprint, err = newSelfSignedCertificate()
+		if err != nil {
+			return listeningServer{}, fmt.Errorf("unable to generate a certificate for the API Server: %w", err)
+		}
+		server.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+		scheme = "https"
 	}
+
 	var errChan = make(chan error)
 	go func() {
+		if withTLS {
+			errChan <- server.ServeTLS(listener, "", "")
+			return
+		}
 		errChan <- server.Serve(listener)
 	}()
 
@@ -127,20 +232,6 @@ func StartServer(
 		panic(fmt.Sprintf("requested port (%d) not the same as the actual port the API Server is bound to (%d)", port, listeningPort))
 	}
 
-	err = stateClient.SetAPIServerPort(ctx, listeningPort)
-	if err != nil {
-		klog.V(0).Infof("Unable to start the API server; encountered error: %v", err)
-		cancelFunc()
-	}
-
-	if feature.IsEnabled(ctx, feature.UIServer) {
-		info := fmt.Sprintf("Web console accessible at http://localhost:%d/", listeningPort)
-		log.Spinner(info).End(true)
-		informerClient.AppendInfo(info + "\n")
-	}
-	log.Spinner(fmt.Sprintf("API Server started at http://localhost:%d/api/v1", listeningPort)).End(true)
-	log.Spinner(fmt.Sprintf("API documentation accessible at http://localhost:%d/swagger-ui/", listeningPort)).End(true)
-
 	go func() {
 		select {
 		case <-ctx.Done():
@@ -155,7 +246,10 @@ func StartServer(
 		}
 	}()
 
-	return ApiServer{
-		PushWatcher: pushWatcher,
+	return listeningServer{
+		port:            listeningPort,
+		scheme:          scheme,
+		token:           token,
+		certFingerprint: certFingerprint,
 	}, nil
 }
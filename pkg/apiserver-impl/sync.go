@@ -0,0 +1,75 @@
+package apiserver_impl
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	dfutil "github.com/devfile/library/v2/pkg/util"
+
+	openapi "github\.com/danielpickens/astra/pkg/apiserver-gen/go"
+	"github\.com/danielpickens/astra/pkg/util"
+)
+
+// getSyncStatus returns the files of directory synchronized with the component, as recorded in the file index,
+// and the files modified locally since the last synchronization
+func getSyncStatus(directory string) (openapi.SyncStatus, error) {
+	status := openapi.SyncStatus{
+		SyncedFiles:  []string{},
+		PendingFiles: []string{},
+		DeletedFiles: []string{},
+	}
+
+	indexFile, err := util.ResolveIndexFilePath(directory)
+	if err != nil {
+		return openapi.SyncStatus{}, err
+	}
+	if fi, sErr := os.Stat(indexFile); sErr == nil {
+		lastSync := fi.ModTime()
+		status.LastSync = &lastSync
+	}
+
+	index, err := util.ReadFileIndex(indexFile)
+	if err != nil {
+		return openapi.SyncStatus{}, err
+	}
+	for file := range index.Files {
+		status.SyncedFiles = append(status.SyncedFiles, filepath.ToSlash(file))
+	}
+	sort.Strings(status.SyncedFiles)
+
+	// Same ignore rules as the ones used by 'astra dev' by default
+	ignores, err := dfutil.GetIgnoreRulesFromDirectory(directory)
+	if err != nil {
+		return openapi.SyncStatus{}, err
+	}
+	ignores = append(ignores, util.GetIndexFileRelativeToContext(), ".git")
+
+	// The indexer does not modify the index, which is written only when the files are synchronized
+	ret, err := util.RunIndexerWithRemote(directory, ignores, nil)
+	if err != nil {
+		return openapi.SyncStatus{}, err
+	}
+	status.PendingFiles = appendRelativePaths(status.PendingFiles, directory, ret.FilesChanged)
+	status.DeletedFiles = appendRelativePaths(status.DeletedFiles, directory, ret.FilesDeleted)
+	return status, nil
+}
+
+// appendRelativePaths appends to list the paths relative to directory, sorted
+func appendRelativePaths(list []string, directory string, paths []string) []string {
+	for _, path := range paths {
+		if filepath.IsAbs(path) {
+			rel, err := filepath.Rel(directory, path)
+			if err != nil {
+				continue
+			}
+			path = rel
+		}
+		if path == "." {
+			continue
+		}
+		list = append(list, filepath.ToSlash(path))
+	}
+	sort.Strings(list)
+	return list
+}
@@ -0,0 +1,67 @@
+package apiserver_impl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/util"
+)
+
+func Test_getSyncStatus(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name string, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("devfile.yaml", "schemaVersion: 2.2.0\n")
+	writeFile("main.go", "package main\n")
+
+	status, err := getSyncStatus(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.LastSync != nil {
+		t.Errorf("expected no last synchronization, got %v", status.LastSync)
+	}
+	if diff := cmp.Diff([]string{"devfile.yaml", "main.go"}, status.PendingFiles); diff != "" {
+		t.Errorf("pending files mismatch (-want +got):\n%s", diff)
+	}
+
+	// synchronize the files, as done by the sync client
+	if err = os.Mkdir(filepath.Join(dir, util.DotastraDirectory), 0750); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := util.RunIndexerWithRemote(dir, []string{util.GetIndexFileRelativeToContext()}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = util.WriteFile(ret.NewFileMap, ret.ResolvedPath); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile("README.md", "# README\n")
+	if err = os.Remove(filepath.Join(dir, "main.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err = getSyncStatus(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.LastSync == nil {
+		t.Errorf("expected the time of the last synchronization")
+	}
+	if diff := cmp.Diff([]string{"devfile.yaml", "main.go"}, status.SyncedFiles); diff != "" {
+		t.Errorf("synced files mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"README.md"}, status.PendingFiles); diff != "" {
+		t.Errorf("pending files mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"main.go"}, status.DeletedFiles); diff != "" {
+		t.Errorf("deleted files mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github\.com/danielpickens/astra/pkg/astra/cli/add/binding"
+	"github\.com/danielpickens/astra/pkg/astra/cli/add/service"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
 	"github\.com/danielpickens/astra/pkg/astra/util"
 )
@@ -19,7 +20,8 @@ func NewCmdAdd(name, fullName string, testClientset clientset.Clientset) *cobra.
 	}
 
 	bindingCmd := binding.NewCmdBinding(binding.BindingRecommendedCommandName, util.GetFullName(fullName, binding.BindingRecommendedCommandName), testClientset)
-	createCmd.AddCommand(bindingCmd)
+	serviceCmd := service.NewCmdService(service.ServiceRecommendedCommandName, util.GetFullName(fullName, service.ServiceRecommendedCommandName), testClientset)
+	createCmd.AddCommand(bindingCmd, serviceCmd)
 	util.SetCommandGroup(createCmd, util.ManagementGroup)
 	createCmd.SetUsageTemplate(util.CmdUsageTemplate)
 
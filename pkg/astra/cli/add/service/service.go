@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github\.com/danielpickens/astra/pkg/devfile"
+	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/astra/cmdline"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+	"github\.com/danielpickens/astra/pkg/astra/genericclioptions"
+	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
+	"github\.com/danielpickens/astra/pkg/provision/backend"
+)
+
+// ServiceRecommendedCommandName is the recommended service sub-command name
+const ServiceRecommendedCommandName = "service"
+
+var addServiceExample = ktemplates.Examples(`
+# Add a service provided by an Operator installed in the cluster to the devfile, in the interactive mode
+%[1]s
+
+# Add a service of kind 'Redis' named 'myredis' to the devfile
+%[1]s --kind Redis --name myredis
+
+# Add a service of kind 'Cluster' from APIGroup 'postgresql.k8s.enterprisedb.io' and version 'v1', setting fields of its spec
+%[1]s --kind Cluster.postgresql.k8s.enterprisedb.io/v1 --name mydb --param instances=1 --param storage.size=1Gi
+
+# Add a service of kind 'Redis' and a binding between the component and this service
+%[1]s --kind Redis --name myredis --bind
+`)
+
+type AddServiceOptions struct {
+	// Flags passed to the command
+	flags map[string]string
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*AddServiceOptions)(nil)
+
+// NewAddServiceOptions returns new instance of AddServiceOptions
+func NewAddServiceOptions() *AddServiceOptions {
+	return &AddServiceOptions{}
+}
+
+func (o *AddServiceOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *AddServiceOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.flags = o.clientset.ProvisionClient.GetFlags(cmdline.GetFlags())
+	return nil
+}
+
+func (o *AddServiceOptions) Validate(ctx context.Context) (err error) {
+	devfileObj := astracontext.GetEffectiveDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(astracontext.GetWorkingDirectory(ctx))
+	}
+	return o.clientset.ProvisionClient.ValidateAddService(o.flags)
+}
+
+func (o *AddServiceOptions) Run(ctx context.Context) error {
+	// Update the raw Devfile only, so we do not break any relationship between parent-child for example
+	devfileObj, err := devfile.ParseAndValidateFromFile(astracontext.GetDevfilePath(ctx), "", false)
+	if err != nil {
+		return err
+	}
+
+	kinds, err := o.clientset.ProvisionClient.ListKinds()
+	if err != nil {
+		return err
+	}
+	if len(kinds) == 0 {
+		return errors.New("no Operator providing services is installed in the current namespace")
+	}
+
+	kind, err := o.clientset.ProvisionClient.SelectKind(o.flags, kinds)
+	if err != nil {
+		return err
+	}
+
+	schema, err := o.clientset.ProvisionClient.GetKindSpecSchema(kind)
+	if err != nil {
+		return err
+	}
+
+	serviceName, err := o.clientset.ProvisionClient.AskServiceName(o.flags, kind)
+	if err != nil {
+		return err
+	}
+
+	params, err := o.clientset.ProvisionClient.SelectParameters(o.flags, schema)
+	if err != nil {
+		return err
+	}
+
+	devfileObj, serviceUnstructured, err := o.clientset.ProvisionClient.AddServiceToDevfile(serviceName, kind, schema, params, devfileObj)
+	if err != nil {
+		return err
+	}
+
+	addBinding, err := o.clientset.ProvisionClient.AskAddBinding(o.flags)
+	if err != nil {
+		return err
+	}
+
+	var bindingName string
+	if addBinding {
+		componentName := astracontext.GetComponentName(ctx)
+		bindingName = fmt.Sprintf("%s-%s", componentName, serviceName)
+		devfileObj, err = o.clientset.BindingClient.AddBindingToDevfile(
+			componentName, bindingName, true, "", "", serviceUnstructured, devfileObj)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = devfileObj.WriteYamlDevfile()
+	if err != nil {
+		return err
+	}
+	log.Successf("Successfully added the service %q to the devfile.", serviceName)
+	if addBinding {
+		log.Successf("Successfully added the binding %q to the devfile.", bindingName)
+	}
+
+	exitMessage := "Run `astra dev` to create it on the cluster."
+	if len(o.flags) == 0 {
+		exitMessage += fmt.Sprintf("\nYou can automate this command by executing:\n  astra add service --kind %s --name %s", kind, serviceName)
+		paths := make([]string, 0, len(params))
+		for path := range params {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			exitMessage += fmt.Sprintf(" --param '%s=%s'", path, strings.ReplaceAll(params[path], "'", `'\''`))
+		}
+		if addBinding {
+			exitMessage += " --bind"
+		}
+	}
+	log.Info(exitMessage)
+	return nil
+}
+
+// NewCmdService implements the service astra sub-command
+func NewCmdService(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewAddServiceOptions()
+
+	var serviceCmd = &cobra.Command{
+		Use:   name,
+		Short: "Add Service",
+		Long: `Add a service provided by an Operator installed in the cluster to the devfile, as a Kubernetes component.
+The values of the parameters are validated against the schema of the spec of the service.`,
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		Example: fmt.Sprintf(addServiceExample, fullName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	serviceCmd.Flags().String(backend.FLAG_KIND, "", "Kind of the service to add, in the form <kind>[.<apigroup>][/<version>]")
+	serviceCmd.Flags().String(backend.FLAG_NAME, "", "Name of the service to add. Default is the kind in lower case")
+	serviceCmd.Flags().StringArray(backend.FLAG_PARAM, []string{}, "Field of the spec of the service to set, in the form <path>=<value> (e.g. storage.size=1Gi); can be used multiple times")
+	serviceCmd.Flags().Bool(backend.FLAG_BIND, false, "Add a binding between the component and the service to the devfile")
+	clientset.Add(serviceCmd, clientset.PROVISION, clientset.BINDING, clientset.FILESYSTEM)
+
+	return serviceCmd
+}
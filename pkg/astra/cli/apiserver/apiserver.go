@@ -26,6 +26,8 @@ type ApiServerOptions struct {
 	// Flags
 	randomPortsFlag bool
 	portFlag        int
+	tlsFlag         bool
+	daemonFlag      bool
 }
 
 func NewApiServerOptions() *ApiServerOptions {
@@ -40,6 +42,11 @@ func (o *ApiServerOptions) SetClientset(clientset *clientset.Clientset) {
 	o.clientset = clientset
 }
 
+func (o *ApiServerOptions) UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	// In daemon mode, the sessions of all the registered projects are managed, independently of the current directory
+	return !o.daemonFlag
+}
+
 func (o *ApiServerOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
 	return nil
 }
@@ -52,6 +59,10 @@ func (o *ApiServerOptions) Validate(ctx context.Context) error {
 }
 
 func (o *ApiServerOptions) Run(ctx context.Context) (err error) {
+	if o.daemonFlag {
+		return o.runDaemon(ctx)
+	}
+
 	err = o.clientset.StateClient.Init(ctx)
 	if err != nil {
 		err = fmt.Errorf("unable to save state file: %w", err)
@@ -76,6 +87,7 @@ func (o *ApiServerOptions) Run(ctx context.Context) (err error) {
 		cancel,
 		o.randomPortsFlag,
 		o.portFlag,
+		o.tlsFlag,
 		devfilePath,
 		devfileFiles,
 		o.clientset.FS,
@@ -84,6 +96,30 @@ func (o *ApiServerOptions) Run(ctx context.Context) (err error) {
 		o.clientset.StateClient,
 		o.clientset.PreferenceClient,
 		o.clientset.InformerClient,
+		// no Dev session is running, no events are published, no logs nor command output are available
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+func (o *ApiServerOptions) runDaemon(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err := apiserver_impl.StartDaemon(
+		ctx,
+		cancel,
+		o.randomPortsFlag,
+		o.portFlag,
+		o.tlsFlag,
+		o.clientset.DevSessionsClient,
 	)
 	if err != nil {
 		return err
@@ -94,6 +130,10 @@ func (o *ApiServerOptions) Run(ctx context.Context) (err error) {
 }
 
 func (o *ApiServerOptions) Cleanup(ctx context.Context, commandError error) error {
+	if o.daemonFlag {
+		// no state file is written in daemon mode
+		return nil
+	}
 	err := o.clientset.StateClient.SaveExit(ctx)
 	if err != nil {
 		klog.V(1).Infof("unable to persist dev state: %v", err)
@@ -119,6 +159,7 @@ func NewCmdApiServer(ctx context.Context, name, fullName string, testClientset c
 		},
 	}
 	clientset.Add(apiserverCmd,
+		clientset.DEV_SESSIONS,
 		clientset.FILESYSTEM,
 		clientset.INFORMER,
 		clientset.STATE,
@@ -126,5 +167,7 @@ func NewCmdApiServer(ctx context.Context, name, fullName string, testClientset c
 	)
 	apiserverCmd.Flags().BoolVar(&o.randomPortsFlag, "random-ports", false, "Assign a random API Server port.")
 	apiserverCmd.Flags().IntVar(&o.portFlag, "port", 0, "Define custom port for API Server.")
+	apiserverCmd.Flags().BoolVar(&o.tlsFlag, "tls", false, "Serve the API Server over TLS, with a self-signed certificate generated for the session.")
+	apiserverCmd.Flags().BoolVar(&o.daemonFlag, "daemon", false, "Run as a daemon managing the Dev sessions of all the projects in which astra dev has been run, instead of serving the devfile of the current directory.")
 	return apiserverCmd
 }
@@ -30,6 +30,7 @@ type BuildImagesOptions struct {
 }
 
 var _ genericclioptions.Runnable = (*BuildImagesOptions)(nil)
+var _ genericclioptions.SecretsUser = (*BuildImagesOptions)(nil)
 
 var buildImagesExample = templates.Examples(`
   # Build images defined in the devfile
@@ -48,6 +49,11 @@ func (o *BuildImagesOptions) SetClientset(clientset *clientset.Clientset) {
 	o.clientset = clientset
 }
 
+// UseSecrets returns true, as the build arguments of the images can reference secrets
+func (o *BuildImagesOptions) UseSecrets(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return true
+}
+
 // Complete completes LoginOptions after they've been created
 func (o *BuildImagesOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
 	return nil
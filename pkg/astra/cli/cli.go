@@ -37,6 +37,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/astra/cli/set"
 	"github\.com/danielpickens/astra/pkg/astra/cli/telemetry"
 	"github\.com/danielpickens/astra/pkg/astra/cli/version"
+	"github\.com/danielpickens/astra/pkg/astra/cli/whoami"
 	"github\.com/danielpickens/astra/pkg/astra/util"
 
 	"github.com/spf13/cobra"
@@ -145,6 +146,7 @@ func astraRootCmd(ctx context.Context, name, fullName string, unknownCmdHandler
 	commonflags.AddOutputFlag()
 	commonflags.AddPlatformFlag(ctx)
 	commonflags.AddVariablesFlags()
+	commonflags.AddTraceFlag()
 
 	// Here we add the necessary "logging" flags.. However, we choose to hide some of these from the user
 	// as they are not necessarily needed and more for advanced debugging
@@ -184,9 +186,10 @@ func astraRootCmd(ctx context.Context, name, fullName string, unknownCmdHandler
 	rootCmdList := append([]*cobra.Command{},
 		login.NewCmdLogin(login.RecommendedCommandName, util.GetFullName(fullName, login.RecommendedCommandName), testClientset),
 		logout.NewCmdLogout(logout.RecommendedCommandName, util.GetFullName(fullName, logout.RecommendedCommandName), testClientset),
+		whoami.NewCmdWhoAmI(whoami.RecommendedCommandName, util.GetFullName(fullName, whoami.RecommendedCommandName), testClientset),
 		version.NewCmdVersion(version.RecommendedCommandName, util.GetFullName(fullName, version.RecommendedCommandName), testClientset),
 		preference.NewCmdPreference(ctx, preference.RecommendedCommandName, util.GetFullName(fullName, preference.RecommendedCommandName), testClientset),
-		telemetry.NewCmdTelemetry(telemetry.RecommendedCommandName, testClientset),
+		telemetry.NewCmdTelemetry(telemetry.RecommendedCommandName, util.GetFullName(fullName, telemetry.RecommendedCommandName), testClientset),
 		list.NewCmdList(ctx, list.RecommendedCommandName, util.GetFullName(fullName, list.RecommendedCommandName), testClientset),
 		build_images.NewCmdBuildImages(build_images.RecommendedCommandName, util.GetFullName(fullName, build_images.RecommendedCommandName), testClientset),
 		deploy.NewCmdDeploy(deploy.RecommendedCommandName, util.GetFullName(fullName, deploy.RecommendedCommandName), testClientset),
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +29,7 @@ import (
 	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
+	"github\.com/danielpickens/astra/pkg/astra/util"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -43,6 +45,12 @@ var deleteExample = ktemplates.Examples(`
 
 # Delete the component named 'frontend' in the 'myproject' namespace from the cluster
 %[1]s --name frontend --namespace myproject
+
+# Delete the components running in Dev mode whose 'astra dev' session is gone
+%[1]s --all-stale
+
+# Delete the components running in Dev mode whose 'astra dev' session has not been seen for 3 days
+%[1]s --all-stale --stale-after 3d
 `)
 
 type ComponentOptions struct {
@@ -69,11 +77,21 @@ type ComponentOptions struct {
 	// It can be either Dev, Deploy or Any (using constant labels.Component*Mode).
 	runningIn string
 
+	// allStaleFlag deletes the components running in Dev mode whose astra dev session is gone
+	allStaleFlag bool
+
+	// staleAfterFlag is the duration after which a component without any sign of its astra dev session is considered stale
+	staleAfterFlag string
+
+	// staleAfter translates staleAfterFlag into a duration
+	staleAfter time.Duration
+
 	// Clients
 	clientset *clientset.Clientset
 }
 
 var _ genericclioptions.Runnable = (*ComponentOptions)(nil)
+var _ genericclioptions.SecretsUser = (*ComponentOptions)(nil)
 
 // NewComponentOptions returns new instance of ComponentOptions
 func NewComponentOptions() *ComponentOptions {
@@ -85,7 +103,12 @@ func (o *ComponentOptions) SetClientset(clientset *clientset.Clientset) {
 }
 
 func (o *ComponentOptions) UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
-	return o.name == ""
+	return o.name == "" && !o.allStaleFlag
+}
+
+// UseSecrets returns true, as the preStop events of the Devfile are executed when the component is deleted
+func (o *ComponentOptions) UseSecrets(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return true
 }
 
 func (o *ComponentOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
@@ -112,6 +135,14 @@ func (o *ComponentOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline
 		o.clientset.KubernetesClient = nil
 	}
 
+	if o.allStaleFlag {
+		o.staleAfter, err = util.ParseDurationWithDays(o.staleAfterFlag)
+		if err != nil {
+			return fmt.Errorf("invalid value for --stale-after: %w", err)
+		}
+		return nil
+	}
+
 	// 1. Name is not passed, and astra has access to devfile.yaml; Name is not passed so we assume that astra has access to the devfile.yaml
 	if o.name == "" {
 		devfileObj := astracontext.GetEffectiveDevfileObj(ctx)
@@ -136,10 +167,21 @@ func (o *ComponentOptions) Validate(ctx context.Context) error {
 	if o.withFilesFlag && o.name != "" {
 		return errors.New("'--files' cannot be used with '--name'; '--files' must be used from a directory containing a Devfile")
 	}
+	if o.allStaleFlag {
+		if o.name != "" || o.withFilesFlag || o.namespace != "" {
+			return errors.New("'--all-stale' cannot be used with '--name', '--namespace' or '--files'")
+		}
+		if o.runningIn == labels.ComponentDeployMode {
+			return errors.New("'--all-stale' only deletes components running in the dev mode and cannot be used with '--running-in deploy'")
+		}
+	}
 	return nil
 }
 
 func (o *ComponentOptions) Run(ctx context.Context) error {
+	if o.allStaleFlag {
+		return o.deleteStaleComponents(ctx)
+	}
 	if o.name != "" {
 		return o.deleteNamedComponent(ctx)
 	}
@@ -209,11 +251,9 @@ func (o *ComponentOptions) deleteNamedComponent(ctx context.Context) error {
 
 		if len(podmanResources) > 0 {
 			spinner := log.Fspinnerf(o.clientset.Stdout, "Deleting resources from podman")
-			for _, pod := range podmanResources {
-				err = o.clientset.PodmanClient.CleanupPodResources(pod, true)
-				if err != nil {
-					log.Fwarningf(o.clientset.Stderr, "Failed to delete the pod %q from podman: %s\n", pod.GetName(), err)
-				}
+			failed := o.clientset.DeleteClient.DeletePodmanResources(podmanResources)
+			for _, fail := range failed {
+				log.Fwarningf(o.clientset.Stderr, "Failed to delete the pod %q from podman: %s\n", fail.Pod.GetName(), fail.Err)
 			}
 			spinner.End(true)
 			successMsg := fmt.Sprintf("The component %q is successfully deleted from podman", o.name)
@@ -383,16 +423,17 @@ func (o *ComponentOptions) deleteDevfileComponent(ctx context.Context) ([]unstru
 
 		if hasPodmanResources {
 			spinner := log.Fspinnerf(o.clientset.Stdout, "Deleting resources from podman")
+			// if innerloop pod is present, then execute preStop events
 			if isPodmanInnerLoopDeployed {
-				// Tastra(feloy) #6424
-				_ = isPodmanInnerLoopDeployed
-			}
-			for _, pod := range podmanPods {
-				err = o.clientset.PodmanClient.CleanupPodResources(pod, true)
+				err = o.clientset.DeleteClient.ExecutePodmanPreStopEvents(ctx, *devfileObj, appName, componentName)
 				if err != nil {
-					log.Fwarningf(o.clientset.Stderr, "Failed to delete the pod %q from podman: %s\n", pod.GetName(), err)
+					log.Ferrorf(o.clientset.Stderr, "Failed to execute preStop events: %v", err)
 				}
 			}
+			failed := o.clientset.DeleteClient.DeletePodmanResources(podmanPods)
+			for _, fail := range failed {
+				log.Fwarningf(o.clientset.Stderr, "Failed to delete the pod %q from podman: %s\n", fail.Pod.GetName(), fail.Err)
+			}
 			spinner.End(true)
 			log.Finfof(o.clientset.Stdout, "The component %q is successfully deleted from podman", componentName)
 		}
@@ -566,6 +607,10 @@ func NewCmdComponent(ctx context.Context, name, fullName string, testClientset c
 	componentCmd.Flags().StringVar(&o.namespace, "namespace", "", "Namespace in which to find the component to delete, optional. By default, the current namespace defined in kubeconfig is used")
 	componentCmd.Flags().StringVar(&o.runningInFlag, "running-in", "",
 		"Delete resources running in the specified mode, optional. By default, all resources created by astra for the component are deleted.")
+	componentCmd.Flags().BoolVar(&o.allStaleFlag, "all-stale", false,
+		"Delete the components running in the dev mode in the current namespace and on podman whose 'astra dev' session is gone.")
+	componentCmd.Flags().StringVar(&o.staleAfterFlag, "stale-after", "24h",
+		"With --all-stale, duration after which a component whose session cannot be checked from this host is considered stale (e.g. 12h, 7d)")
 	componentCmd.Flags().BoolVarP(&o.withFilesFlag, "files", "", false, "Delete all files and directories generated by astra. Use with caution.")
 	componentCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Delete component without prompting")
 	componentCmd.Flags().BoolVarP(&o.waitFlag, "wait", "w", false, "Wait for deletion of all dependent resources")
@@ -213,13 +213,13 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				},
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
-					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
 					client := _delete.NewMockClient(ctrl)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", "").
 						Return(true, []*corev1.Pod{&pod1}, nil).Times(1)
+					client.EXPECT().DeletePodmanResources([]*corev1.Pod{&pod1}).Times(1)
 					return client
 				},
 			},
@@ -235,13 +235,13 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				},
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
-					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
 					client := _delete.NewMockClient(ctrl)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", labels.ComponentDevMode).
 						Return(true, []*corev1.Pod{&pod1}, nil).Times(1)
+					client.EXPECT().DeletePodmanResources([]*corev1.Pod{&pod1}).Times(1)
 					return client
 				},
 			},
@@ -257,13 +257,13 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				},
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
-					client.EXPECT().CleanupPodResources(&pod1, true).Times(0)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
 					client := _delete.NewMockClient(ctrl)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", labels.ComponentDeployMode).
 						Return(false, nil, nil).Times(1)
+					client.EXPECT().DeletePodmanResources(gomock.Any()).Times(0)
 					return client
 				},
 			},
@@ -280,7 +280,6 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				},
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
-					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
@@ -293,6 +292,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 						Return(resources, nil)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", "").
 						Return(true, []*corev1.Pod{&pod1}, nil).Times(1)
+					client.EXPECT().DeletePodmanResources([]*corev1.Pod{&pod1}).Times(1)
 					client.EXPECT().DeleteResources([]unstructured.Unstructured{res1, res2}, false).Times(1)
 					return client
 				},
@@ -311,7 +311,6 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				},
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
-					client.EXPECT().CleanupPodResources(&pod1, true).Times(1)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
@@ -324,6 +323,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 						Return([]unstructured.Unstructured{res2}, nil).Times(0)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", labels.ComponentDevMode).
 						Return(true, []*corev1.Pod{&pod1}, nil).Times(1)
+					client.EXPECT().DeletePodmanResources([]*corev1.Pod{&pod1}).Times(1)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", labels.ComponentDeployMode).
 						Return(false, nil, nil).Times(0)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", labels.ComponentAnyMode).
@@ -346,7 +346,6 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 				},
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					client := podman.NewMockClient(ctrl)
-					client.EXPECT().CleanupPodResources(&pod1, true).Times(0)
 					return client
 				},
 				deleteComponentClient: func(ctrl *gomock.Controller) _delete.Client {
@@ -359,6 +358,7 @@ func TestComponentOptions_deleteNamedComponent(t *testing.T) {
 						Return([]unstructured.Unstructured{res2}, nil).Times(0)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", labels.ComponentDeployMode).
 						Return(false, nil, nil)
+					client.EXPECT().DeletePodmanResources(gomock.Any()).Times(0)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", labels.ComponentDevMode).
 						Return(true, []*corev1.Pod{&pod1}, nil).Times(0)
 					client.EXPECT().ListPodmanResourcesToDelete("app", "my-component", labels.ComponentAnyMode).
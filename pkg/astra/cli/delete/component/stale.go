@@ -0,0 +1,201 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/klog"
+
+	_delete "github\.com/danielpickens/astra/pkg/component/delete"
+	"github\.com/danielpickens/astra/pkg/devfile"
+	"github\.com/danielpickens/astra/pkg/devfile/location"
+	"github\.com/danielpickens/astra/pkg/log"
+	clierrors "github\.com/danielpickens/astra/pkg/astra/cli/errors"
+	"github\.com/danielpickens/astra/pkg/astra/cli/ui"
+	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+)
+
+// staleComponent is a component running in Dev mode whose astra dev session is considered gone
+type staleComponent struct {
+	_delete.DevComponent
+	// reason explains why the session is considered gone
+	reason string
+}
+
+// deleteStaleComponents deletes the resources of the components running in Dev mode whose astra dev session is gone
+func (o *ComponentOptions) deleteStaleComponents(ctx context.Context) error {
+	log.Finfof(o.clientset.Stdout, "Searching stale components, please wait...")
+	var components []_delete.DevComponent
+	if o.clientset.KubernetesClient != nil {
+		clusterComponents, err := o.clientset.DeleteClient.ListClusterDevComponents(ctx)
+		if err != nil {
+			return err
+		}
+		components = append(components, clusterComponents...)
+	}
+	if o.clientset.PodmanClient != nil {
+		podmanComponents, err := o.clientset.DeleteClient.ListPodmanDevComponents(ctx)
+		if err != nil {
+			if !clierrors.AsWarning(err) {
+				return err
+			}
+			log.Fwarning(o.clientset.Stderr, err.Error())
+		}
+		components = append(components, podmanComponents...)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		klog.V(4).Infof("unable to get the name of the host: %v", err)
+	}
+	now := time.Now()
+	var stales []staleComponent
+	for _, component := range components {
+		var reason string
+		reason, err = o.getStaleReason(ctx, component, hostname, now)
+		if err != nil {
+			return err
+		}
+		if reason == "" {
+			klog.V(4).Infof("the session of the component %q on %s is still running", component.Name, component.Platform)
+			continue
+		}
+		stales = append(stales, staleComponent{DevComponent: component, reason: reason})
+	}
+
+	if len(stales) == 0 {
+		log.Finfof(o.clientset.Stdout, "No stale component found")
+		return nil
+	}
+	o.printStaleComponents(stales)
+
+	proceed := o.forceFlag
+	if !proceed {
+		proceed, err = ui.Proceed("Are you sure you want to delete these resources?")
+		if err != nil {
+			return err
+		}
+	}
+	if !proceed {
+		log.Ferror(o.clientset.Stderr, "Aborting deletion of stale components")
+		return nil
+	}
+
+	for _, stale := range stales {
+		switch stale.Platform {
+		case commonflags.PlatformCluster:
+			o.deleteStaleClusterComponent(ctx, stale, hostname)
+		case commonflags.PlatformPodman:
+			o.deleteStalePodmanComponent(ctx, stale, hostname)
+		}
+	}
+	log.Finfof(o.clientset.Stdout, "The stale components are successfully deleted")
+	return nil
+}
+
+// getStaleReason returns why the astra dev session which has deployed the component is considered gone,
+// or an empty string if the session may still be running
+func (o *ComponentOptions) getStaleReason(ctx context.Context, component _delete.DevComponent, hostname string, now time.Time) (string, error) {
+	session := component.Session
+	if session.Host != "" && session.Host == hostname && session.PID != 0 {
+		// The session has been started on this host, its state can be checked directly
+		sessions, err := o.clientset.StateClient.GetDevSessions(ctx, session.Directory)
+		if err != nil {
+			return "", err
+		}
+		for _, running := range sessions {
+			if running.PID == session.PID {
+				return "", nil
+			}
+		}
+		return fmt.Sprintf("the astra dev session (PID %d) in %q is not running anymore", session.PID, session.Directory), nil
+	}
+
+	if !component.Heartbeat.IsZero() {
+		if now.Sub(component.Heartbeat) > o.staleAfter {
+			return fmt.Sprintf("no heartbeat from the astra dev session for %s", duration.HumanDuration(now.Sub(component.Heartbeat))), nil
+		}
+		return "", nil
+	}
+
+	if !component.Created.IsZero() && now.Sub(component.Created) > o.staleAfter {
+		return fmt.Sprintf("deployed %s ago, with no information about its astra dev session", duration.HumanDuration(now.Sub(component.Created))), nil
+	}
+	return "", nil
+}
+
+// deleteStaleClusterComponent deletes the resources of the stale component from the cluster,
+// after executing its preStop events if the devfile of the component can be found on this host
+func (o *ComponentOptions) deleteStaleClusterComponent(ctx context.Context, stale staleComponent, hostname string) {
+	spinner := log.Fspinnerf(o.clientset.Stdout, "Deleting resources of %q from namespace %q", stale.Name, stale.Namespace)
+	defer spinner.End(true)
+
+	o.executeStalePreStopEvents(ctx, stale, hostname, o.clientset.DeleteClient.ExecutePreStopEvents)
+
+	failed := o.clientset.DeleteClient.DeleteResources(stale.ClusterResources, o.waitFlag)
+	for _, fail := range failed {
+		log.Fwarningf(o.clientset.Stderr, "Failed to delete the %q resource: %s\n", fail.GetKind(), fail.GetName())
+	}
+}
+
+// deleteStalePodmanComponent deletes the pods of the stale component from podman,
+// after executing its preStop events if the devfile of the component can be found on this host
+func (o *ComponentOptions) deleteStalePodmanComponent(ctx context.Context, stale staleComponent, hostname string) {
+	spinner := log.Fspinnerf(o.clientset.Stdout, "Deleting resources of %q from podman", stale.Name)
+	defer spinner.End(true)
+
+	o.executeStalePreStopEvents(ctx, stale, hostname, o.clientset.DeleteClient.ExecutePodmanPreStopEvents)
+
+	failed := o.clientset.DeleteClient.DeletePodmanResources(stale.PodmanPods)
+	for _, fail := range failed {
+		log.Fwarningf(o.clientset.Stderr, "Failed to delete the pod %q from podman: %s\n", fail.Pod.GetName(), fail.Err)
+	}
+}
+
+// executeStalePreStopEvents executes the preStop events of the stale component with execute,
+// if its session has been started on this host and its devfile can still be parsed
+func (o *ComponentOptions) executeStalePreStopEvents(
+	ctx context.Context,
+	stale staleComponent,
+	hostname string,
+	execute func(ctx context.Context, devfileObj parser.DevfileObj, appName string, componentName string) error,
+) {
+	if stale.Session.Host != hostname || stale.Session.Directory == "" {
+		return
+	}
+	devfilePath := location.DevfileLocation(o.clientset.FS, stale.Session.Directory)
+	devfileObj, err := devfile.ParseAndValidateFromFileWithSecrets(devfilePath, nil, "")
+	if err != nil {
+		klog.V(2).Infof("unable to parse the devfile %q, not executing preStop events: %v", devfilePath, err)
+		return
+	}
+	err = execute(ctx, devfileObj, astracontext.GetApplication(ctx), stale.Name)
+	if err != nil {
+		log.Ferrorf(o.clientset.Stderr, "Failed to execute preStop events: %v", err)
+	}
+}
+
+// printStaleComponents prints the stale components and the resources which will be deleted
+func (o *ComponentOptions) printStaleComponents(stales []staleComponent) {
+	for _, stale := range stales {
+		if stale.Platform == commonflags.PlatformPodman {
+			log.Finfof(o.clientset.Stdout, "The component %q on podman is stale: %s", stale.Name, stale.reason)
+			log.Fprintf(o.clientset.Stdout, "The following pods and associated volumes will get deleted from podman:")
+			for _, pod := range stale.PodmanPods {
+				log.Fprintf(o.clientset.Stdout, "\t- %s", pod.GetName())
+			}
+		} else {
+			log.Finfof(o.clientset.Stdout, "The component %q in the namespace %q is stale: %s", stale.Name, stale.Namespace, stale.reason)
+			log.Fprintf(o.clientset.Stdout, "The following resources will get deleted from cluster:")
+			for _, resource := range stale.ClusterResources {
+				log.Fprintf(o.clientset.Stdout, "\t- %s: %s", resource.GetKind(), resource.GetName())
+			}
+		}
+		log.Fprintln(o.clientset.Stdout)
+	}
+}
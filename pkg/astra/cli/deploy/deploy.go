@@ -34,6 +34,7 @@ type DeployOptions struct {
 }
 
 var _ genericclioptions.Runnable = (*DeployOptions)(nil)
+var _ genericclioptions.SecretsUser = (*DeployOptions)(nil)
 
 var deployExample = templates.Examples(`
   # Run the components defined in the Devfile on the cluster in the Deploy mode
@@ -49,6 +50,11 @@ func (o *DeployOptions) SetClientset(clientset *clientset.Clientset) {
 	o.clientset = clientset
 }
 
+// UseSecrets returns true, as the commands of the Devfile are executed and its images built in the Deploy mode
+func (o *DeployOptions) UseSecrets(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return true
+}
+
 func (o *DeployOptions) PreInit() string {
 	return messages.DeployInitializeExistingComponent
 }
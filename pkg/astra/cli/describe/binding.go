@@ -172,6 +172,9 @@ func printSingleBindingHumanReadableOutput(binding api.ServiceBinding) bool {
 		log.Describef("Available binding information: ", "unknown")
 		return true
 	}
+	if binding.Status.Operatorless {
+		log.Describef("Processed by: ", "astra (Service Binding Operator not installed)")
+	}
 	log.Info("Available binding information:")
 	for _, info := range binding.Status.BindingFiles {
 		log.Printf(info)
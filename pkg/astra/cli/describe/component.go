@@ -14,6 +14,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github\.com/danielpickens/astra/pkg/api"
+	apiserver_impl "github\.com/danielpickens/astra/pkg/apiserver-impl"
 	"github\.com/danielpickens/astra/pkg/component/describe"
 	"github\.com/danielpickens/astra/pkg/kclient"
 	"github\.com/danielpickens/astra/pkg/log"
@@ -36,6 +37,9 @@ var describeExample = ktemplates.Examples(`
 
 # Describe a component deployed in the cluster
 %[1]s --name frontend --namespace myproject
+
+# Describe the component in the current directory, with the state of its resources
+%[1]s --tree
 `)
 
 type ComponentOptions struct {
@@ -45,6 +49,9 @@ type ComponentOptions struct {
 	// namespaceFlag on which to find the component to describe, optional, defaults to current namespaceFlag
 	namespaceFlag string
 
+	// treeFlag displays the resources deployed for the component, with their state
+	treeFlag bool
+
 	// Clients
 	clientset *clientset.Clientset
 }
@@ -129,10 +136,37 @@ func (o *ComponentOptions) RunForJsonOutput(ctx context.Context) (out interface{
 }
 
 func (o *ComponentOptions) run(ctx context.Context) (result api.Component, devfileObj *parser.DevfileObj, err error) {
+	componentName := o.nameFlag
 	if o.nameFlag != "" {
-		return describe.DescribeNamedComponent(ctx, o.nameFlag, o.clientset.KubernetesClient, o.clientset.PodmanClient)
+		result, devfileObj, err = describe.DescribeNamedComponent(ctx, o.nameFlag, o.clientset.KubernetesClient, o.clientset.PodmanClient)
+	} else {
+		componentName = astracontext.GetComponentName(ctx)
+		result, devfileObj, err = describe.DescribeDevfileComponent(ctx, o.clientset.KubernetesClient, o.clientset.PodmanClient, o.clientset.StateClient)
+	}
+	if err != nil && !clierrors.AsWarning(err) {
+		return result, devfileObj, err
+	}
+	if o.treeFlag {
+		resources, treeErr := o.getResourceTree(ctx, componentName)
+		if treeErr != nil {
+			return result, devfileObj, treeErr
+		}
+		result.Resources = resources
+	}
+	return result, devfileObj, err
+}
+
+// getResourceTree returns the resources deployed for the component on the platforms selected by the --platform flag
+func (o *ComponentOptions) getResourceTree(ctx context.Context, componentName string) ([]api.ResourceNode, error) {
+	kubeClient := o.clientset.KubernetesClient
+	podmanClient := o.clientset.PodmanClient
+	switch fcontext.GetPlatform(ctx, "") {
+	case commonflags.PlatformCluster:
+		podmanClient = nil
+	case commonflags.PlatformPodman:
+		kubeClient = nil
 	}
-	return describe.DescribeDevfileComponent(ctx, o.clientset.KubernetesClient, o.clientset.PodmanClient, o.clientset.StateClient)
+	return describe.GetResourceTree(componentName, astracontext.GetApplication(ctx), kubeClient, podmanClient)
 }
 
 func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj *parser.DevfileObj) error {
@@ -163,15 +197,20 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 	if len(cmp.DevControlPlane) != 0 {
 		var webui string
 		if feature.IsEnabled(ctx, feature.UIServer) {
-			webui = "\n      Web UI: http://%[2]s:%[3]d/"
+			webui = "\n      Web UI: %[5]s://%[2]s:%[3]d/"
 		}
 		const ctrlPlaneHost = "localhost"
 		log.Info("Dev Control Plane:")
 		for _, dcp := range cmp.DevControlPlane {
+			dcpWebui := webui
+			if webui != "" && dcp.Token != "" {
+				// the web console gets the token from the URL
+				dcpWebui += "?" + apiserver_impl.TokenQueryParam + "=" + dcp.Token
+			}
 			log.Printf(`%[1]s
-      API: http://%[2]s:%[3]d/%[4]s`+webui,
+      API: %[5]s://%[2]s:%[3]d/%[4]s`+dcpWebui,
 				log.Sbold(dcp.Platform),
-				ctrlPlaneHost, dcp.LocalPort, strings.TrimPrefix(dcp.APIServerPath, "/"))
+				ctrlPlaneHost, dcp.LocalPort, strings.TrimPrefix(dcp.APIServerPath, "/"), dcp.Scheme())
 		}
 		fmt.Println()
 	}
@@ -288,9 +327,55 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		fmt.Println()
 	}
 
+	if len(cmp.Resources) != 0 {
+		log.Info("Resources:")
+		for _, resource := range cmp.Resources {
+			// The first line is indented by log.Printf
+			log.Printf("%s", strings.TrimSpace(formatResourceNode(resource, "    ")))
+		}
+		fmt.Println()
+	}
+
 	return nil
 }
 
+// formatResourceNode returns the description of a resource and of its children, one per line
+func formatResourceNode(node api.ResourceNode, indent string) string {
+	var details []string
+	if node.Status != "" {
+		details = append(details, node.Status)
+	}
+	if node.Ready != nil {
+		if *node.Ready {
+			details = append(details, "ready")
+		} else {
+			details = append(details, "not ready")
+		}
+	}
+	if node.Restarts > 0 {
+		details = append(details, fmt.Sprintf("%d restarts", node.Restarts))
+	}
+	line := node.Kind + " " + log.Sbold(node.Name)
+	if node.Platform != "" {
+		line = fmt.Sprintf("[%s] ", node.Platform) + line
+	}
+	if len(details) > 0 {
+		line += " (" + strings.Join(details, ", ") + ")"
+	}
+	result := indent + line + "\n"
+	for _, event := range node.Events {
+		result += fmt.Sprintf("%s    ! %s: %s", indent, event.Reason, event.Message)
+		if event.Count > 1 {
+			result += fmt.Sprintf(" (x%d)", event.Count)
+		}
+		result += "\n"
+	}
+	for _, child := range node.Children {
+		result += formatResourceNode(child, indent+"    ")
+	}
+	return result
+}
+
 func listComponentsNames(title string, devfileObj *parser.DevfileObj, typ v1alpha2.ComponentType) error {
 	if devfileObj == nil {
 		log.Describef(title, " Unknown")
@@ -332,6 +417,7 @@ func NewCmdComponent(ctx context.Context, name, fullName string, testClientset c
 		},
 	}
 	componentCmd.Flags().StringVar(&o.nameFlag, "name", "", "Name of the component to describe, optional. By default, the component in the local devfile is described")
+	componentCmd.Flags().BoolVar(&o.treeFlag, "tree", false, "Display the resources deployed for the component, with their state and their last warning events")
 	componentCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace in which to find the component to describe, optional. By default, the current namespace defined in kubeconfig is used")
 	clientset.Add(componentCmd, clientset.KUBERNETES_NULLABLE, clientset.STATE, clientset.FILESYSTEM)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
@@ -21,6 +21,7 @@ import (
 
 	"github\.com/danielpickens/astra/pkg/api"
 	"github\.com/danielpickens/astra/pkg/component"
+	envcontext "github\.com/danielpickens/astra/pkg/config/context"
 	"github\.com/danielpickens/astra/pkg/dev"
 	"github\.com/danielpickens/astra/pkg/kclient"
 	astralabels "github\.com/danielpickens/astra/pkg/labels"
@@ -74,11 +75,13 @@ type DevOptions struct {
 	noCommandsFlag       bool
 	apiServerFlag        bool
 	apiServerPortFlag    int
+	apiServerTLSFlag     bool
 	syncGitDirFlag       bool
 	logsFlag             bool
 }
 
 var _ genericclioptions.Runnable = (*DevOptions)(nil)
+var _ genericclioptions.SecretsUser = (*DevOptions)(nil)
 var _ genericclioptions.SignalHandler = (*DevOptions)(nil)
 
 func NewDevOptions() *DevOptions {
@@ -106,6 +109,11 @@ func (o *DevOptions) SetClientset(clientset *clientset.Clientset) {
 	o.clientset = clientset
 }
 
+// UseSecrets returns true, as the commands of the Devfile are executed in the Dev mode
+func (o *DevOptions) UseSecrets(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return true
+}
+
 func (o *DevOptions) PreInit() string {
 	return messages.DevInitializeExistingComponent
 }
@@ -113,6 +121,20 @@ func (o *DevOptions) PreInit() string {
 func (o *DevOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
 	// Define this first so that if user hits Ctrl+c very soon after running astra dev, astra doesn't panic
 	o.ctx, o.cancel = context.WithCancel(ctx)
+
+	// Use the defaults of the project configuration for the flags not set
+	projectConfig := envcontext.GetProjectConfig(ctx)
+	if !o.noCommandsFlag {
+		if o.buildCommandFlag == "" {
+			o.buildCommandFlag = projectConfig.BuildCommand
+		}
+		if o.runCommandFlag == "" {
+			o.runCommandFlag = projectConfig.RunCommand
+		}
+	}
+	if o.portForwardFlag == nil && !o.randomPortsFlag {
+		o.portForwardFlag = projectConfig.PortForward
+	}
 	return nil
 }
 
@@ -182,6 +204,10 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 		return errors.New("--api-server-port makes sense only if --api-server is enabled")
 	}
 
+	if !o.apiServerFlag && o.apiServerTLSFlag {
+		return errors.New("--api-server-tls makes sense only if --api-server is enabled")
+	}
+
 	if o.apiServerFlag && o.apiServerPortFlag != 0 {
 		if o.randomPortsFlag {
 			return errors.New("--random-ports and --api-server-port cannot be used together")
@@ -243,7 +269,7 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		return err
 	}
 	// Ignore the devfile, as it will be handled independently
-	o.ignorePaths = ignores
+	o.ignorePaths = append(ignores, envcontext.GetProjectConfig(ctx).Ignore...)
 
 	if o.syncGitDirFlag {
 		o.ignorePaths = removeGitDir(o.ignorePaths)
@@ -262,6 +288,12 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		return err
 	}
 
+	// Register the project, so the session can be managed by `astra api-server --daemon`
+	err = o.clientset.DevSessionsClient.RegisterDirectory(path)
+	if err != nil {
+		klog.V(1).Infof("unable to register the project directory %q: %v", path, err)
+	}
+
 	var apiServer apiserver_impl.ApiServer
 	if o.apiServerFlag {
 		var devfileFiles []string
@@ -276,6 +308,7 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 			o.cancel,
 			o.randomPortsFlag,
 			o.apiServerPortFlag,
+			o.apiServerTLSFlag,
 			devfilePath,
 			devfileFiles,
 			o.clientset.FS,
@@ -284,6 +317,9 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 			o.clientset.StateClient,
 			o.clientset.PreferenceClient,
 			o.clientset.InformerClient,
+			o.clientset.EventsClient,
+			o.clientset.LogsClient,
+			o.clientset.ExecClient,
 		)
 		if err != nil {
 			return err
@@ -422,10 +458,12 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 	devCmd.Flags().BoolVar(&o.logsFlag, "logs", false, "Follow logs of component")
 	devCmd.Flags().BoolVar(&o.apiServerFlag, "api-server", true, "Start the API Server")
 	devCmd.Flags().IntVar(&o.apiServerPortFlag, "api-server-port", 0, "Define custom port for API Server; this flag should be used in combination with --api-server flag.")
+	devCmd.Flags().BoolVar(&o.apiServerTLSFlag, "api-server-tls", false, "Serve the API Server over TLS, with a self-signed certificate generated for the session; this flag should be used in combination with --api-server flag.")
 
 	clientset.Add(devCmd,
 		clientset.BINDING,
 		clientset.DEV,
+		clientset.DEV_SESSIONS,
 		clientset.EXEC,
 		clientset.FILESYSTEM,
 		clientset.INFORMER,
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github\.com/danielpickens/astra/pkg/config"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 	"github\.com/danielpickens/astra/pkg/util"
 )
@@ -54,10 +55,25 @@ func ReportLocalFileGeneratedByastra(filesys filesystem.Filesystem, rootDirector
 // Under the hood, it works by reading the content of the .astra/generated file (ignoring blank lines),
 // which is filled by any command that generates a file.
 // astra commands that generate files should call ReportLocalFileGeneratedByastra anytime a file is generated.
-// Note that the .astra directory itself is always included in the list returned.
+// Note that the .astra directory itself is always included in the list returned,
+// unless it contains the configuration file of the project, which is not generated by astra;
+// in this case, the other files of the .astra directory are included instead.
 // No error is returned if the .astra/generated file does not exist.
 func GetFilesGeneratedByastra(filesys filesystem.Filesystem, rootDirectory string) ([]string, error) {
 	list := []string{util.DotastraDirectory}
+	projectConfigFile := config.GetProjectConfigurationPath(rootDirectory)
+	if _, err := filesys.Stat(projectConfigFile); err == nil {
+		entries, err := filesys.ReadDir(filepath.Dir(projectConfigFile))
+		if err != nil {
+			return nil, err
+		}
+		list = nil
+		for _, entry := range entries {
+			if entry.Name() != filepath.Base(projectConfigFile) {
+				list = append(list, filepath.Join(util.DotastraDirectory, entry.Name()))
+			}
+		}
+	}
 
 	astraGeneratedFile := filepath.Join(rootDirectory, util.DotastraDirectory, _dotastraGenerated)
 	f, err := filesys.OpenFile(astraGeneratedFile, os.O_RDWR, 0666)
@@ -136,6 +136,33 @@ func TestGetFilesGeneratedByastra(t *testing.T) {
 			wantErr: false,
 			want:    []string{util.DotastraDirectory, "devfile.yaml", ".gitignore", "/path/to/a/file", "a-path with spaces"},
 		},
+		{
+			name: ".astra directory with a project configuration file that should be kept",
+			args: args{
+				fsProvider: func() filesystem.Filesystem {
+					return fakeFs
+				},
+				rootDir: "/path/to/root/directory/6",
+			},
+			setup: func() error {
+				dir := filepath.Join("/path/to/root/directory/6", util.DotastraDirectory)
+				err := fakeFs.WriteFile(filepath.Join(dir, _dotastraGenerated), []byte("devfile.yaml\n"), 0644)
+				if err != nil {
+					return err
+				}
+				err = fakeFs.WriteFile(filepath.Join(dir, "devstate.json"), []byte("{}"), 0644)
+				if err != nil {
+					return err
+				}
+				return fakeFs.WriteFile(filepath.Join(dir, "config.yaml"), []byte("platform: podman\n"), 0644)
+			},
+			wantErr: false,
+			want: []string{
+				filepath.Join(util.DotastraDirectory, "devstate.json"),
+				filepath.Join(util.DotastraDirectory, _dotastraGenerated),
+				"devfile.yaml",
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.setup != nil {
@@ -0,0 +1,103 @@
+package init
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/component"
+	"github\.com/danielpickens/astra/pkg/devfile/importer"
+	"github\.com/danielpickens/astra/pkg/devfile/location"
+	"github\.com/danielpickens/astra/pkg/init/backend"
+	"github\.com/danielpickens/astra/pkg/libdevfile"
+	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/astra/cli/files"
+	fcontext "github\.com/danielpickens/astra/pkg/astra/commonflags/context"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+	scontext "github\.com/danielpickens/astra/pkg/segment/context"
+)
+
+// validateFrom validates the options when the --from flag is used
+func (o *InitOptions) validateFrom(ctx context.Context) error {
+	if _, err := importer.ParseSource(o.fromFlag); err != nil {
+		return err
+	}
+	for flag := range o.flags {
+		if flag != backend.FLAG_NAME {
+			return fmt.Errorf("--%s cannot be used with --from", flag)
+		}
+	}
+	if name := o.flags[backend.FLAG_NAME]; name != "" {
+		if err := dfutil.ValidateK8sResourceName("name", name); err != nil {
+			return err
+		}
+	}
+
+	workingDir := astracontext.GetWorkingDirectory(ctx)
+	devfilePresent, err := location.DirectoryContainsDevfile(o.clientset.FS, workingDir)
+	if err != nil {
+		return err
+	}
+	if devfilePresent {
+		return errors.New("a devfile already exists in the current directory")
+	}
+	return nil
+}
+
+// runFrom generates a devfile from an existing Dockerfile, Compose file or Kubernetes manifests,
+// and reports the elements of the source which could not be represented in the devfile.
+// These elements are returned, to be included in the JSON output
+func (o *InitOptions) runFrom(ctx context.Context) (parser.DevfileObj, string, []string, error) {
+	var (
+		workingDir = astracontext.GetWorkingDirectory(ctx)
+		jsonOutput = fcontext.IsJsonOutput(ctx)
+	)
+
+	source, err := importer.ParseSource(o.fromFlag)
+	if err != nil {
+		return parser.DevfileObj{}, "", nil, err
+	}
+
+	if !jsonOutput {
+		log.Title("Generating a devfile from the existing "+o.fromFlag+" description of the project", "")
+	}
+
+	devfileObj, devfilePath, unmapped, err := o.clientset.InitClient.GenerateDevfile(ctx, source, o.fromPathFlag, o.flags[backend.FLAG_NAME], workingDir)
+	if err != nil {
+		return parser.DevfileObj{}, "", nil, err
+	}
+
+	err = files.ReportLocalFileGeneratedByastra(o.clientset.FS, workingDir, filepath.Base(devfilePath))
+	if err != nil {
+		klog.V(4).Infof("error trying to report local file generated: %v", err)
+	}
+
+	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
+	scontext.SetDevfileName(ctx, devfileObj.GetMetadataName())
+
+	if jsonOutput {
+		return devfileObj, devfilePath, unmapped, nil
+	}
+
+	if len(unmapped) > 0 {
+		log.Warning("The following elements could not be represented in the devfile, please review it:")
+		for _, msg := range unmapped {
+			log.Printf("%s", msg)
+		}
+	}
+
+	exitMessage := fmt.Sprintf(`
+Your new component '%s' is ready in the current directory.
+To start editing your component, use 'astra dev' and open this folder in your favorite IDE.
+Changes will be directly reflected on the cluster.`, devfileObj.GetMetadataName())
+	if libdevfile.HasDeployCommand(devfileObj.Data) {
+		exitMessage += "\nTo deploy your component to a cluster use \"astra deploy\"."
+	}
+	log.Info(exitMessage)
+	return devfileObj, devfilePath, unmapped, nil
+}
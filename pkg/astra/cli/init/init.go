@@ -17,6 +17,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/api"
 	"github\.com/danielpickens/astra/pkg/component"
 	"github\.com/danielpickens/astra/pkg/devfile"
+	"github\.com/danielpickens/astra/pkg/devfile/importer"
 	"github\.com/danielpickens/astra/pkg/devfile/location"
 	"github\.com/danielpickens/astra/pkg/init/backend"
 	"github\.com/danielpickens/astra/pkg/libdevfile"
@@ -63,8 +64,26 @@ var initExample = templates.Examples(`
   # Bootstrap a new component and download a starter project
   %[1]s --name my-app --devfile nodejs --starter nodejs-starter
 
+  # Bootstrap a new component from a template, setting the values of its parameters
+  %[1]s --name my-app --devfile-path https://devfiles.example.com/golden-path/devfile.yaml --starter api --param SERVICE_NAME=orders --param DATABASE=postgresql
+
   # Bootstrap a new component with a specific devfile from registry for a specific architecture
   %[1]s --name my-app --devfile nodejs --architecture s390x
+
+  # Bootstrap a new component by generating a devfile from the Compose file of the current directory
+  %[1]s --name my-app --from compose
+
+  # Bootstrap a new component by generating a devfile from a specific Dockerfile
+  %[1]s --name my-app --from dockerfile --from-path docker/Dockerfile.dev
+
+  # Bootstrap a new component by generating a devfile from the Kubernetes manifests in a directory
+  %[1]s --name my-app --from k8s --from-path manifests/
+
+  # Bootstrap a component for each project detected in the sub-directories (for example in a monorepo)
+  %[1]s --recursive
+
+  # Bootstrap a component for each project detected in the sub-directories, without confirmation
+  %[1]s --recursive --yes
   `)
 
 type InitOptions struct {
@@ -73,6 +92,18 @@ type InitOptions struct {
 
 	// Flags passed to the command
 	flags map[string]string
+
+	// recursiveFlag indicates to detect and initialize a component for each project found in the directory tree
+	recursiveFlag bool
+
+	// yesFlag indicates to initialize the detected components without confirmation
+	yesFlag bool
+
+	// fromFlag is the type of the existing description of the project to generate the devfile from
+	fromFlag string
+
+	// fromPathFlag is the path of the existing description of the project to generate the devfile from
+	fromPathFlag string
 }
 
 var _ genericclioptions.Runnable = (*InitOptions)(nil)
@@ -106,6 +137,22 @@ func (o *InitOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, arg
 // Validate validates the InitOptions based on completed values
 func (o *InitOptions) Validate(ctx context.Context) error {
 
+	if o.recursiveFlag {
+		if o.fromFlag != "" {
+			return errors.New("--from cannot be used with --recursive")
+		}
+		return o.validateRecursive(ctx)
+	}
+	if o.yesFlag {
+		return errors.New("--yes can only be used with --recursive")
+	}
+	if o.fromFlag != "" {
+		return o.validateFrom(ctx)
+	}
+	if o.fromPathFlag != "" {
+		return errors.New("--from-path can only be used with --from")
+	}
+
 	workingDir := astracontext.GetWorkingDirectory(ctx)
 
 	devfilePresent, err := location.DirectoryContainsDevfile(o.clientset.FS, workingDir)
@@ -130,6 +177,15 @@ func (o *InitOptions) Validate(ctx context.Context) error {
 // Run contains the logic for the astra command
 func (o *InitOptions) Run(ctx context.Context) (err error) {
 
+	if o.recursiveFlag {
+		_, err = o.runRecursive(ctx)
+		return err
+	}
+	if o.fromFlag != "" {
+		_, _, _, err = o.runFrom(ctx)
+		return err
+	}
+
 	devfileObj, _, name, devfileLocation, starterInfo, err := o.run(ctx)
 	if err != nil {
 		return err
@@ -163,7 +219,19 @@ Changes will be directly reflected on the cluster.`, devfileObj.Data.GetMetadata
 
 // RunForJsonOutput is executed instead of Run when -o json flag is given
 func (o *InitOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
-	devfileObj, devfilePath, _, _, _, err := o.run(ctx)
+	if o.recursiveFlag {
+		return o.runRecursive(ctx)
+	}
+	var (
+		devfileObj  parser.DevfileObj
+		devfilePath string
+		unmapped    []string
+	)
+	if o.fromFlag != "" {
+		devfileObj, devfilePath, unmapped, err = o.runFrom(ctx)
+	} else {
+		devfileObj, devfilePath, _, _, _, err = o.run(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -171,13 +239,20 @@ func (o *InitOptions) RunForJsonOutput(ctx context.Context) (out interface{}, er
 	if err != nil {
 		return nil, err
 	}
-	return api.Component{
+	result := api.Component{
 		DevfilePath:       devfilePath,
 		DevfileData:       devfileData,
 		DevForwardedPorts: []api.ForwardedPort{},
 		RunningIn:         api.NewRunningModes(),
 		ManagedBy:         "astra",
-	}, nil
+	}
+	if o.fromFlag != "" {
+		return api.GeneratedComponent{
+			Component: result,
+			Unmapped:  unmapped,
+		}, nil
+	}
+	return result, nil
 }
 
 // run downloads the devfile and starter project and returns the content of the devfile, path of the devfile, name of the component, api.DetectionResult object for DevfileRegistry info and StarterProject object
@@ -251,6 +326,11 @@ func (o *InitOptions) run(ctx context.Context) (devfileObj parser.DevfileObj, pa
 			}
 		}
 	}
+	devfileObj, err = o.clientset.InitClient.ApplyTemplateParameters(devfileObj, starterInfo, o.flags, workingDir)
+	if err != nil {
+		return parser.DevfileObj{}, "", "", nil, nil, fmt.Errorf("unable to apply the template parameters: %w", err)
+	}
+
 	// WARNING: SetMetadataName writes the Devfile to disk
 	if err = devfileObj.SetMetadataName(name); err != nil {
 		return parser.DevfileObj{}, "", "", nil, nil, err
@@ -293,6 +373,11 @@ func NewCmdInit(name, fullName string, testClientset clientset.Clientset) *cobra
 	initCmd.Flags().String(backend.FLAG_DEVFILE_VERSION, "", "version of the devfile stack; use \"latest\" to dowload the latest stack")
 	initCmd.Flags().StringArray(backend.FLAG_ARCHITECTURE, []string{}, "Architecture supported. Can be one or multiple values from amd64, arm64, ppc64le, s390x. Default is amd64.")
 	initCmd.Flags().StringArray(backend.FLAG_RUN_PORT, []string{}, "ports used by the application (via the 'run' command)")
+	initCmd.Flags().StringArray(backend.FLAG_PARAM, []string{}, "value of a template parameter declared by the devfile or the starter project, in the form NAME=VALUE. Can be used multiple times")
+	initCmd.Flags().StringVar(&o.fromFlag, "from", "", fmt.Sprintf("generate the devfile from an existing description of the project, one of %v", importer.Sources))
+	initCmd.Flags().StringVar(&o.fromPathFlag, "from-path", "", "path of the file (or directory for k8s) to generate the devfile from, used with --from. Defaults to the standard location for the source")
+	initCmd.Flags().BoolVar(&o.recursiveFlag, "recursive", false, "detect the projects in the sub-directories and bootstrap a component for each of them")
+	initCmd.Flags().BoolVarP(&o.yesFlag, "yes", "y", false, "bootstrap the components detected with --recursive without asking for confirmation")
 
 	commonflags.UseOutputFlag(initCmd)
 	// Add a defined annotation in order to appear in the help menu
@@ -4,12 +4,19 @@ import (
 	"context"
 	"testing"
 
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/devfile/importer"
 	_init "github\.com/danielpickens/astra/pkg/init"
 	"github\.com/danielpickens/astra/pkg/astra/cmdline"
+	fcontext "github\.com/danielpickens/astra/pkg/astra/commonflags/context"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
 	"github\.com/danielpickens/astra/pkg/preference"
+	"github\.com/danielpickens/astra/pkg/testingutil"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -74,3 +81,37 @@ func TestInitOptions_Complete(t *testing.T) {
 		})
 	}
 }
+
+func TestInitOptions_RunForJsonOutput_from(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	devfileObj := testingutil.GetTestDevfileObj(devfilefs.NewFakeFs())
+	unmapped := []string{`service "db": depends_on`}
+	initClient := _init.NewMockClient(ctrl)
+	initClient.EXPECT().GenerateDevfile(gomock.Any(), importer.SourceCompose, "", "my-app", "/path").
+		Return(devfileObj, "/path/devfile.yaml", unmapped, nil)
+
+	o := NewInitOptions()
+	o.SetClientset(&clientset.Clientset{
+		InitClient: initClient,
+		FS:         filesystem.NewFakeFs(),
+	})
+	o.fromFlag = "compose"
+	o.flags = map[string]string{"name": "my-app"}
+
+	ctx := astracontext.WithWorkingDirectory(context.Background(), "/path")
+	ctx = fcontext.WithJsonOutput(ctx, true)
+	out, err := o.RunForJsonOutput(ctx)
+	if err != nil {
+		t.Fatalf("InitOptions.RunForJsonOutput() unexpected error: %v", err)
+	}
+	result, ok := out.(api.GeneratedComponent)
+	if !ok {
+		t.Fatalf("InitOptions.RunForJsonOutput() returned %T, expected api.GeneratedComponent", out)
+	}
+	if diff := cmp.Diff(unmapped, result.Unmapped); diff != "" {
+		t.Errorf("InitOptions.RunForJsonOutput() unmapped mismatch (-want +got):\n%s", diff)
+	}
+	if result.DevfilePath != "/path/devfile.yaml" {
+		t.Errorf("InitOptions.RunForJsonOutput() devfilePath = %q, expected %q", result.DevfilePath, "/path/devfile.yaml")
+	}
+}
@@ -0,0 +1,137 @@
+package init
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/devfile/location"
+	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/astra/cli/files"
+	"github\.com/danielpickens/astra/pkg/astra/cli/ui"
+	fcontext "github\.com/danielpickens/astra/pkg/astra/commonflags/context"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+)
+
+// validateRecursive validates the options when the --recursive flag is used
+func (o *InitOptions) validateRecursive(ctx context.Context) error {
+	if len(o.flags) != 0 {
+		return errors.New("--recursive cannot be used with other flags selecting a devfile")
+	}
+	if fcontext.IsJsonOutput(ctx) && !o.yesFlag {
+		return errors.New("--yes is expected with --recursive when using JSON output")
+	}
+	return nil
+}
+
+// runRecursive detects the projects in the directory tree, proposes a devfile for each of them,
+// and writes the devfiles after confirmation from the user.
+// It returns the list of the components which have been initialized.
+func (o *InitOptions) runRecursive(ctx context.Context) ([]api.DetectionResult, error) {
+	var (
+		workingDir = astracontext.GetWorkingDirectory(ctx)
+		jsonOutput = fcontext.IsJsonOutput(ctx)
+	)
+
+	spinner := log.Spinnerf("Detecting projects in %q", workingDir)
+	detected, err := o.clientset.InitClient.DetectComponents(ctx, workingDir)
+	spinner.End(err == nil)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]api.DetectionResult, 0, len(detected))
+	for _, detection := range detected {
+		devfilePresent, err := location.DirectoryContainsDevfile(o.clientset.FS, detection.Path)
+		if err != nil {
+			return nil, err
+		}
+		if devfilePresent {
+			klog.V(2).Infof("a devfile already exists in %q, skipping", detection.Path)
+			continue
+		}
+		candidates = append(candidates, detection)
+	}
+
+	if len(candidates) == 0 {
+		if jsonOutput {
+			return []api.DetectionResult{}, nil
+		}
+		log.Info("No project without devfile has been detected in the directory tree")
+		return nil, nil
+	}
+
+	if !jsonOutput {
+		printDetectedComponents(workingDir, candidates)
+	}
+
+	if !o.yesFlag {
+		proceed, err := ui.Proceed(fmt.Sprintf("Do you want to bootstrap these %d components?", len(candidates)))
+		if err != nil {
+			return nil, err
+		}
+		if !proceed {
+			log.Info("Aborted by the user")
+			return nil, nil
+		}
+	}
+
+	initialized := make([]api.DetectionResult, 0, len(candidates))
+	for i := range candidates {
+		candidate := candidates[i]
+		_, devfilePath, err := o.clientset.InitClient.InitComponent(ctx, &candidate)
+		if err != nil {
+			return initialized, fmt.Errorf("unable to bootstrap component in %q: %w", candidate.Path, err)
+		}
+		candidate.DevfilePath = devfilePath
+		initialized = append(initialized, candidate)
+
+		err = files.ReportLocalFileGeneratedByastra(o.clientset.FS, candidate.Path, filepath.Base(devfilePath))
+		if err != nil {
+			klog.V(4).Infof("error trying to report local file generated: %v", err)
+		}
+		if !jsonOutput {
+			log.Successf("Component %q bootstrapped in %q", candidate.Name, relativePath(workingDir, candidate.Path))
+		}
+	}
+
+	if !jsonOutput {
+		log.Info("\nTo start editing a component, use 'astra dev' from its directory.")
+	}
+	return initialized, nil
+}
+
+// printDetectedComponents displays a table of the components detected in the directory tree
+func printDetectedComponents(workingDir string, detected []api.DetectionResult) {
+	log.Info("Based on the files in the directory tree, astra detected the following projects:")
+	t := ui.NewTable()
+	t.AppendHeader(table.Row{"PATH", "NAME", "DEVFILE", "REGISTRY", "PORTS"})
+	for _, detection := range detected {
+		devfile := detection.Devfile
+		if detection.DevfileVersion != "" {
+			devfile = fmt.Sprintf("%s:%s", devfile, detection.DevfileVersion)
+		}
+		ports := make([]string, 0, len(detection.ApplicationPorts))
+		for _, p := range detection.ApplicationPorts {
+			ports = append(ports, strconv.Itoa(p))
+		}
+		t.AppendRow(table.Row{relativePath(workingDir, detection.Path), detection.Name, devfile, detection.DevfileRegistry, strings.Join(ports, ", ")})
+	}
+	t.Render()
+}
+
+// relativePath returns path relative to base, or path itself if it cannot be made relative
+func relativePath(base, path string) string {
+	rel, err := filepath.Rel(base, path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
@@ -2,7 +2,9 @@ package component
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -24,6 +26,8 @@ import (
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
 
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/duration"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 )
 
@@ -32,6 +36,12 @@ const RecommendedCommandName = "component"
 
 var listExample = ktemplates.Examples(`  # List all components in the application
 %[1]s
+
+  # List the components running in Dev mode in all namespaces
+%[1]s --all-namespaces --mode dev
+
+  # List the components not modified for 7 days
+%[1]s --stale 7d
   `)
 
 // ListOptions ...
@@ -41,9 +51,14 @@ type ListOptions struct {
 
 	// Local variables
 	namespaceFilter string
+	staleDuration   time.Duration
 
 	// Flags
-	namespaceFlag string
+	namespaceFlag     string
+	allNamespacesFlag bool
+	selectorFlag      string
+	modeFlag          string
+	staleFlag         string
 }
 
 var _ genericclioptions.Runnable = (*ListOptions)(nil)
@@ -67,15 +82,32 @@ func (lo *ListOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, ar
 			return kclient.NewNoConnectionError()
 		}
 		lo.namespaceFilter = lo.namespaceFlag
-	} else if lo.clientset.KubernetesClient != nil {
+	} else if lo.clientset.KubernetesClient != nil && !lo.allNamespacesFlag {
 		lo.namespaceFilter = astracontext.GetNamespace(ctx)
 	}
 
+	if lo.staleFlag != "" {
+		lo.staleDuration, err = util.ParseDurationWithDays(lo.staleFlag)
+		if err != nil {
+			return fmt.Errorf("invalid value for --stale: %w", err)
+		}
+	}
 	return nil
 }
 
 // Validate ...
 func (lo *ListOptions) Validate(ctx context.Context) (err error) {
+	if lo.namespaceFlag != "" && lo.allNamespacesFlag {
+		return errors.New("--namespace and --all-namespaces flags cannot be used together")
+	}
+	if lo.modeFlag != "" && lo.modeFlag != string(api.RunningModeDev) && lo.modeFlag != string(api.RunningModeDeploy) {
+		return fmt.Errorf("invalid value %q for --mode, must be %q or %q", lo.modeFlag, api.RunningModeDev, api.RunningModeDeploy)
+	}
+	if lo.selectorFlag != "" {
+		if _, err = labels.Parse(lo.selectorFlag); err != nil {
+			return fmt.Errorf("invalid value %q for --selector: %w", lo.selectorFlag, err)
+		}
+	}
 	if lo.clientset.KubernetesClient == nil {
 		log.Warning(kclient.NewNoConnectionError())
 	}
@@ -84,7 +116,12 @@ func (lo *ListOptions) Validate(ctx context.Context) (err error) {
 
 // Run has the logic to perform the required actions as part of command
 func (lo *ListOptions) Run(ctx context.Context) error {
-	listSpinner := log.Spinnerf("Listing components from namespace '%s'", lo.namespaceFilter)
+	var listSpinner *log.Status
+	if lo.allNamespacesFlag {
+		listSpinner = log.Spinner("Listing components from all namespaces")
+	} else {
+		listSpinner = log.Spinnerf("Listing components from namespace '%s'", lo.namespaceFilter)
+	}
 	defer listSpinner.End(false)
 
 	list, err := lo.run(ctx)
@@ -94,7 +131,7 @@ func (lo *ListOptions) Run(ctx context.Context) error {
 
 	listSpinner.End(true)
 
-	HumanReadableOutput(ctx, list)
+	HumanReadableOutput(ctx, list, fcontext.IsWideOutput(ctx) || !lo.getFilter().IsNamespaceOnly())
 	return nil
 }
 
@@ -120,7 +157,7 @@ func (lo *ListOptions) run(ctx context.Context) (api.ResourcesList, error) {
 	}
 
 	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+		kubeClient, podmanClient, lo.getFilter(), devfileObj, componentName)
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -139,6 +176,20 @@ func (lo *ListOptions) run(ctx context.Context) (api.ResourcesList, error) {
 	}, nil
 }
 
+// getFilter returns the filter selecting the components to list, from the flags
+func (lo *ListOptions) getFilter() component.ListFilter {
+	filter := component.ListFilter{
+		Namespace:     lo.namespaceFilter,
+		AllNamespaces: lo.allNamespacesFlag,
+		Selector:      lo.selectorFlag,
+		Mode:          lo.modeFlag,
+	}
+	if lo.staleDuration != 0 {
+		filter.ModifiedBefore = time.Now().Add(-lo.staleDuration)
+	}
+	return filter
+}
+
 // NewCmdList implements the list astra command
 func NewCmdComponentList(ctx context.Context, name, fullName string, testClientset clientset.Clientset) *cobra.Command {
 	o := NewListOptions()
@@ -159,15 +210,21 @@ func NewCmdComponentList(ctx context.Context, name, fullName string, testClients
 		clientset.Add(listCmd, clientset.PODMAN_NULLABLE)
 	}
 	listCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace for astra to scan for components")
+	listCmd.Flags().BoolVarP(&o.allNamespacesFlag, "all-namespaces", "A", false, "List the components from all the namespaces you have access to")
+	listCmd.Flags().StringVarP(&o.selectorFlag, "selector", "l", "", "Label selector the resources of the components must match")
+	listCmd.Flags().StringVar(&o.modeFlag, "mode", "", "List only the components running in this mode (dev or deploy)")
+	listCmd.Flags().StringVar(&o.staleFlag, "stale", "", "List only the components not modified for this duration (e.g. 7d, 12h)")
 
 	util.SetCommandGroup(listCmd, util.ManagementGroup)
-	commonflags.UseOutputFlag(listCmd)
+	commonflags.UseWideOutputFlag(listCmd)
 	commonflags.UsePlatformFlag(listCmd)
 
 	return listCmd
 }
 
-func HumanReadableOutput(ctx context.Context, list api.ResourcesList) {
+// HumanReadableOutput displays the list of components as a table. The namespace (if set), the name of the Devfile
+// and the age of the components are displayed when showDetails is true
+func HumanReadableOutput(ctx context.Context, list api.ResourcesList, showDetails bool) {
 	components := list.Components
 	if len(components) == 0 {
 		log.Error("There are no components deployed.")
@@ -181,6 +238,19 @@ func HumanReadableOutput(ctx context.Context, list api.ResourcesList) {
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
 		headers = append(headers, "PLATFORM")
 	}
+	showNamespace := false
+	for _, comp := range components {
+		if comp.Namespace != "" {
+			showNamespace = true
+			break
+		}
+	}
+	if showDetails {
+		if showNamespace {
+			headers = append(table.Row{"NAMESPACE"}, headers...)
+		}
+		headers = append(headers, "DEVFILE", "AGE")
+	}
 	t.AppendHeader(headers)
 	t.SortBy([]table.SortBy{
 		{Name: "MANAGED", Mode: table.Asc},
@@ -230,6 +300,21 @@ func HumanReadableOutput(ctx context.Context, list api.ResourcesList) {
 			row = append(row, platform)
 		}
 
+		if showDetails {
+			if showNamespace {
+				row = append(table.Row{comp.Namespace}, row...)
+			}
+			devfileName := comp.DevfileName
+			if devfileName == "" {
+				devfileName = api.TypeUnknown
+			}
+			age := api.TypeUnknown
+			if comp.Created != nil {
+				age = duration.HumanDuration(time.Since(*comp.Created))
+			}
+			row = append(row, devfileName, age)
+		}
+
 		t.AppendRow(row)
 	}
 	t.Render()
@@ -92,7 +92,7 @@ func (lo *ListOptions) Run(ctx context.Context) error {
 	listSpinner.End(true)
 
 	fmt.Printf("\nComponents:\n")
-	clicomponent.HumanReadableOutput(ctx, list)
+	clicomponent.HumanReadableOutput(ctx, list, fcontext.IsWideOutput(ctx))
 	fmt.Printf("\nBindings:\n")
 	binding.HumanReadableOutput(list)
 	return nil
@@ -120,7 +120,7 @@ func (lo *ListOptions) run(ctx context.Context) (list api.ResourcesList, err err
 	}
 
 	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+		kubeClient, podmanClient, component.ListFilter{Namespace: lo.namespaceFilter}, devfileObj, componentName)
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -180,7 +180,7 @@ func NewCmdList(ctx context.Context, name, fullName string, testClientset client
 	listCmd.SetUsageTemplate(astrautil.CmdUsageTemplate)
 	listCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace for astra to scan for components")
 
-	commonflags.UseOutputFlag(listCmd)
+	commonflags.UseWideOutputFlag(listCmd)
 	commonflags.UsePlatformFlag(listCmd)
 
 	return listCmd
@@ -30,6 +30,15 @@ type LoginOptions struct {
 	skipTlsFlag  bool
 	serverFlag   string
 
+	contextFlag          string
+	namespaceFlag        string
+	oidcIssuerURLFlag    string
+	oidcClientIDFlag     string
+	oidcClientSecretFlag string
+	oidcExtraScopesFlag  []string
+	oidcDeviceCodeFlag   bool
+	oidcListenPortFlag   int
+
 	// client
 	loginClient auth.Client
 }
@@ -48,6 +57,15 @@ var loginExample = templates.Examples(`
 
   # Log in to the given server with the given credentials (token)
   %[1]s localhost:8443 --token=xxxxxxxxxxxxxxxxxxxxxxx
+
+  # Switch to an existing context of the kubeconfig file
+  %[1]s --context=my-cluster
+
+  # Create a context for the given server and log in with an OIDC provider in the browser
+  %[1]s https://api.example.com:6443 --context=my-cluster --oidc-issuer-url=https://sso.example.com/realms/dev --oidc-client-id=astra
+
+  # Log in with an OIDC provider from an environment without a browser
+  %[1]s --context=my-cluster --oidc-issuer-url=https://sso.example.com/realms/dev --oidc-client-id=astra --oidc-device-code
 `)
 
 // NewLoginOptions creates a new LoginOptions instance
@@ -82,11 +100,48 @@ func (o *LoginOptions) Validate(ctx context.Context) (err error) {
 		o.serverFlag = o.server //	set o.serverFlag to same as o.server if there was no error
 	}
 
+	if o.oidcIssuerURLFlag != "" && o.oidcClientIDFlag == "" {
+		return fmt.Errorf("--oidc-client-id is required with --oidc-issuer-url")
+	}
+	if o.oidcIssuerURLFlag != "" && o.tokenFlag != "" {
+		return fmt.Errorf("--token cannot be used with --oidc-issuer-url")
+	}
+	if o.isContextLogin() && (o.userNameFlag != "" || o.passwordFlag != "") {
+		return fmt.Errorf("--username and --password cannot be used with --context or --oidc-issuer-url")
+	}
+
 	return
 }
 
+// isContextLogin returns true if the login is done by selecting or creating a context of the kubeconfig file,
+// instead of using the OpenShift login flow
+func (o *LoginOptions) isContextLogin() bool {
+	return o.contextFlag != "" || o.oidcIssuerURLFlag != ""
+}
+
 // Run contains the logic for the astra command
 func (o *LoginOptions) Run(ctx context.Context) (err error) {
+	if o.isContextLogin() {
+		options := auth.ContextOptions{
+			Context:         o.contextFlag,
+			Server:          o.serverFlag,
+			Namespace:       o.namespaceFlag,
+			CAFile:          o.caAuthFlag,
+			InsecureSkipTLS: o.skipTlsFlag,
+			Token:           o.tokenFlag,
+		}
+		if o.oidcIssuerURLFlag != "" {
+			options.OIDC = &auth.OIDCOptions{
+				IssuerURL:    o.oidcIssuerURLFlag,
+				ClientID:     o.oidcClientIDFlag,
+				ClientSecret: o.oidcClientSecretFlag,
+				ExtraScopes:  o.oidcExtraScopesFlag,
+				DeviceCode:   o.oidcDeviceCodeFlag,
+				ListenPort:   o.oidcListenPortFlag,
+			}
+		}
+		return o.loginClient.LoginToContext(ctx, options)
+	}
 	return o.loginClient.Login(o.serverFlag, o.userNameFlag, o.passwordFlag, o.tokenFlag, o.caAuthFlag, o.skipTlsFlag)
 }
 
@@ -98,7 +153,7 @@ func NewCmdLogin(name, fullName string, testClientset clientset.Clientset) *cobr
 	loginCmd := &cobra.Command{
 		Use:     name,
 		Short:   "Login to cluster",
-		Long:    "Login to cluster",
+		Long:    "Login to cluster, with the OpenShift login flow, or by selecting or creating a context of the kubeconfig file and optionally logging in with an OIDC provider",
 		Example: fmt.Sprintf(loginExample, fullName),
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -114,5 +169,13 @@ func NewCmdLogin(name, fullName string, testClientset clientset.Clientset) *cobr
 	loginCmd.Flags().BoolVar(&o.skipTlsFlag, "insecure-skip-tls-verify", false, "If true, the server's certificate will not be checked for validity. This will make your HTTPS connections insecure")
 	loginCmd.Flags().StringVar(&o.caAuthFlag, "certificate-authority", "", "Path to a cert file for the certificate authority")
 	loginCmd.Flags().StringVar(&o.serverFlag, "server", "", "OpenShift server to log into")
+	loginCmd.Flags().StringVar(&o.contextFlag, "context", "", "Context of the kubeconfig file to switch to, created if it does not exist")
+	loginCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace to set in the context, with --context or --oidc-issuer-url")
+	loginCmd.Flags().StringVar(&o.oidcIssuerURLFlag, "oidc-issuer-url", "", "URL of the OIDC provider to log in with")
+	loginCmd.Flags().StringVar(&o.oidcClientIDFlag, "oidc-client-id", "", "Client ID registered in the OIDC provider")
+	loginCmd.Flags().StringVar(&o.oidcClientSecretFlag, "oidc-client-secret", "", "Client secret registered in the OIDC provider, if any")
+	loginCmd.Flags().StringArrayVar(&o.oidcExtraScopesFlag, "oidc-extra-scope", nil, "Scope to request in addition to openid, can be repeated (for example groups)")
+	loginCmd.Flags().BoolVar(&o.oidcDeviceCodeFlag, "oidc-device-code", false, "Log in with the device code flow instead of opening a browser")
+	loginCmd.Flags().IntVar(&o.oidcListenPortFlag, "oidc-listen-port", 8000, "Local port receiving the OIDC authorization code, registered as http://localhost:<port>/callback in the OIDC provider")
 	return loginCmd
 }
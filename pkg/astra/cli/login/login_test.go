@@ -109,3 +109,80 @@ func TestLoginOptions_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestLoginOptions_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		options func(o *LoginOptions)
+		client  func(client *auth.MockClient)
+		wantErr bool
+	}{
+		{
+			name: "OpenShift login without context",
+			options: func(o *LoginOptions) {
+				o.serverFlag = "https://api.crc.testing:6443"
+				o.tokenFlag = "token"
+			},
+			client: func(client *auth.MockClient) {
+				client.EXPECT().Login("https://api.crc.testing:6443", "", "", "token", "", false).Return(nil)
+			},
+		},
+		{
+			name: "login to a context with an OIDC provider",
+			options: func(o *LoginOptions) {
+				o.contextFlag = "dev"
+				o.namespaceFlag = "my-project"
+				o.oidcIssuerURLFlag = "https://sso.example.com"
+				o.oidcClientIDFlag = "astra"
+				o.oidcListenPortFlag = 8000
+			},
+			client: func(client *auth.MockClient) {
+				client.EXPECT().LoginToContext(gomock.Any(), auth.ContextOptions{
+					Context:   "dev",
+					Namespace: "my-project",
+					OIDC: &auth.OIDCOptions{
+						IssuerURL:  "https://sso.example.com",
+						ClientID:   "astra",
+						ListenPort: 8000,
+					},
+				}).Return(nil)
+			},
+		},
+		{
+			name: "OIDC provider without client ID",
+			options: func(o *LoginOptions) {
+				o.oidcIssuerURLFlag = "https://sso.example.com"
+			},
+			wantErr: true,
+		},
+		{
+			name: "username with a context",
+			options: func(o *LoginOptions) {
+				o.contextFlag = "dev"
+				o.userNameFlag = "developer"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := auth.NewMockClient(ctrl)
+			if tt.client != nil {
+				tt.client(client)
+			}
+
+			loginOptions := NewLoginOptions(client)
+			tt.options(loginOptions)
+
+			err := loginOptions.Validate(context.Background())
+			if err == nil {
+				err = loginOptions.Run(context.Background())
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,30 +4,41 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
 	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/config"
+	envcontext "github\.com/danielpickens/astra/pkg/config/context"
 	"github\.com/danielpickens/astra/pkg/log"
 	"github\.com/danielpickens/astra/pkg/astra/cli/ui"
 	"github\.com/danielpickens/astra/pkg/astra/cmdline"
 	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	fcontext "github\.com/danielpickens/astra/pkg/astra/commonflags/context"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
+	"github\.com/danielpickens/astra/pkg/preference"
 )
 
 const viewCommandName = "view"
 
 var viewExample = ktemplates.Examples(`# View all set preference values 
    %[1]s
+
+  # View all preference values and where they come from (default value, user preferences or project configuration)
+   %[1]s --show-origin
   `)
 
 // ViewOptions encapsulates the options for the command
 type ViewOptions struct {
 	// Clients
 	clientset *clientset.Clientset
+
+	// Flags
+	showOriginFlag bool
 }
 
 var _ genericclioptions.Runnable = (*ViewOptions)(nil)
@@ -62,7 +73,11 @@ func (o *ViewOptions) Run(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	HumanReadableOutput(preferenceList, registryList)
+	HumanReadableOutput(preferenceList, registryList, fcontext.IsWideOutput(ctx), o.showOriginFlag)
+	projectConfig := envcontext.GetProjectConfig(ctx)
+	if projectConfig.Path != "" {
+		projectHumanReadableOutput(projectConfig.Path, getProjectSettings(projectConfig), fcontext.IsWideOutput(ctx))
+	}
 	return
 }
 
@@ -75,20 +90,38 @@ func (o *ViewOptions) RunForJsonOutput(ctx context.Context) (result interface{},
 
 	return api.PreferenceView{
 		Preferences: preferenceList.Items,
+		Project:     getProjectSettings(envcontext.GetProjectConfig(ctx)),
 		Registries:  registryList,
 	}, nil
 }
 
-func HumanReadableOutput(preferenceList api.PreferenceList, registryList []api.Registry) {
+// HumanReadableOutput displays the preferences and the Devfile registries as tables.
+// The descriptions of the preferences are displayed when wide is true,
+// and where their values come from when showOrigin is true
+func HumanReadableOutput(preferenceList api.PreferenceList, registryList []api.Registry, wide bool, showOrigin bool) {
 	preferenceT := ui.NewTable()
-	preferenceT.AppendHeader(table.Row{"PARAMETER", "VALUE"})
+	header := table.Row{"PARAMETER", "VALUE"}
+	if showOrigin {
+		header = append(header, "ORIGIN")
+	}
+	if wide {
+		header = append(header, "DESCRIPTION")
+	}
+	preferenceT.AppendHeader(header)
 	preferenceT.SortBy([]table.SortBy{{Name: "PARAMETER", Mode: table.Asc}})
 	for _, pref := range preferenceList.Items {
 		value := showBlankIfNil(pref.Value)
 		if value != "" && reflect.DeepEqual(value, pref.Default) {
 			value = fmt.Sprintf("%v (default)", value)
 		}
-		preferenceT.AppendRow(table.Row{pref.Name, value})
+		row := table.Row{pref.Name, value}
+		if showOrigin {
+			row = append(row, pref.Origin)
+		}
+		if wide {
+			row = append(row, pref.Description)
+		}
+		preferenceT.AppendRow(row)
 	}
 	registryT := ui.NewTable()
 	registryT.AppendHeader(table.Row{"NAME", "URL", "SECURE"})
@@ -128,6 +161,64 @@ func showBlankIfNil(intf interface{}) interface{} {
 	return intf
 }
 
+// getProjectSettings returns the settings defined in the configuration of the project
+// which are not preferences
+func getProjectSettings(projectConfig config.ProjectConfiguration) []api.PreferenceItem {
+	var result []api.PreferenceItem
+	add := func(name string, isSet bool, value interface{}, description string) {
+		if !isSet {
+			return
+		}
+		result = append(result, api.PreferenceItem{
+			Name:        name,
+			Value:       value,
+			Type:        getType(value),
+			Description: description,
+			Origin:      preference.OriginProject,
+		})
+	}
+	add("ignore", len(projectConfig.Ignore) != 0, projectConfig.Ignore, "Additional patterns of files not synchronized into the containers")
+	add("platform", projectConfig.Platform != "", projectConfig.Platform, "Platform used when the --platform flag is not set")
+	add("portForward", len(projectConfig.PortForward) != 0, projectConfig.PortForward, "Port-forward mappings used when the --port-forward flag of `astra dev` is not set")
+	add("buildCommand", projectConfig.BuildCommand != "", projectConfig.BuildCommand, "Build command used when the --build-command flag of `astra dev` is not set")
+	add("runCommand", projectConfig.RunCommand != "", projectConfig.RunCommand, "Run command used when the --run-command flag of `astra dev` is not set")
+	add("varFiles", len(projectConfig.VarFiles) != 0, projectConfig.VarFiles, "Files containing variables to override Devfile variables, used when the --var-file flag is not set")
+	return result
+}
+
+// projectHumanReadableOutput displays the settings of the project configuration file as a table
+func projectHumanReadableOutput(path string, settings []api.PreferenceItem, wide bool) {
+	if len(settings) == 0 {
+		return
+	}
+	projectT := ui.NewTable()
+	if wide {
+		projectT.AppendHeader(table.Row{"PARAMETER", "VALUE", "DESCRIPTION"})
+	} else {
+		projectT.AppendHeader(table.Row{"PARAMETER", "VALUE"})
+	}
+	for _, setting := range settings {
+		value := setting.Value
+		if list, ok := value.([]string); ok {
+			value = strings.Join(list, ", ")
+		}
+		if wide {
+			projectT.AppendRow(table.Row{setting.Name, value, setting.Description})
+		} else {
+			projectT.AppendRow(table.Row{setting.Name, value})
+		}
+	}
+	log.Infof("\nProject settings (from %s):", path)
+	projectT.Render()
+}
+
+func getType(v interface{}) string {
+	if _, ok := v.([]string); ok {
+		return "list"
+	}
+	return reflect.ValueOf(v).Kind().String()
+}
+
 // NewCmdView implements the config view astra command
 func NewCmdView(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
 	o := NewViewOptions()
@@ -142,7 +233,8 @@ func NewCmdView(name, fullName string, testClientset clientset.Clientset) *cobra
 			return genericclioptions.GenericRun(o, testClientset, cmd, args)
 		},
 	}
+	preferenceViewCmd.Flags().BoolVar(&o.showOriginFlag, "show-origin", false, "Show where each value comes from: default value, user preferences or project configuration")
 	clientset.Add(preferenceViewCmd, clientset.PREFERENCE, clientset.REGISTRY)
-	commonflags.UseOutputFlag(preferenceViewCmd)
+	commonflags.UseWideOutputFlag(preferenceViewCmd)
 	return preferenceViewCmd
 }
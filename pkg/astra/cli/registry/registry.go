@@ -14,6 +14,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/log"
 	"github\.com/danielpickens/astra/pkg/astra/cmdline"
 	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	fcontext "github\.com/danielpickens/astra/pkg/astra/commonflags/context"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
 	astrautil "github\.com/danielpickens/astra/pkg/astra/util"
@@ -99,7 +100,7 @@ func (o *ListOptions) Validate(ctx context.Context) error {
 
 // Run contains the logic for the command associated with ListOptions
 func (o *ListOptions) Run(ctx context.Context) (err error) {
-	o.printDevfileList(o.devfileList.Items)
+	o.printDevfileList(o.devfileList.Items, fcontext.IsWideOutput(ctx))
 	return nil
 }
 
@@ -133,11 +134,13 @@ func NewCmdRegistry(name, fullName string, testClientset clientset.Clientset) *c
 	astrautil.SetCommandGroup(listCmd, astrautil.MainGroup)
 	listCmd.SetUsageTemplate(astrautil.CmdUsageTemplate)
 
-	commonflags.UseOutputFlag(listCmd)
+	commonflags.UseWideOutputFlag(listCmd)
 	return listCmd
 }
 
-func (o *ListOptions) printDevfileList(DevfileList []api.DevfileStack) {
+// printDevfileList displays the Devfile stacks. The project type and language of the stacks are displayed,
+// and their description is not truncated, when wide is true
+func (o *ListOptions) printDevfileList(DevfileList []api.DevfileStack, wide bool) {
 
 	// Create the table and use our own style
 	t := table.NewWriter()
@@ -165,7 +168,11 @@ func (o *ListOptions) printDevfileList(DevfileList []api.DevfileStack) {
 	})
 	t.SetOutputMirror(log.GetStdout())
 
-	t.AppendHeader(table.Row{"NAME", "REGISTRY", "DESCRIPTION", "ARCHITECTURES", "VERSIONS"})
+	headers := table.Row{"NAME", "REGISTRY", "DESCRIPTION", "ARCHITECTURES", "VERSIONS"}
+	if wide {
+		headers = append(headers, "PROJECT TYPE", "LANGUAGE")
+	}
+	t.AppendHeader(headers)
 
 	for _, devfileComponent := range DevfileList {
 		// Mark the name as yellow in the index so it's easier to see.
@@ -250,13 +257,21 @@ func (o *ListOptions) printDevfileList(DevfileList []api.DevfileStack) {
 			)
 		} else {
 			// Create a simplified row only showing the name, registry and description and versions
-			t.AppendRow(table.Row{
+			description := devfileComponent.Description
+			if !wide {
+				description = util.TruncateString(description, 40, "...")
+			}
+			row := table.Row{
 				name,
 				devfileComponent.Registry.Name,
-				util.TruncateString(devfileComponent.Description, 40, "..."),
+				description,
 				strings.Join(devfileComponent.Architectures, ", "),
 				strings.Join(vList, ", "),
-			})
+			}
+			if wide {
+				row = append(row, devfileComponent.ProjectType, devfileComponent.Language)
+			}
+			t.AppendRow(row)
 		}
 
 	}
@@ -3,11 +3,14 @@ package run
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
 	"github.com/spf13/cobra"
 
 	"github\.com/danielpickens/astra/pkg/kclient"
+	"github\.com/danielpickens/astra/pkg/libdevfile"
 	"github\.com/danielpickens/astra/pkg/astra/cli/errors"
 	"github\.com/danielpickens/astra/pkg/astra/cmdline"
 	"github\.com/danielpickens/astra/pkg/astra/commonflags"
@@ -24,6 +27,11 @@ import (
 
 const (
 	RecommendedCommandName = "run"
+
+	// ModeDev runs the command in the container of the component running in Dev mode
+	ModeDev = "dev"
+	// ModeDeploy runs the command in a container of the resources deployed in Deploy mode
+	ModeDeploy = "deploy"
 )
 
 type RunOptions struct {
@@ -32,9 +40,18 @@ type RunOptions struct {
 
 	// Args
 	commandName string
+	args        []string
+
+	// Flags
+	envFlag     []string
+	workdirFlag string
+	modeFlag    string
+
+	overrides libdevfile.CommandOverrides
 }
 
 var _ genericclioptions.Runnable = (*RunOptions)(nil)
+var _ genericclioptions.SecretsUser = (*RunOptions)(nil)
 
 func NewRunOptions() *RunOptions {
 	return &RunOptions{}
@@ -44,17 +61,60 @@ var runExample = ktemplates.Examples(`
 	# Run the command "my-command" in the Dev mode
 	%[1]s my-command
 
+	# Run the command "my-command", appending arguments to its command line
+	%[1]s my-command -- --verbose --dry-run
+
+	# Run the command "my-command" with an additional environment variable, in a specific working directory
+	%[1]s my-command --env LOG_LEVEL=debug --workdir /projects/app
+
+	# Run the command "migrate" in a container of the resources deployed with "astra deploy"
+	%[1]s migrate --mode deploy
 `)
 
 func (o *RunOptions) SetClientset(clientset *clientset.Clientset) {
 	o.clientset = clientset
 }
 
+// UseSecrets returns true, as the command of the Devfile is executed
+func (o *RunOptions) UseSecrets(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool {
+	return true
+}
+
 func (o *RunOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
-	o.commandName = args[0] // Value at 0 is expected to exist, thanks to ExactArgs(1)
+	o.commandName = args[0] // Value at 0 is expected to exist, thanks to MinimumNArgs(1)
+	if len(args) > 1 {
+		extraArgs, err := cmdline.GetArgsAfterDashes(args)
+		if err != nil || len(args)-len(extraArgs) != 1 {
+			return fmt.Errorf("a single command name is expected, arguments for the command must be passed after \"--\"")
+		}
+		o.args = extraArgs
+	}
+
+	envs, err := parseEnvFlag(o.envFlag)
+	if err != nil {
+		return err
+	}
+	o.overrides = libdevfile.CommandOverrides{
+		Args:       o.args,
+		Env:        envs,
+		WorkingDir: o.workdirFlag,
+	}
 	return nil
 }
 
+// parseEnvFlag parses the values of the --env flag, in the form KEY=VALUE
+func parseEnvFlag(values []string) ([]v1alpha2.EnvVar, error) {
+	envs := make([]v1alpha2.EnvVar, 0, len(values))
+	for _, value := range values {
+		name, val, found := strings.Cut(value, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid value %q for --env, expected KEY=VALUE", value)
+		}
+		envs = append(envs, v1alpha2.EnvVar{Name: name, Value: val})
+	}
+	return envs, nil
+}
+
 func (o *RunOptions) Validate(ctx context.Context) error {
 	var (
 		devfileObj = astracontext.GetEffectiveDevfileObj(ctx)
@@ -74,6 +134,22 @@ func (o *RunOptions) Validate(ctx context.Context) error {
 	if len(commands) != 1 {
 		return errors.NewNoCommandNameInDevfileError(o.commandName)
 	}
+	if commands[0].Exec == nil && !o.overrides.IsEmpty() {
+		return fmt.Errorf("arguments, --env and --workdir can be used only with exec commands, %q is not an exec command", o.commandName)
+	}
+
+	switch o.modeFlag {
+	case ModeDev:
+	case ModeDeploy:
+		if platform == commonflags.PlatformPodman {
+			return fmt.Errorf("--mode %s is not supported on the %s platform", ModeDeploy, commonflags.PlatformPodman)
+		}
+		if commands[0].Exec == nil {
+			return fmt.Errorf("only exec commands can be run in Deploy mode, %q is not an exec command", o.commandName)
+		}
+	default:
+		return fmt.Errorf("invalid value %q for --mode, accepted values are %q and %q", o.modeFlag, ModeDev, ModeDeploy)
+	}
 
 	switch platform {
 
@@ -93,17 +169,28 @@ func (o *RunOptions) Validate(ctx context.Context) error {
 }
 
 func (o *RunOptions) Run(ctx context.Context) (err error) {
+	devfileObj := astracontext.GetEffectiveDevfileObj(ctx)
+	err = libdevfile.OverrideCommand(*devfileObj, o.commandName, o.overrides)
+	if err != nil {
+		return err
+	}
+	if o.modeFlag == ModeDeploy {
+		return o.clientset.DeployClient.Run(ctx, o.commandName)
+	}
 	return o.clientset.DevClient.Run(ctx, o.commandName)
 }
 
 func NewCmdRun(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
 	o := NewRunOptions()
 	runCmd := &cobra.Command{
-		Use:     name,
-		Short:   "Run a specific command in the Dev mode",
-		Long:    `astra run executes a specific command of the Devfile during the Dev mode ("astra dev" needs to be running)`,
+		Use:   name,
+		Short: "Run a specific command in the Dev mode",
+		Long: `astra run executes a specific command of the Devfile during the Dev mode ("astra dev" needs to be running),
+or in a container of the resources deployed in Deploy mode, with --mode deploy.
+
+Arguments passed after "--" are appended to the command line of the command.`,
 		Example: fmt.Sprintf(runExample, fullName),
-		Args:    cobra.ExactArgs(1),
+		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return genericclioptions.GenericRun(o, testClientset, cmd, args)
 		},
@@ -113,7 +200,11 @@ func NewCmdRun(name, fullName string, testClientset clientset.Clientset) *cobra.
 		clientset.KUBERNETES_NULLABLE,
 		clientset.PODMAN_NULLABLE,
 		clientset.DEV,
+		clientset.DEPLOY,
 	)
+	runCmd.Flags().StringArrayVar(&o.envFlag, "env", nil, "Environment variable to add to or override in the command, in the form KEY=VALUE. Can be used multiple times")
+	runCmd.Flags().StringVar(&o.workdirFlag, "workdir", "", "Working directory in which to run the command, overriding the one defined in the Devfile")
+	runCmd.Flags().StringVar(&o.modeFlag, "mode", ModeDev, fmt.Sprintf("Mode of the component in which to run the command (%s or %s)", ModeDev, ModeDeploy))
 
 	astrautil.SetCommandGroup(runCmd, astrautil.MainGroup)
 	runCmd.SetUsageTemplate(astrautil.CmdUsageTemplate)
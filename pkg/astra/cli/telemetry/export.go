@@ -0,0 +1,246 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	envcontext "github\.com/danielpickens/astra/pkg/config/context"
+	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/astra/cli/ui"
+	"github\.com/danielpickens/astra/pkg/astra/cmdline"
+	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	"github\.com/danielpickens/astra/pkg/astra/genericclioptions"
+	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
+	"github\.com/danielpickens/astra/pkg/segment"
+)
+
+const exportCommandName = "export"
+
+var exportLongDesc = ktemplates.LongDesc(`
+	Summarize the telemetry data collected locally, when astra_TELEMETRY_SINK is set to "file":
+	the number of executions, failures and durations of each command, and the types of the errors
+`)
+
+var exportExample = ktemplates.Examples(`
+	# Summarize the telemetry data collected locally
+	%[1]s
+
+	# Summarize the telemetry data of the last 7 days in JSON
+	%[1]s --since 168h -o json
+`)
+
+// ExportOptions encapsulates the options for the astra telemetry export command
+type ExportOptions struct {
+	// Flags
+	sinceFlag time.Duration
+
+	// file is the path of the file written by the file sink
+	file string
+}
+
+var _ genericclioptions.Runnable = (*ExportOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*ExportOptions)(nil)
+
+// NewExportOptions creates a new ExportOptions instance
+func NewExportOptions() *ExportOptions {
+	return &ExportOptions{}
+}
+
+func (o *ExportOptions) SetClientset(clientset *clientset.Clientset) {
+}
+
+// Complete completes ExportOptions after they've been created
+func (o *ExportOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	o.file = segment.GetTelemetryDataFilePath(envcontext.GetEnvConfig(ctx))
+	return nil
+}
+
+// Validate validates the ExportOptions based on completed values
+func (o *ExportOptions) Validate(ctx context.Context) (err error) {
+	if o.sinceFlag < 0 {
+		return fmt.Errorf("--since must be a positive duration")
+	}
+	return nil
+}
+
+// Run contains the logic for the astra telemetry export command
+func (o *ExportOptions) Run(ctx context.Context) (err error) {
+	summary, err := o.run()
+	if err != nil {
+		return err
+	}
+	HumanReadableOutput(summary)
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *ExportOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	return o.run()
+}
+
+func (o *ExportOptions) run() (api.TelemetrySummary, error) {
+	records, err := segment.ReadRecords(o.file)
+	if err != nil {
+		return api.TelemetrySummary{}, fmt.Errorf("unable to read the telemetry data: %w", err)
+	}
+	var since time.Time
+	if o.sinceFlag != 0 {
+		since = time.Now().Add(-o.sinceFlag)
+	}
+	return summarize(o.file, records, since), nil
+}
+
+// summarize returns the summary of the records more recent than since
+func summarize(file string, records []segment.Record, since time.Time) api.TelemetrySummary {
+	result := api.TelemetrySummary{
+		File: file,
+	}
+	durations := make(map[string][]int64)
+	commands := make(map[string]*api.CommandTelemetry)
+	errorTypes := make(map[string]*api.ErrorTypeTelemetry)
+	for _, record := range records {
+		if record.Timestamp.Before(since) {
+			continue
+		}
+		timestamp := record.Timestamp
+		if result.From == nil || timestamp.Before(*result.From) {
+			result.From = &timestamp
+		}
+		if result.To == nil || timestamp.After(*result.To) {
+			result.To = &timestamp
+		}
+		result.Events++
+
+		command, ok := commands[record.Event]
+		if !ok {
+			command = &api.CommandTelemetry{Command: record.Event}
+			commands[record.Event] = command
+		}
+		command.Count++
+		durations[record.Event] = append(durations[record.Event], record.Properties.Duration)
+		if record.Properties.Success {
+			continue
+		}
+		result.Failures++
+		command.Failures++
+
+		errorType := record.Properties.ErrorType
+		if errorType == "" {
+			errorType = "unknown"
+		}
+		summary, ok := errorTypes[errorType]
+		if !ok {
+			summary = &api.ErrorTypeTelemetry{ErrorType: errorType}
+			errorTypes[errorType] = summary
+		}
+		summary.Count++
+		if !contains(summary.Commands, record.Event) {
+			summary.Commands = append(summary.Commands, record.Event)
+		}
+	}
+
+	for name, command := range commands {
+		d := durations[name]
+		sort.Slice(d, func(i, j int) bool { return d[i] < d[j] })
+		var sum int64
+		for _, duration := range d {
+			sum += duration
+		}
+		command.AverageDurationMs = sum / int64(len(d))
+		command.P95DurationMs = d[(len(d)*95+99)/100-1]
+		command.MaxDurationMs = d[len(d)-1]
+		result.Commands = append(result.Commands, *command)
+	}
+	sort.Slice(result.Commands, func(i, j int) bool {
+		if result.Commands[i].Count != result.Commands[j].Count {
+			return result.Commands[i].Count > result.Commands[j].Count
+		}
+		return result.Commands[i].Command < result.Commands[j].Command
+	})
+
+	for _, errorType := range errorTypes {
+		sort.Strings(errorType.Commands)
+		result.ErrorTypes = append(result.ErrorTypes, *errorType)
+	}
+	sort.Slice(result.ErrorTypes, func(i, j int) bool {
+		if result.ErrorTypes[i].Count != result.ErrorTypes[j].Count {
+			return result.ErrorTypes[i].Count > result.ErrorTypes[j].Count
+		}
+		return result.ErrorTypes[i].ErrorType < result.ErrorTypes[j].ErrorType
+	})
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// HumanReadableOutput displays the summary of the telemetry data as tables
+func HumanReadableOutput(summary api.TelemetrySummary) {
+	if summary.Events == 0 {
+		log.Infof("No telemetry data found in %s", summary.File)
+		return
+	}
+	log.Infof("%d commands executed, %d failed, between %s and %s (from %s)\n",
+		summary.Events, summary.Failures,
+		summary.From.Local().Format(time.RFC1123), summary.To.Local().Format(time.RFC1123), summary.File)
+
+	commandsT := ui.NewTable()
+	commandsT.AppendHeader(table.Row{"COMMAND", "COUNT", "FAILURES", "AVERAGE DURATION", "P95 DURATION", "MAX DURATION"})
+	for _, command := range summary.Commands {
+		commandsT.AppendRow(table.Row{
+			command.Command,
+			command.Count,
+			command.Failures,
+			formatDuration(command.AverageDurationMs),
+			formatDuration(command.P95DurationMs),
+			formatDuration(command.MaxDurationMs),
+		})
+	}
+	commandsT.Render()
+
+	if len(summary.ErrorTypes) == 0 {
+		return
+	}
+	log.Info("\nFailures:")
+	errorsT := ui.NewTable()
+	errorsT.AppendHeader(table.Row{"ERROR TYPE", "COUNT", "COMMANDS"})
+	for _, errorType := range summary.ErrorTypes {
+		errorsT.AppendRow(table.Row{errorType.ErrorType, errorType.Count, fmt.Sprint(errorType.Commands)})
+	}
+	errorsT.Render()
+}
+
+func formatDuration(ms int64) string {
+	return (time.Duration(ms) * time.Millisecond).Round(100 * time.Millisecond).String()
+}
+
+// NewCmdExport implements the astra telemetry export command
+func NewCmdExport(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewExportOptions()
+	exportCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Summarize the telemetry data collected locally",
+		Long:    exportLongDesc,
+		Example: fmt.Sprintf(exportExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+	exportCmd.Flags().DurationVar(&o.sinceFlag, "since", 0, "Only summarize the data more recent than this duration, for example 24h")
+	commonflags.UseOutputFlag(exportCmd)
+	return exportCmd
+}
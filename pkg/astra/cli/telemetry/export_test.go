@@ -0,0 +1,56 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/segment"
+)
+
+func Test_summarize(t *testing.T) {
+	now := time.Date(2024, 3, 8, 12, 0, 0, 0, time.UTC)
+	record := func(age time.Duration, event string, duration int64, errorType string) segment.Record {
+		return segment.Record{
+			Timestamp: now.Add(-age),
+			TelemetryData: segment.TelemetryData{
+				Event: event,
+				Properties: segment.TelemetryProperties{
+					Duration:  duration,
+					Success:   errorType == "",
+					ErrorType: errorType,
+				},
+			},
+		}
+	}
+	records := []segment.Record{
+		record(72*time.Hour, "astra deploy", 100000, "*errors.errorString"),
+		record(3*time.Hour, "astra dev", 1000, ""),
+		record(2*time.Hour, "astra dev", 3000, "*errors.errorString"),
+		record(time.Hour, "astra init", 500, "*fs.PathError"),
+		record(0, "astra dev", 2000, ""),
+	}
+	from, to := now.Add(-3*time.Hour), now
+
+	got := summarize("telemetry.jsonl", records, now.Add(-24*time.Hour))
+	want := api.TelemetrySummary{
+		File:     "telemetry.jsonl",
+		From:     &from,
+		To:       &to,
+		Events:   4,
+		Failures: 2,
+		Commands: []api.CommandTelemetry{
+			{Command: "astra dev", Count: 3, Failures: 1, AverageDurationMs: 2000, P95DurationMs: 3000, MaxDurationMs: 3000},
+			{Command: "astra init", Count: 1, Failures: 1, AverageDurationMs: 500, P95DurationMs: 500, MaxDurationMs: 500},
+		},
+		ErrorTypes: []api.ErrorTypeTelemetry{
+			{ErrorType: "*errors.errorString", Count: 1, Commands: []string{"astra dev"}},
+			{ErrorType: "*fs.PathError", Count: 1, Commands: []string{"astra init"}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("summarize() mismatch (-want +got):\n%s", diff)
+	}
+}
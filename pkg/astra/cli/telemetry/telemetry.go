@@ -10,6 +10,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/astra/cmdline"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions"
 	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
+	astrautil "github\.com/danielpickens/astra/pkg/astra/util"
 	"github\.com/danielpickens/astra/pkg/segment"
 	scontext "github\.com/danielpickens/astra/pkg/segment/context"
 	"github\.com/danielpickens/astra/pkg/util"
@@ -18,7 +19,10 @@ import (
 	"k8s.io/utils/pointer"
 )
 
-const RecommendedCommandName = "telemetry"
+const (
+	RecommendedCommandName = "telemetry"
+	uploadCommandName      = "upload"
+)
 
 type TelemetryOptions struct {
 	// clients
@@ -66,23 +70,47 @@ func (o *TelemetryOptions) Run(ctx context.Context) (err error) {
 		return util.WriteToJSONFile(o.telemetryData, dt)
 	}
 
-	segmentClient, err := segment.NewClient()
+	sink, err := segment.NewSink(envcontext.GetEnvConfig(ctx))
 	if err != nil {
-		klog.V(4).Infof("Cannot create a segment client. Will not send any data: %q", err)
+		klog.V(4).Infof("Cannot create the telemetry sink. Will not send any data: %q", err)
+		return nil
 	}
-	defer segmentClient.Close()
+	defer func() {
+		if cErr := sink.Close(); cErr != nil {
+			klog.V(4).Infof("Cannot close the telemetry sink: %q", cErr)
+		}
+	}()
 
-	err = segmentClient.Upload(ctx, o.telemetryData)
+	err = sink.Upload(ctx, o.telemetryData)
 	if err != nil {
 		klog.V(4).Infof("Cannot send data to telemetry: %q", err)
 	}
 
-	return segmentClient.Close()
+	return nil
 }
 
-func NewCmdTelemetry(name string, testClientset clientset.Clientset) *cobra.Command {
-	o := NewTelemetryOptions()
+// NewCmdTelemetry returns the telemetry command, grouping the export command
+// and the hidden upload command, executed in a separate process by the other commands to send their usage data
+func NewCmdTelemetry(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	telemetryExportCmd := NewCmdExport(exportCommandName, astrautil.GetFullName(fullName, exportCommandName), testClientset)
 	telemetryCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Manage the usage data collected by astra",
+		Example: telemetryExportCmd.Example,
+	}
+	telemetryCmd.AddCommand(
+		telemetryExportCmd,
+		NewCmdUpload(uploadCommandName, astrautil.GetFullName(fullName, uploadCommandName), testClientset),
+	)
+	telemetryCmd.SetUsageTemplate(astrautil.CmdUsageTemplate)
+	astrautil.SetCommandGroup(telemetryCmd, astrautil.UtilityGroup)
+	return telemetryCmd
+}
+
+// NewCmdUpload returns the hidden command uploading the usage data passed as JSON argument
+func NewCmdUpload(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewTelemetryOptions()
+	uploadCmd := &cobra.Command{
 		Use:                    name,
 		Short:                  "Collect and upload usage data.",
 		BashCompletionFunction: "",
@@ -98,6 +126,6 @@ func NewCmdTelemetry(name string, testClientset clientset.Clientset) *cobra.Comm
 			return genericclioptions.GenericRun(o, testClientset, cmd, args)
 		},
 	}
-	clientset.Add(telemetryCmd, clientset.PREFERENCE)
-	return telemetryCmd
+	clientset.Add(uploadCmd, clientset.PREFERENCE)
+	return uploadCmd
 }
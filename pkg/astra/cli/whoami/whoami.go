@@ -0,0 +1,218 @@
+package whoami
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/klog"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/astra/cmdline"
+	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	"github\.com/danielpickens/astra/pkg/astra/genericclioptions"
+	"github\.com/danielpickens/astra/pkg/astra/genericclioptions/clientset"
+	"github\.com/danielpickens/astra/pkg/astra/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "whoami"
+
+var whoamiLongDesc = ktemplates.LongDesc(`
+	Display the user logged in to the cluster, their groups, and their permissions in the current namespace
+`)
+
+var whoamiExample = ktemplates.Examples(`
+	# Display the current user and their permissions
+	%[1]s
+
+	# Display the current user and their permissions in JSON
+	%[1]s -o json
+`)
+
+// WhoAmIOptions encapsulates the options for the astra whoami command
+type WhoAmIOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+}
+
+var _ genericclioptions.Runnable = (*WhoAmIOptions)(nil)
+var _ genericclioptions.JsonOutputter = (*WhoAmIOptions)(nil)
+
+// NewWhoAmIOptions creates a new WhoAmIOptions instance
+func NewWhoAmIOptions() *WhoAmIOptions {
+	return &WhoAmIOptions{}
+}
+
+func (o *WhoAmIOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes WhoAmIOptions after they've been created
+func (o *WhoAmIOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	return nil
+}
+
+// Validate validates the WhoAmIOptions based on completed values
+func (o *WhoAmIOptions) Validate(ctx context.Context) (err error) {
+	return nil
+}
+
+// Run contains the logic for the astra whoami command
+func (o *WhoAmIOptions) Run(ctx context.Context) (err error) {
+	whoami, err := o.run()
+	if err != nil {
+		return err
+	}
+	return HumanReadableOutput(os.Stdout, whoami)
+}
+
+// RunForJsonOutput contains the logic for the JSON Output
+func (o *WhoAmIOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	return o.run()
+}
+
+func (o *WhoAmIOptions) run() (api.WhoAmI, error) {
+	kubeClient := o.clientset.KubernetesClient
+	result := api.WhoAmI{
+		Namespace: kubeClient.GetCurrentNamespace(),
+	}
+	if config := kubeClient.GetClientConfig(); config != nil {
+		result.Server = config.Host
+	}
+
+	var kubeUser string
+	rawConfig, err := kubeClient.GetConfig().RawConfig()
+	if err != nil {
+		klog.V(4).Infof("unable to read the kubeconfig file: %v", err)
+	} else {
+		result.Context = rawConfig.CurrentContext
+		if kubeContext, ok := rawConfig.Contexts[rawConfig.CurrentContext]; ok {
+			kubeUser = kubeContext.AuthInfo
+		}
+	}
+
+	user, err := kubeClient.GetCurrentUser()
+	if err != nil {
+		// the SelfSubjectReview API is not available before Kubernetes 1.26
+		klog.V(4).Infof("unable to get the current user from the cluster: %v", err)
+		log.Warning("Unable to get the user from the cluster, displaying the user of the kubeconfig file")
+		result.Username = kubeUser
+	} else {
+		result.Username = user.Username
+		result.Groups = user.Groups
+	}
+
+	rules, err := kubeClient.GetSelfSubjectRules(result.Namespace)
+	if err != nil {
+		return api.WhoAmI{}, fmt.Errorf("unable to get the permissions in the namespace %q: %w", result.Namespace, err)
+	}
+	result.Incomplete = rules.Incomplete
+	result.Permissions = getPermissions(rules)
+	return result, nil
+}
+
+func getPermissions(rules authorizationv1.SubjectRulesReviewStatus) []api.Permission {
+	var permissions []api.Permission
+	for _, rule := range rules.ResourceRules {
+		permissions = append(permissions, api.Permission{
+			Verbs:         rule.Verbs,
+			APIGroups:     rule.APIGroups,
+			Resources:     rule.Resources,
+			ResourceNames: rule.ResourceNames,
+		})
+	}
+	for _, rule := range rules.NonResourceRules {
+		permissions = append(permissions, api.Permission{
+			Verbs:           rule.Verbs,
+			NonResourceURLs: rule.NonResourceURLs,
+		})
+	}
+	return permissions
+}
+
+// HumanReadableOutput outputs the user and their permissions in a human readable format
+func HumanReadableOutput(w io.Writer, whoami api.WhoAmI) error {
+	fmt.Fprintf(w, "Server:    %s\n", whoami.Server)
+	fmt.Fprintf(w, "Context:   %s\n", whoami.Context)
+	fmt.Fprintf(w, "User:      %s\n", whoami.Username)
+	if len(whoami.Groups) != 0 {
+		fmt.Fprintf(w, "Groups:    %s\n", strings.Join(whoami.Groups, ", "))
+	}
+	fmt.Fprintf(w, "Namespace: %s\n", whoami.Namespace)
+	fmt.Fprintln(w)
+
+	if len(whoami.Permissions) == 0 {
+		fmt.Fprintln(w, "No permissions in the current namespace")
+		return nil
+	}
+	wr := tabwriter.NewWriter(w, 5, 2, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(wr, "RESOURCES\tNON-RESOURCE URLS\tRESOURCE NAMES\tVERBS")
+	for _, permission := range whoami.Permissions {
+		fmt.Fprintf(wr, "%s\t%s\t%s\t%s\n",
+			formatList(getResources(permission)),
+			formatList(permission.NonResourceURLs),
+			formatList(permission.ResourceNames),
+			formatList(permission.Verbs))
+	}
+	if err := wr.Flush(); err != nil {
+		return err
+	}
+	if whoami.Incomplete {
+		fmt.Fprintln(w)
+		log.Warning("The list of permissions may be incomplete, depending on the authorization modes of the cluster")
+	}
+	return nil
+}
+
+// getResources returns the resources of the permission, qualified with their API group as displayed by kubectl
+func getResources(permission api.Permission) []string {
+	var resources []string
+	for _, resource := range permission.Resources {
+		if len(permission.APIGroups) == 0 {
+			resources = append(resources, resource)
+			continue
+		}
+		for _, group := range permission.APIGroups {
+			if group == "" {
+				resources = append(resources, resource)
+			} else {
+				resources = append(resources, resource+"."+group)
+			}
+		}
+	}
+	return resources
+}
+
+func formatList(values []string) string {
+	return "[" + strings.Join(values, " ") + "]"
+}
+
+// NewCmdWhoAmI implements the astra whoami command
+func NewCmdWhoAmI(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
+	o := NewWhoAmIOptions()
+	whoamiCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Display the current user and their permissions",
+		Long:    whoamiLongDesc,
+		Example: fmt.Sprintf(whoamiExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, testClientset, cmd, args)
+		},
+	}
+
+	util.SetCommandGroup(whoamiCmd, util.OpenshiftGroup)
+	whoamiCmd.SetUsageTemplate(util.CmdUsageTemplate)
+	commonflags.UseOutputFlag(whoamiCmd)
+	clientset.Add(whoamiCmd, clientset.KUBERNETES)
+
+	return whoamiCmd
+}
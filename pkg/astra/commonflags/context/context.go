@@ -9,15 +9,17 @@ const (
 )
 
 type (
-	outputKeyType    struct{}
-	platformKeyType  struct{}
-	variablesKeyType struct{}
+	outputKeyType     struct{}
+	wideOutputKeyType struct{}
+	platformKeyType   struct{}
+	variablesKeyType  struct{}
 )
 
 var (
-	outputKey    outputKeyType
-	platformKey  platformKeyType
-	variablesKey variablesKeyType
+	outputKey     outputKeyType
+	wideOutputKey wideOutputKeyType
+	platformKey   platformKeyType
+	variablesKey  variablesKeyType
 )
 
 // WithJsonOutput sets the value for the output flag (-o) in ctx
@@ -34,6 +36,20 @@ func IsJsonOutput(ctx context.Context) bool {
 	return false
 }
 
+// WithWideOutput sets in ctx if the wide output format has been requested with the output flag (-o wide)
+func WithWideOutput(ctx context.Context, val bool) context.Context {
+	return context.WithValue(ctx, wideOutputKey, val)
+}
+
+// IsWideOutput returns true if the wide output format has been requested with the output flag (-o wide)
+func IsWideOutput(ctx context.Context) bool {
+	value := ctx.Value(wideOutputKey)
+	if cast, ok := value.(bool); ok {
+		return cast
+	}
+	return false
+}
+
 // WithPlatform sets the value for the platform flag in ctx
 func WithPlatform(ctx context.Context, val string) context.Context {
 	return context.WithValue(ctx, platformKey, val)
@@ -3,10 +3,12 @@ package commonflags
 import (
 	"errors"
 	"flag"
+	"fmt"
 	"strconv"
 
 	"github\.com/danielpickens/astra/pkg/config"
 	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/machineoutput"
 	"github\.com/danielpickens/astra/pkg/astra/cmdline"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -25,12 +27,18 @@ func UseOutputFlag(cmd *cobra.Command) {
 	cmd.Annotations["machineoutput"] = "json"
 }
 
+// UseWideOutputFlag indicates that a command accepts the -o flag, and implements the wide output format
+func UseWideOutputFlag(cmd *cobra.Command) {
+	UseOutputFlag(cmd)
+	cmd.Annotations["wideoutput"] = "true"
+}
+
 // AddOutputFlag adds the machine readable output flag to all commands
 // We use "flag" in order to make this accessible throughtout ALL of astra, rather than the
 // above traditional "persistentflags" usage that does not make it a pointer within the 'pflag'
 // package
 func AddOutputFlag() {
-	flag.CommandLine.String(OutputFlagName, "", "Specify output format, supported format: json")
+	flag.CommandLine.String(OutputFlagName, "", "Specify output format, supported formats: "+machineoutput.SupportedFormats)
 	_ = pflag.CommandLine.MarkHidden(OutputFlagName)
 }
 
@@ -44,14 +52,19 @@ func CheckMachineReadableOutputCommand(envconfig *config.Configuration, cmd *cob
 	machineOutput := cmd.Annotations["machineoutput"]
 
 	// Check the valid output
-	if hasFlagChanged && outputFlag.Value.String() != "json" {
-		//revive:disable:error-strings This is a top-level error message displayed as is to the end user
-		return errors.New("Please input a valid output format for -o, available format: json")
-		//revive:enable:error-strings
+	var format machineoutput.OutputFormat
+	if hasFlagChanged {
+		var err error
+		format, err = machineoutput.ParseOutputFormat(outputFlag.Value.String())
+		if err != nil {
+			//revive:disable:error-strings This is a top-level error message displayed as is to the end user
+			return fmt.Errorf("Please input a valid output format for -o, available formats: %s: %w", machineoutput.SupportedFormats, err)
+			//revive:enable:error-strings
+		}
 	}
 
-	// Check that if -o json has been passed, that the command actually USES json.. if not, error out.
-	if hasFlagChanged && outputFlag.Value.String() == "json" && machineOutput == "" {
+	// Check that if -o has been passed, that the command actually supports it.. if not, error out.
+	if hasFlagChanged && machineOutput == "" {
 
 		// By default we "disable" logging, so activate it so that the below error can be shown.
 		_ = flag.Set(OutputFlagName, "")
@@ -62,6 +75,14 @@ func CheckMachineReadableOutputCommand(envconfig *config.Configuration, cmd *cob
 		//revive:enable:error-strings
 	}
 
+	// Check that if -o wide has been passed, that the command actually supports it
+	if hasFlagChanged && format.Name == machineoutput.FormatWide && cmd.Annotations["wideoutput"] == "" {
+		_ = flag.Set(OutputFlagName, "")
+		//revive:disable:error-strings This is a top-level error message displayed as is to the end user
+		return errors.New("The wide output format is not implemented for this command")
+		//revive:enable:error-strings
+	}
+
 	// Before running anything, we will make sure that no verbose output is made
 	// This is a HACK to manually override `-v 4` to `-v 0` (in which we have no klog.V(0) in our code...
 	// in order to have NO verbose output when combining both `-o json` and `-v 4` so json output
@@ -79,7 +100,17 @@ func CheckMachineReadableOutputCommand(envconfig *config.Configuration, cmd *cob
 	return nil
 }
 
-// GetJsonOutputValue returns true if -o flag is used and value is "json"
+// GetJsonOutputValue returns true if -o flag is used with a machine readable format (json, yaml, jsonpath or go-template)
 func GetJsonOutputValue(cmd cmdline.Cmdline) bool {
-	return cmd.FlagValueIfSet(OutputFlagName) == "json"
+	return GetOutputFormat(cmd).IsMachineReadable()
+}
+
+// GetOutputFormat returns the output format requested with the -o flag, or an empty format if the flag is not used.
+// The value of the flag is expected to have been validated by CheckMachineReadableOutputCommand
+func GetOutputFormat(cmd cmdline.Cmdline) machineoutput.OutputFormat {
+	format, err := machineoutput.ParseOutputFormat(cmd.FlagValueIfSet(OutputFlagName))
+	if err != nil {
+		return machineoutput.OutputFormat{}
+	}
+	return format
 }
@@ -3,6 +3,8 @@ package commonflags
 import (
 	"testing"
 
+	"github\.com/danielpickens/astra/pkg/config"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -39,7 +41,50 @@ func TestUseOutputFlagWrongValue(t *testing.T) {
 		t.Errorf("Set error should be nil but is %v", err)
 	}
 	err = CheckMachineReadableOutputCommand(nil, cmd)
-	if err.Error() != "Please input a valid output format for -o, available format: json" {
+	if err.Error() != `Please input a valid output format for -o, available formats: json, yaml, jsonpath=<template>, go-template=<template>, wide: unknown output format "wrong-value"` {
+		t.Errorf("Check error is %v", err)
+	}
+}
+
+func TestUseOutputFlagOtherFormats(t *testing.T) {
+	for _, value := range []string{"yaml", "jsonpath={.name}", "go-template={{.name}}", "wide"} {
+		t.Run(value, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			UseWideOutputFlag(cmd)
+			err := pflag.CommandLine.Set("o", value)
+			if err != nil {
+				t.Errorf("Set error should be nil but is %v", err)
+			}
+			err = CheckMachineReadableOutputCommand(&config.Configuration{}, cmd)
+			if err != nil {
+				t.Errorf("Check error should be nil but is %v", err)
+			}
+		})
+	}
+}
+
+func TestUseOutputFlagWideNotImplemented(t *testing.T) {
+	cmd := &cobra.Command{}
+	UseOutputFlag(cmd)
+	err := pflag.CommandLine.Set("o", "wide")
+	if err != nil {
+		t.Errorf("Set error should be nil but is %v", err)
+	}
+	err = CheckMachineReadableOutputCommand(&config.Configuration{}, cmd)
+	if err == nil || err.Error() != "The wide output format is not implemented for this command" {
 		t.Errorf("Check error is %v", err)
 	}
 }
+
+func TestUseOutputFlagMissingTemplate(t *testing.T) {
+	cmd := &cobra.Command{}
+	UseOutputFlag(cmd)
+	err := pflag.CommandLine.Set("o", "jsonpath")
+	if err != nil {
+		t.Errorf("Set error should be nil but is %v", err)
+	}
+	err = CheckMachineReadableOutputCommand(nil, cmd)
+	if err == nil {
+		t.Errorf("Check error should not be nil")
+	}
+}
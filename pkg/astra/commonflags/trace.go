@@ -0,0 +1,22 @@
+package commonflags
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// TraceFlagName is the name of the flag displaying a summary of the time spent in each operation at exit
+const TraceFlagName = "trace"
+
+// AddTraceFlag adds the --trace flag to all commands
+// We use "flag" in order to make this accessible throughtout ALL of astra, rather than the
+// traditional "persistentflags" usage that does not make it a pointer within the 'pflag'
+// package
+func AddTraceFlag() {
+	pflag.CommandLine.Bool(TraceFlagName, false, "Display a summary of the time spent in each operation at exit")
+}
+
+// GetTraceValue returns true if the --trace flag is set
+func GetTraceValue() bool {
+	traceFlag := pflag.Lookup(TraceFlagName)
+	return traceFlag != nil && traceFlag.Value.String() == "true"
+}
@@ -49,8 +49,9 @@ func CheckVariablesCommand(cmd *cobra.Command) error {
 	return nil
 }
 
-// GetVariablesValues returns variables computed from --var-file and --var values
-func GetVariablesValues(cmd cmdline.Cmdline) (map[string]string, error) {
+// GetVariablesValues returns variables computed from --var-file and --var values.
+// The variables are read from defaultVarFiles when the --var-file flag is not set
+func GetVariablesValues(cmd cmdline.Cmdline, defaultVarFiles []string) (map[string]string, error) {
 	varFileFlagValue, err := cmd.FlagValue(VarFileFlagName)
 	if err != nil {
 		return nil, err
@@ -59,5 +60,9 @@ func GetVariablesValues(cmd cmdline.Cmdline) (map[string]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	return vars.GetVariables(filesystem.DefaultFs{}, varFileFlagValue, varFlagValue, os.LookupEnv)
+	varFiles := defaultVarFiles
+	if varFileFlagValue != "" {
+		varFiles = []string{varFileFlagValue}
+	}
+	return vars.GetVariablesFromFiles(filesystem.DefaultFs{}, varFiles, varFlagValue, os.LookupEnv)
 }
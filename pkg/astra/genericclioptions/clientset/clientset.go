@@ -20,6 +20,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/configAutomount"
 	"github\.com/danielpickens/astra/pkg/dev/kubedev"
 	"github\.com/danielpickens/astra/pkg/dev/podmandev"
+	"github\.com/danielpickens/astra/pkg/events"
 	"github\.com/danielpickens/astra/pkg/exec"
 	"github\.com/danielpickens/astra/pkg/informer"
 	"github\.com/danielpickens/astra/pkg/log"
@@ -33,6 +34,7 @@ import (
 
 	"github\.com/danielpickens/astra/pkg/alizer"
 	"github\.com/danielpickens/astra/pkg/dev"
+	"github\.com/danielpickens/astra/pkg/devsessions"
 	"github\.com/danielpickens/astra/pkg/state"
 
 	"github\.com/danielpickens/astra/pkg/binding"
@@ -42,6 +44,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/kclient"
 	"github\.com/danielpickens/astra/pkg/preference"
 	"github\.com/danielpickens/astra/pkg/project"
+	"github\.com/danielpickens/astra/pkg/provision"
 	"github\.com/danielpickens/astra/pkg/registry"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 	"github\.com/danielpickens/astra/pkg/testingutil/system"
@@ -61,6 +64,10 @@ const (
 	DEPLOY = "DEP_DEPLOY"
 	// DEV instantiates client for pkg/dev
 	DEV = "DEP_DEV"
+	// DEV_SESSIONS instantiates client for pkg/devsessions
+	DEV_SESSIONS = "DEP_DEV_SESSIONS"
+	// EVENTS instantiates client for pkg/events
+	EVENTS = "DEP_EVENTS"
 	// EXEC instantiates client for pkg/exec
 	EXEC = "DEP_EXEC"
 	// FILESYSTEM instantiates client for pkg/testingutil/filesystem
@@ -85,6 +92,8 @@ const (
 	PREFERENCE = "DEP_PREFERENCE"
 	// PROJECT instantiates client for pkg/project
 	PROJECT = "DEP_PROJECT"
+	// PROVISION instantiates client for pkg/provision
+	PROVISION = "DEP_PROVISION"
 	// REGISTRY instantiates client for pkg/registry
 	REGISTRY = "DEP_REGISTRY"
 	// STATE instantiates client for pkg/state
@@ -109,6 +118,7 @@ var subdeps map[string][]string = map[string][]string{
 		BINDING,
 		DELETE_COMPONENT,
 		CONFIG_AUTOMOUNT,
+		EVENTS,
 		EXEC,
 		FILESYSTEM,
 		KUBERNETES_NULLABLE,
@@ -119,15 +129,17 @@ var subdeps map[string][]string = map[string][]string{
 		SYNC,
 		WATCH,
 	},
+	DEV_SESSIONS: {FILESYSTEM, STATE},
 	EXEC:         {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
 	INIT:         {ALIZER, FILESYSTEM, PREFERENCE, REGISTRY},
 	LOGS:         {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
-	PORT_FORWARD: {KUBERNETES_NULLABLE, EXEC, STATE},
+	PORT_FORWARD: {KUBERNETES_NULLABLE, EVENTS, EXEC, STATE},
 	PROJECT:      {KUBERNETES},
+	PROVISION:    {KUBERNETES},
 	REGISTRY:     {FILESYSTEM, PREFERENCE, KUBERNETES_NULLABLE},
 	STATE:        {FILESYSTEM, SYSTEM},
 	SYNC:         {EXEC},
-	WATCH:        {INFORMER, KUBERNETES_NULLABLE},
+	WATCH:        {EVENTS, INFORMER, KUBERNETES_NULLABLE},
 	BINDING:      {PROJECT, KUBERNETES_NULLABLE},
 	/* Add sub-dependencies here, if any */
 }
@@ -142,6 +154,8 @@ type Clientset struct {
 	DeleteClient          _delete.Client
 	DeployClient          deploy.Client
 	DevClient             dev.Client
+	DevSessionsClient     devsessions.Client
+	EventsClient          *events.EventsClient
 	ExecClient            exec.Client
 	FS                    filesystem.Filesystem
 	InformerClient        *informer.InformerClient
@@ -152,6 +166,7 @@ type Clientset struct {
 	PortForwardClient     portForward.Client
 	PreferenceClient      preference.Client
 	ProjectClient         project.Client
+	ProvisionClient       provision.Client
 	RegistryClient        registry.Client
 	StateClient           state.Client
 	SyncClient            sync.Client
@@ -212,6 +227,9 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 			dep.systemClient = system.Default{}
 		}
 	}
+	if isDefined(command, EVENTS) {
+		dep.EventsClient = events.NewEventsClient()
+	}
 	if isDefined(command, INFORMER) {
 		dep.InformerClient = informer.NewInformerClient()
 	}
@@ -299,9 +317,20 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 	if isDefined(command, PROJECT) {
 		dep.ProjectClient = project.NewClient(dep.KubernetesClient)
 	}
+	if isDefined(command, PROVISION) {
+		dep.ProvisionClient = provision.NewProvisionClient(dep.KubernetesClient)
+	}
 	if isDefined(command, STATE) {
 		dep.StateClient = state.NewStateClient(dep.FS, dep.systemClient)
 	}
+	if isDefined(command, DEV_SESSIONS) {
+		var registryFile string
+		registryFile, err = devsessions.GetRegistryFile()
+		if err != nil {
+			return nil, err
+		}
+		dep.DevSessionsClient = devsessions.NewDevSessionsClient(dep.FS, dep.StateClient, registryFile)
+	}
 	if isDefined(command, SYNC) {
 		switch platform {
 		case commonflags.PlatformPodman:
@@ -311,7 +340,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 		}
 	}
 	if isDefined(command, WATCH) {
-		dep.WatchClient = watch.NewWatchClient(dep.KubernetesClient, dep.InformerClient)
+		dep.WatchClient = watch.NewWatchClient(dep.KubernetesClient, dep.InformerClient, dep.EventsClient)
 	}
 	if isDefined(command, BINDING) {
 		dep.BindingClient = binding.NewBindingClient(dep.ProjectClient, dep.KubernetesClient)
@@ -321,7 +350,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 		case commonflags.PlatformPodman:
 			dep.PortForwardClient = podmanportforward.NewPFClient(dep.ExecClient)
 		default:
-			dep.PortForwardClient = kubeportforward.NewPFClient(dep.KubernetesClient, dep.StateClient)
+			dep.PortForwardClient = kubeportforward.NewPFClient(dep.KubernetesClient, dep.StateClient, dep.EventsClient)
 		}
 	}
 	if isDefined(command, DEV) {
@@ -336,6 +365,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 				dep.ExecClient,
 				dep.StateClient,
 				dep.WatchClient,
+				dep.EventsClient,
 			)
 		default:
 			dep.DevClient = kubedev.NewDevClient(
@@ -349,6 +379,7 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 				dep.ExecClient,
 				dep.DeleteClient,
 				dep.ConfigAutomountClient,
+				dep.EventsClient,
 			)
 		}
 	}
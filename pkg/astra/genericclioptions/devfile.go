@@ -15,7 +15,7 @@ import (
 	astrautil "github\.com/danielpickens/astra/pkg/util"
 )
 
-func getDevfileInfo(cmd *cobra.Command, fsys filesystem.Filesystem, workingDir string, variables map[string]string, imageRegistry string) (
+func getDevfileInfo(cmd *cobra.Command, fsys filesystem.Filesystem, workingDir string, variables map[string]string, imageRegistry string, useSecrets bool) (
 	devfilePath string,
 	devfileObj *parser.DevfileObj,
 	componentName string,
@@ -30,7 +30,11 @@ func getDevfileInfo(cmd *cobra.Command, fsys filesystem.Filesystem, workingDir s
 		}
 		// Parse devfile and validate
 		var devObj parser.DevfileObj
-		devObj, err = devfile.ParseAndValidateFromFileWithVariables(devfilePath, variables, imageRegistry, true)
+		if useSecrets {
+			devObj, err = devfile.ParseAndValidateFromFileWithSecrets(devfilePath, variables, imageRegistry)
+		} else {
+			devObj, err = devfile.ParseAndValidateFromFileWithVariables(devfilePath, variables, imageRegistry, true)
+		}
 		if err != nil {
 			return "", nil, "", fmt.Errorf("failed to parse the devfile %s: %w", devfilePath, err)
 		}
@@ -35,6 +35,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/preference"
 	"github\.com/danielpickens/astra/pkg/segment"
 	scontext "github\.com/danielpickens/astra/pkg/segment/context"
+	"github\.com/danielpickens/astra/pkg/tracing"
 
 	"github.com/spf13/cobra"
 
@@ -64,9 +65,9 @@ type PreIniter interface {
 }
 
 // JsonOutputter must be implemented by commands with JSON output
-// For these commands, the `-o json` flag will be added
+// For these commands, the `-o json|yaml|jsonpath=...|go-template=...` flag will be added
 // when err is not nil, the text of the error will be returned in a `message` field on stderr with an exit status of 1
-// when err is nil, the result of RunForJsonOutput will be returned in JSON format on stdout with an exit status of 0
+// when err is nil, the result of RunForJsonOutput will be returned in the requested format on stdout with an exit status of 0
 type JsonOutputter interface {
 	RunForJsonOutput(ctx context.Context) (result interface{}, err error)
 }
@@ -79,12 +80,23 @@ type DevfileUser interface {
 	UseDevfile(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool
 }
 
+// SecretsUser must be implemented by commands needing the values of the secrets referenced by the variables of the Devfile,
+// to execute its commands or to build its images.
+// If the interface is not implemented, the variables referencing secrets are not resolved
+type SecretsUser interface {
+	// UseSecrets returns true if the command with the specified cmdline and args needs the values of the secrets
+	UseSecrets(ctx context.Context, cmdline cmdline.Cmdline, args []string) bool
+}
+
 const (
 	// defaultAppName is the default name of the application when an application name is not provided
 	defaultAppName = "app"
 )
 
 func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Command, args []string) error {
+	// The configuration of the project is saved into the context of the command, as it is also used to fetch the clients
+	cmd.SetContext(withProjectConfig(cmd.Context()))
+
 	var (
 		err             error
 		startTime       = time.Now()
@@ -102,6 +114,11 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 	userConfig, _ := preference.NewClient(ctx)
 	envConfig := envcontext.GetEnvConfig(ctx)
 
+	ctx, endTracing := startTracing(ctx, cmd, envConfig)
+	defer func() {
+		endTracing(err)
+	}()
+
 	//lint:ignore SA1019 We deprecated this env var, but until it is removed, we still need to support it
 	disableTelemetryEnvSet := envConfig.astraDisableTelemetry != nil
 	var disableTelemetry bool
@@ -119,9 +136,9 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 	}
 
 	// Prompt the user to consent for telemetry if a value is not set already
-	// Skip prompting if the preference command is called
+	// Skip prompting if the preference or telemetry command is called
 	// This prompt has been placed here so that it does not prompt the user when they call --help
-	if !userConfig.IsSet(preference.ConsentTelemetrySetting) && cmd.Parent().Name() != "preference" {
+	if !userConfig.IsSet(preference.ConsentTelemetrySetting) && cmd.Parent().Name() != "preference" && cmd.Parent().Name() != "telemetry" {
 		if !segment.RunningInTerminal() {
 			klog.V(4).Infof("Skipping telemetry question because there is no terminal (tty)\n")
 		} else {
@@ -139,6 +156,8 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 				} else {
 					klog.V(4).Infof("Skipping telemetry question due to %s=%s\n", segment.TrackingConsentEnv, trackingConsentValue)
 				}
+			} else if envConfig.TelemetrySink == segment.SinkNone {
+				klog.V(4).Infof("Skipping telemetry question due to %s=%s\n", segment.TelemetrySinkEnv, segment.SinkNone)
 			} else if disableTelemetry {
 				//lint:ignore SA1019 We deprecated this env var, but until it is removed, we still need to support it
 				klog.V(4).Infof("Skipping telemetry question due to %s=%t\n", segment.DisableTelemetryEnv, disableTelemetry)
@@ -207,7 +226,12 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 	}
 
 	cmdLineObj := cmdline.NewCobra(cmd)
+	projectConfig := envcontext.GetProjectConfig(ctx)
 	platform := commonflags.GetPlatformValue(cmdLineObj)
+	if platform == "" && cmd.Annotations["platform"] == "true" {
+		// the platform defined in the project configuration is used only by the commands supporting the --platform flag
+		platform = getProjectPlatform(feature.IsEnabled(ctx, feature.GenericPlatformFlag), projectConfig.Platform)
+	}
 	deps, err := clientset.Fetch(cmd, platform, testClientset)
 	if err != nil {
 		return err
@@ -219,7 +243,9 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 		log.DisplayExperimentalWarning()
 	}
 
-	ctx = fcontext.WithJsonOutput(ctx, commonflags.GetJsonOutputValue(cmdLineObj))
+	outputFormat := commonflags.GetOutputFormat(cmdLineObj)
+	ctx = fcontext.WithJsonOutput(ctx, outputFormat.IsMachineReadable())
+	ctx = fcontext.WithWideOutput(ctx, outputFormat.Name == machineoutput.FormatWide)
 	if platform != "" {
 		ctx = fcontext.WithPlatform(ctx, platform)
 	}
@@ -240,7 +266,7 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 		ctx = astracontext.WithWorkingDirectory(ctx, cwd)
 
 		var variables map[string]string
-		variables, err = commonflags.GetVariablesValues(cmdLineObj)
+		variables, err = commonflags.GetVariablesValues(cmdLineObj, projectConfig.VarFiles)
 		if err != nil {
 			return err
 
@@ -262,9 +288,16 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 		}
 
 		if useDevfile {
+			useSecrets := false
+			if secretsUser, ok := o.(SecretsUser); ok {
+				useSecrets = secretsUser.UseSecrets(ctx, cmdLineObj, args)
+			}
+
 			var devfilePath, componentName string
 			var devfileObj *parser.DevfileObj
-			devfilePath, devfileObj, componentName, err = getDevfileInfo(cmd, deps.FS, cwd, variables, userConfig.GetImageRegistry())
+			_, span := tracing.Start(ctx, "parse devfile")
+			devfilePath, devfileObj, componentName, err = getDevfileInfo(cmd, deps.FS, cwd, variables, userConfig.GetImageRegistry(), useSecrets)
+			span.End(&err)
 			if err != nil {
 				startTelemetry(cmd, err, startTime)
 				return err
@@ -289,11 +322,11 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 		return err
 	}
 
-	if jsonOutputter, ok := o.(JsonOutputter); ok && log.IsJSON() {
+	if jsonOutputter, ok := o.(JsonOutputter); ok && outputFormat.IsMachineReadable() {
 		var out interface{}
 		out, err = jsonOutputter.RunForJsonOutput(ctx)
 		if err == nil {
-			machineoutput.OutputSuccess(testClientset.Stdout, testClientset.Stderr, out)
+			err = machineoutput.OutputSuccessWithFormat(testClientset.Stdout, outputFormat, out)
 		}
 	} else {
 		err = o.Run(ctx)
@@ -341,7 +374,7 @@ func startTelemetry(cmd *cobra.Command, err error, startTime time.Time) {
 	if err1 != nil {
 		klog.V(4).Infof("Failed to marshall telemetry data. %q", err1.Error())
 	}
-	command := exec.Command(os.Args[0], "telemetry", string(data))
+	command := exec.Command(os.Args[0], "telemetry", "upload", string(data))
 	if err1 = command.Start(); err1 != nil {
 		klog.V(4).Infof("Failed to start the telemetry process. Error: %q", err1.Error())
 		return
@@ -352,6 +385,19 @@ func startTelemetry(cmd *cobra.Command, err error, startTime time.Time) {
 	}
 }
 
+// getProjectPlatform returns the platform defined in the project configuration, if the --platform flag is enabled.
+// Otherwise, it warns that the platform is ignored, and returns an empty platform, so the commands target the cluster
+func getProjectPlatform(platformFlagEnabled bool, projectPlatform string) string {
+	if projectPlatform == "" {
+		return ""
+	}
+	if !platformFlagEnabled {
+		log.Warningf("The platform %q defined in the project configuration is ignored, as the --platform flag is not enabled", projectPlatform)
+		return ""
+	}
+	return projectPlatform
+}
+
 // NoArgsAndSilenceJSON returns the NoArgs value, and silence output when JSON output is activated
 func NoArgsAndSilenceJSON(cmd *cobra.Command, args []string) error {
 	if log.IsJSON() {
@@ -0,0 +1,37 @@
+package genericclioptions
+
+import "testing"
+
+func Test_getProjectPlatform(t *testing.T) {
+	tests := []struct {
+		name                string
+		platformFlagEnabled bool
+		projectPlatform     string
+		want                string
+	}{
+		{
+			name:                "platform ignored when the --platform flag is disabled",
+			platformFlagEnabled: false,
+			projectPlatform:     "podman",
+			want:                "",
+		},
+		{
+			name:                "platform used when the --platform flag is enabled",
+			platformFlagEnabled: true,
+			projectPlatform:     "podman",
+			want:                "podman",
+		},
+		{
+			name:                "no platform defined",
+			platformFlagEnabled: true,
+			want:                "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getProjectPlatform(tt.platformFlagEnabled, tt.projectPlatform); got != tt.want {
+				t.Errorf("getProjectPlatform() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package genericclioptions
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/config"
+	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	"github\.com/danielpickens/astra/pkg/otlp"
+	"github\.com/danielpickens/astra/pkg/tracing"
+	"github\.com/danielpickens/astra/pkg/version"
+)
+
+// startTracing starts recording the operations of the command if the --trace flag is set,
+// or if the traces are exported to an OpenTelemetry collector or to a file.
+// The returned function must be called at the end of the command: it exports the traces and displays their summary.
+func startTracing(ctx context.Context, cmd *cobra.Command, envConfig config.Configuration) (context.Context, func(err error)) {
+	printSummary := commonflags.GetTraceValue()
+	if !printSummary && envConfig.TraceOTLPEndpoint == "" && envConfig.TraceFile == "" {
+		return ctx, func(error) {}
+	}
+
+	tracer := tracing.NewTracer()
+	ctx = tracing.WithTracer(ctx, tracer)
+	ctx, span := tracing.Start(ctx, cmd.CommandPath())
+	return ctx, func(err error) {
+		span.End(&err)
+		spans := tracer.Finish()
+		traces := tracing.ToOTLP(spans, tracer.TraceID(), version.VERSION)
+
+		if envConfig.TraceOTLPEndpoint != "" {
+			client, err := otlp.NewClient(envConfig.TraceOTLPEndpoint, envConfig.TraceOTLPHeaders)
+			if err == nil {
+				// the context of the command may have been cancelled
+				err = tracing.Send(context.Background(), client, traces)
+			}
+			if err != nil {
+				log.Warningf("Unable to send the traces to %s: %v", envConfig.TraceOTLPEndpoint, err)
+			}
+		}
+		if envConfig.TraceFile != "" {
+			if err := tracing.WriteFile(envConfig.TraceFile, traces); err != nil {
+				log.Warningf("Unable to write the traces to %s: %v", envConfig.TraceFile, err)
+			}
+		}
+		if printSummary {
+			if err := tracing.PrintSummary(log.GetStderr(), spans, tracer.TraceID()); err != nil {
+				klog.V(4).Infof("unable to display the summary of the traces: %v", err)
+			}
+		}
+	}
+}
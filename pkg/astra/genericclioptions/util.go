@@ -1,12 +1,18 @@
 package genericclioptions
 
 import (
+	"context"
 	"fmt"
+	"os"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
 
+	"github\.com/danielpickens/astra/pkg/config"
+	envcontext "github\.com/danielpickens/astra/pkg/config/context"
 	"github\.com/danielpickens/astra/pkg/kclient"
 	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 	pkgUtil "github\.com/danielpickens/astra/pkg/util"
 
 	dfutil "github.com/devfile/library/v2/pkg/util"
@@ -16,7 +22,7 @@ const (
 	gitDirName = ".git"
 )
 
-// ApplyIgnore will take the current ignores []string and append the mandatory astra-file-index.json and
+// ApplyIgnore will take the current ignores []string and append the mandatory astra-file-index.json, .astra and
 // .git ignores; or find the .astraignore/.gitignore file in the directory and use that instead.
 func ApplyIgnore(ignores *[]string, sourcePath string) (err error) {
 	if len(*ignores) == 0 {
@@ -33,6 +39,11 @@ func ApplyIgnore(ignores *[]string, sourcePath string) (err error) {
 		*ignores = append(*ignores, indexFile)
 	}
 
+	// check if the ignores flag has the .astra dir, as the .gitignore file does not ignore all its content
+	if !dfutil.In(*ignores, pkgUtil.DotastraDirectory) {
+		*ignores = append(*ignores, pkgUtil.DotastraDirectory)
+	}
+
 	// check if the ignores flag has the git dir
 	if !dfutil.In(*ignores, gitDirName) {
 		*ignores = append(*ignores, gitDirName)
@@ -53,3 +64,20 @@ func WarnIfDefaultNamespace(namespace string, kubeClient kclient.ClientInterface
 You may set a new %[1]s by running `+"`astra create %[1]s <name>`, or set an existing one by running `astra set %[1]s <name>`", noun)
 	}
 }
+
+// withProjectConfig saves into ctx the configuration of the project in the working directory.
+// An invalid configuration file is reported as a warning and ignored,
+// so that it does not prevent running commands like `astra preference view` to fix it.
+func withProjectConfig(ctx context.Context) context.Context {
+	cwd, err := os.Getwd()
+	if err != nil {
+		klog.V(4).Infof("unable to get the working directory: %v", err)
+		return ctx
+	}
+	projectConfig, err := config.GetProjectConfiguration(filesystem.DefaultFs{}, cwd)
+	if err != nil {
+		log.Warningf("%v. The project configuration is ignored", err)
+		return ctx
+	}
+	return envcontext.WithProjectConfig(ctx, projectConfig)
+}
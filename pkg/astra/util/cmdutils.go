@@ -3,6 +3,9 @@ package util
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github\.com/danielpickens/astra/pkg/api"
 	"github\.com/danielpickens/astra/pkg/log"
@@ -93,3 +96,20 @@ func SetCommandGroup(cmd *cobra.Command, groupName string) {
 	}
 	cmd.Annotations["command"] = groupName
 }
+
+// ParseDurationWithDays parses the value of a duration flag, accepting a number of days with the "d" unit (e.g. 7d)
+// in addition to the units accepted by time.ParseDuration. Negative durations are rejected.
+func ParseDurationWithDays(value string) (time.Duration, error) {
+	if days := strings.TrimSuffix(value, "d"); days != value {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("%q is not a valid duration, such as 7d or 12h", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("%q is not a valid duration, such as 7d or 12h", value)
+	}
+	return d, nil
+}
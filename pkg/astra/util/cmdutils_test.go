@@ -2,6 +2,7 @@ package util
 
 import (
 	"testing"
+	"time"
 )
 
 func TestGetFullName(t *testing.T) {
@@ -13,3 +14,33 @@ func TestGetFullName(t *testing.T) {
 		t.Errorf("test failed, expected %s, got %s", expected, actual)
 	}
 }
+
+func TestParseDurationWithDays(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "7d", want: 7 * 24 * time.Hour},
+		{value: "0d", want: 0},
+		{value: "12h", want: 12 * time.Hour},
+		{value: "90m", want: 90 * time.Minute},
+		{value: "-1d", wantErr: true},
+		{value: "-2h", wantErr: true},
+		{value: "d", wantErr: true},
+		{value: "1.5d", wantErr: true},
+		{value: "seven", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDurationWithDays(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDurationWithDays() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDurationWithDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
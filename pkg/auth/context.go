@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	astralog "github\.com/danielpickens/astra/pkg/log"
+)
+
+// LoginToContext selects the context of the kubeconfig file, after creating it if it does not exist,
+// and logs in to the cluster with the credentials defined in options
+func (o KubernetesClient) LoginToContext(ctx context.Context, options ContextOptions) error {
+	config, err := o.pathOptions.GetStartingConfig()
+	if err != nil {
+		return err
+	}
+
+	name := options.Context
+	if name == "" {
+		name = config.CurrentContext
+	}
+	if name == "" {
+		return errors.New("no current context defined in the kubeconfig file, use --context to select or create a context")
+	}
+
+	kubeContext, exists := config.Contexts[name]
+	if !exists {
+		if options.Server == "" {
+			return fmt.Errorf("the context %q does not exist in the kubeconfig file, a server is required to create it", name)
+		}
+		kubeContext = clientcmdapi.NewContext()
+		kubeContext.Cluster = name
+		kubeContext.AuthInfo = name
+		config.Contexts[name] = kubeContext
+	}
+
+	if options.Server != "" || options.CAFile != "" || options.InsecureSkipTLS {
+		if kubeContext.Cluster == "" {
+			kubeContext.Cluster = name
+		}
+		cluster, ok := config.Clusters[kubeContext.Cluster]
+		if !ok {
+			cluster = clientcmdapi.NewCluster()
+			config.Clusters[kubeContext.Cluster] = cluster
+		}
+		if options.Server != "" {
+			cluster.Server = options.Server
+		}
+		if options.CAFile != "" {
+			cluster.CertificateAuthority, err = filepath.Abs(options.CAFile)
+			if err != nil {
+				return err
+			}
+		}
+		if options.InsecureSkipTLS {
+			cluster.InsecureSkipTLSVerify = true
+		}
+	}
+
+	if options.Namespace != "" {
+		kubeContext.Namespace = options.Namespace
+	}
+
+	if options.Token != "" || options.OIDC != nil {
+		if kubeContext.AuthInfo == "" {
+			kubeContext.AuthInfo = name
+		}
+		authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+		if !ok {
+			authInfo = clientcmdapi.NewAuthInfo()
+			config.AuthInfos[kubeContext.AuthInfo] = authInfo
+		}
+		if options.Token != "" {
+			authInfo.Token = options.Token
+			authInfo.AuthProvider = nil
+		}
+		if options.OIDC != nil {
+			var cached map[string]string
+			if authInfo.AuthProvider != nil && authInfo.AuthProvider.Name == oidcAuthProvider {
+				cached = authInfo.AuthProvider.Config
+			}
+			var tokens oidcTokens
+			tokens, err = o.getOIDCTokens(ctx, *options.OIDC, cached)
+			if err != nil {
+				return err
+			}
+			authInfo.Token = ""
+			authInfo.AuthProvider = &clientcmdapi.AuthProviderConfig{
+				Name:   oidcAuthProvider,
+				Config: tokens.toAuthProviderConfig(*options.OIDC),
+			}
+		}
+	}
+
+	config.CurrentContext = name
+	err = clientcmd.ModifyConfig(o.pathOptions, *config, true)
+	if err != nil {
+		return fmt.Errorf("unable to write the kubeconfig file: %w", err)
+	}
+
+	if exists {
+		astralog.Successf("Switched to context %q", name)
+	} else {
+		astralog.Successf("Created and switched to context %q", name)
+	}
+	return nil
+}
@@ -1,5 +1,10 @@
 package auth
 
+import "context"
+
 type Client interface {
 	Login(server, username, password, token, caAuth string, skipTLS bool) error
+	// LoginToContext selects the context of the kubeconfig file, after creating it if it does not exist,
+	// and logs in to the cluster with the credentials defined in options
+	LoginToContext(ctx context.Context, options ContextOptions) error
 }
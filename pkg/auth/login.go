@@ -14,12 +14,23 @@ import (
 	astralog "github\.com/danielpickens/astra/pkg/log"
 )
 
-type KubernetesClient struct{}
+type KubernetesClient struct {
+	// pathOptions locates the kubeconfig file modified by LoginToContext
+	pathOptions *clientcmd.PathOptions
+	// out receives the instructions to log in with an OIDC provider
+	out io.Writer
+	// openBrowser opens the page of the OIDC provider to log in
+	openBrowser func(url string) error
+}
 
 var _ Client = (*KubernetesClient)(nil)
 
 func NewKubernetesClient() *KubernetesClient {
-	return &KubernetesClient{}
+	return &KubernetesClient{
+		pathOptions: clientcmd.NewDefaultPathOptions(),
+		out:         astralog.GetStdout(),
+		openBrowser: openBrowser,
+	}
 }
 
 // Login takes care of authentication part and returns error, if any
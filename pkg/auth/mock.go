@@ -5,6 +5,7 @@
 package auth
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -46,3 +47,17 @@ func (mr *MockClientMockRecorder) Login(server, username, password, token, caAut
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockClient)(nil).Login), server, username, password, token, caAuth, skipTLS)
 }
+
+// LoginToContext mocks base method.
+func (m *MockClient) LoginToContext(ctx context.Context, options ContextOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginToContext", ctx, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoginToContext indicates an expected call of LoginToContext.
+func (mr *MockClientMockRecorder) LoginToContext(ctx interface{}, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginToContext", reflect.TypeOf((*MockClient)(nil).LoginToContext), ctx, options)
+}
@@ -0,0 +1,277 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"k8s.io/klog"
+)
+
+// oidcAuthProvider is the name of the client-go auth provider using the OIDC tokens stored in the kubeconfig file,
+// and refreshing them when they expire
+const oidcAuthProvider = "oidc"
+
+// Keys of the configuration of the oidc auth provider
+const (
+	cfgIssuerURL    = "idp-issuer-url"
+	cfgClientID     = "client-id"
+	cfgClientSecret = "client-secret"
+	cfgIDToken      = "id-token"
+	cfgRefreshToken = "refresh-token"
+	cfgExtraScopes  = "extra-scopes"
+)
+
+// tokenExpirySkew is the delay before its expiration after which an ID token is considered expired
+const tokenExpirySkew = 10 * time.Second
+
+// oidcTokens are the tokens returned by the OIDC provider
+type oidcTokens struct {
+	idToken      string
+	refreshToken string
+}
+
+// oidcEndpoints are the endpoints of an OIDC provider, from its discovery document
+type oidcEndpoints struct {
+	AuthorizationEndpoint       string `json:"authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+func (o oidcTokens) toAuthProviderConfig(options OIDCOptions) map[string]string {
+	config := map[string]string{
+		cfgIssuerURL: options.IssuerURL,
+		cfgClientID:  options.ClientID,
+		cfgIDToken:   o.idToken,
+	}
+	if options.ClientSecret != "" {
+		config[cfgClientSecret] = options.ClientSecret
+	}
+	if o.refreshToken != "" {
+		config[cfgRefreshToken] = o.refreshToken
+	}
+	if len(options.ExtraScopes) != 0 {
+		config[cfgExtraScopes] = strings.Join(options.ExtraScopes, ",")
+	}
+	return config
+}
+
+// getOIDCTokens returns the cached tokens if the ID token is still valid, or refreshes them with the cached refresh token.
+// Otherwise, the user is asked to log in to the OIDC provider.
+func (o KubernetesClient) getOIDCTokens(ctx context.Context, options OIDCOptions, cached map[string]string) (oidcTokens, error) {
+	if cached[cfgIssuerURL] != options.IssuerURL || cached[cfgClientID] != options.ClientID {
+		cached = nil
+	}
+	if idToken := cached[cfgIDToken]; idToken != "" && !isTokenExpired(idToken, time.Now()) {
+		klog.V(2).Infof("using the cached ID token")
+		return oidcTokens{idToken: idToken, refreshToken: cached[cfgRefreshToken]}, nil
+	}
+
+	endpoints, err := discoverOIDCEndpoints(ctx, options.IssuerURL)
+	if err != nil {
+		return oidcTokens{}, err
+	}
+	conf := &oauth2.Config{
+		ClientID:     options.ClientID,
+		ClientSecret: options.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       endpoints.AuthorizationEndpoint,
+			TokenURL:      endpoints.TokenEndpoint,
+			DeviceAuthURL: endpoints.DeviceAuthorizationEndpoint,
+		},
+		Scopes: append([]string{"openid"}, options.ExtraScopes...),
+	}
+
+	if refreshToken := cached[cfgRefreshToken]; refreshToken != "" {
+		var token *oauth2.Token
+		token, err = conf.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+		if err == nil {
+			klog.V(2).Infof("ID token refreshed")
+			return getTokens(token)
+		}
+		klog.V(2).Infof("unable to refresh the ID token, logging in again: %v", err)
+	}
+
+	var token *oauth2.Token
+	if options.DeviceCode {
+		token, err = o.loginWithDeviceCode(ctx, conf)
+	} else {
+		token, err = o.loginWithAuthCode(ctx, conf, options.ListenPort)
+	}
+	if err != nil {
+		return oidcTokens{}, err
+	}
+	return getTokens(token)
+}
+
+// loginWithAuthCode logs in with the authorization code flow with PKCE, receiving the code on a local server
+func (o KubernetesClient) loginWithAuthCode(ctx context.Context, conf *oauth2.Config, port int) (*oauth2.Token, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen for the OIDC callback: %w", err)
+	}
+	conf.RedirectURL = fmt.Sprintf("http://localhost:%d/callback", listener.Addr().(*net.TCPAddr).Port)
+
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	type callbackResult struct {
+		code string
+		err  error
+	}
+	results := make(chan callbackResult, 1)
+	server := &http.Server{
+		ReadHeaderTimeout: 10 * time.Second,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/callback" {
+				http.NotFound(w, r)
+				return
+			}
+			var result callbackResult
+			query := r.URL.Query()
+			switch {
+			case query.Get("error") != "":
+				result.err = fmt.Errorf("the OIDC provider returned an error: %s %s", query.Get("error"), query.Get("error_description"))
+			case query.Get("state") != state:
+				result.err = errors.New("invalid state received from the OIDC provider")
+			default:
+				result.code = query.Get("code")
+			}
+			if result.err != nil {
+				http.Error(w, result.err.Error(), http.StatusBadRequest)
+			} else {
+				fmt.Fprintln(w, "You are logged in. You can close this window and go back to astra.")
+			}
+			select {
+			case results <- result:
+			default:
+			}
+		}),
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Close()
+
+	authURL := conf.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+	fmt.Fprintf(o.out, "Open the following URL in your browser to log in:\n\n    %s\n\n", authURL)
+	if err = o.openBrowser(authURL); err != nil {
+		klog.V(4).Infof("unable to open the browser: %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.err != nil {
+			return nil, result.err
+		}
+		return conf.Exchange(ctx, result.code, oauth2.VerifierOption(verifier))
+	}
+}
+
+// loginWithDeviceCode logs in with the device authorization flow, for environments without a browser
+func (o KubernetesClient) loginWithDeviceCode(ctx context.Context, conf *oauth2.Config) (*oauth2.Token, error) {
+	if conf.Endpoint.DeviceAuthURL == "" {
+		return nil, errors.New("the OIDC provider does not support the device authorization flow")
+	}
+	response, err := conf.DeviceAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if response.VerificationURIComplete != "" {
+		fmt.Fprintf(o.out, "Open the following URL in a browser to log in:\n\n    %s\n\n", response.VerificationURIComplete)
+	} else {
+		fmt.Fprintf(o.out, "Open the following URL in a browser and enter the code %s to log in:\n\n    %s\n\n", response.UserCode, response.VerificationURI)
+	}
+	return conf.DeviceAccessToken(ctx, response)
+}
+
+// discoverOIDCEndpoints gets the endpoints of the OIDC provider from its discovery document
+func discoverOIDCEndpoints(ctx context.Context, issuerURL string) (oidcEndpoints, error) {
+	discoveryURL := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return oidcEndpoints{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return oidcEndpoints{}, fmt.Errorf("unable to get the configuration of the OIDC provider: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return oidcEndpoints{}, fmt.Errorf("unable to get the configuration of the OIDC provider from %s: %s", discoveryURL, resp.Status)
+	}
+	var endpoints oidcEndpoints
+	if err = json.NewDecoder(resp.Body).Decode(&endpoints); err != nil {
+		return oidcEndpoints{}, fmt.Errorf("invalid configuration of the OIDC provider: %w", err)
+	}
+	if endpoints.TokenEndpoint == "" {
+		return oidcEndpoints{}, errors.New("invalid configuration of the OIDC provider: no token endpoint")
+	}
+	return endpoints, nil
+}
+
+// getTokens extracts the ID and refresh tokens from the token returned by the OIDC provider
+func getTokens(token *oauth2.Token) (oidcTokens, error) {
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		return oidcTokens{}, errors.New("no ID token returned by the OIDC provider")
+	}
+	return oidcTokens{idToken: idToken, refreshToken: token.RefreshToken}, nil
+}
+
+// isTokenExpired returns true if the JWT token is expired at now, or if its expiration cannot be determined.
+// The signature of the token is not verified, this is done by the cluster.
+func isTokenExpired(token string, now time.Time) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return true
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return true
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return true
+	}
+	return !now.Add(tokenExpirySkew).Before(time.Unix(claims.Exp, 0))
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// openBrowser opens url in the default browser
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,258 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// mockIssuer is a minimal OIDC provider supporting the authorization code, device code and refresh token grants
+type mockIssuer struct {
+	*httptest.Server
+	mu            sync.Mutex
+	grants        []string
+	challenge     string
+	idTokenExpiry time.Time
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	issuer := &mockIssuer{idTokenExpiry: time.Now().Add(time.Hour)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                        issuer.URL,
+			"authorization_endpoint":        issuer.URL + "/authorize",
+			"token_endpoint":                issuer.URL + "/token",
+			"device_authorization_endpoint": issuer.URL + "/device",
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		issuer.mu.Lock()
+		issuer.challenge = query.Get("code_challenge")
+		issuer.mu.Unlock()
+		redirect := fmt.Sprintf("%s?code=auth-code&state=%s", query.Get("redirect_uri"), url.QueryEscape(query.Get("state")))
+		http.Redirect(w, r, redirect, http.StatusFound)
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": issuer.URL + "/activate",
+			"interval":         1,
+			"expires_in":       60,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("invalid token request: %v", err)
+		}
+		grant := r.PostForm.Get("grant_type")
+		issuer.mu.Lock()
+		issuer.grants = append(issuer.grants, grant)
+		challenge := issuer.challenge
+		expiry := issuer.idTokenExpiry
+		issuer.mu.Unlock()
+		if grant == "authorization_code" {
+			sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			if r.PostForm.Get("code") != "auth-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+				http.Error(w, `{"error": "invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  "access-token",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"refresh_token": "refresh-token-" + grant,
+			"id_token":      newIDToken(expiry),
+		})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	return issuer
+}
+
+func (o *mockIssuer) getGrants() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]string{}, o.grants...)
+}
+
+// newIDToken returns an unsigned JWT expiring at expiry
+func newIDToken(expiry time.Time) string {
+	encode := func(v interface{}) string {
+		b, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	return encode(map[string]string{"alg": "none"}) + "." + encode(map[string]interface{}{"sub": "developer", "exp": expiry.Unix()}) + ".signature"
+}
+
+// newTestClient returns a client modifying a kubeconfig file in a temporary directory,
+// and following the redirections of the OIDC provider instead of opening a browser
+func newTestClient(t *testing.T, config *clientcmdapi.Config) (KubernetesClient, string) {
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	if config == nil {
+		config = clientcmdapi.NewConfig()
+	}
+	if err := clientcmd.WriteToFile(*config, kubeconfig); err != nil {
+		t.Fatal(err)
+	}
+	pathOptions := clientcmd.NewDefaultPathOptions()
+	pathOptions.GlobalFile = kubeconfig
+	pathOptions.EnvVar = ""
+	pathOptions.LoadingRules.ExplicitPath = kubeconfig
+	return KubernetesClient{
+		pathOptions: pathOptions,
+		out:         io.Discard,
+		openBrowser: func(url string) error {
+			resp, err := http.Get(url)
+			if err != nil {
+				return err
+			}
+			return resp.Body.Close()
+		},
+	}, kubeconfig
+}
+
+func TestKubernetesClient_LoginToContext_OIDC(t *testing.T) {
+	issuer := newMockIssuer(t)
+	client, kubeconfig := newTestClient(t, nil)
+	options := ContextOptions{
+		Context:   "dev",
+		Server:    "https://api.example.com:6443",
+		Namespace: "my-project",
+		OIDC: &OIDCOptions{
+			IssuerURL: issuer.URL,
+			ClientID:  "astra",
+		},
+	}
+
+	// first login, with the authorization code flow
+	if err := client.LoginToContext(context.Background(), options); err != nil {
+		t.Fatalf("LoginToContext() unexpected error: %v", err)
+	}
+	config, err := clientcmd.LoadFromFile(kubeconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.CurrentContext != "dev" || config.Contexts["dev"] == nil || config.Contexts["dev"].Namespace != "my-project" {
+		t.Fatalf("context dev not created and selected: %+v", config)
+	}
+	if server := config.Clusters["dev"].Server; server != options.Server {
+		t.Errorf("server = %q, want %q", server, options.Server)
+	}
+	provider := config.AuthInfos["dev"].AuthProvider
+	if provider == nil || provider.Name != oidcAuthProvider || provider.Config[cfgIDToken] == "" || provider.Config[cfgRefreshToken] != "refresh-token-authorization_code" {
+		t.Fatalf("unexpected auth provider: %+v", provider)
+	}
+
+	// the cached ID token is still valid
+	if err = client.LoginToContext(context.Background(), options); err != nil {
+		t.Fatalf("LoginToContext() unexpected error: %v", err)
+	}
+	if grants := issuer.getGrants(); len(grants) != 1 {
+		t.Errorf("expected the cached ID token to be used, got grants %v", grants)
+	}
+
+	// the cached ID token is expired, it is refreshed
+	provider.Config[cfgIDToken] = newIDToken(time.Now().Add(-time.Minute))
+	if err = clientcmd.ModifyConfig(client.pathOptions, *config, false); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.LoginToContext(context.Background(), options); err != nil {
+		t.Fatalf("LoginToContext() unexpected error: %v", err)
+	}
+	if grants := issuer.getGrants(); len(grants) != 2 || grants[1] != "refresh_token" {
+		t.Errorf("expected the ID token to be refreshed, got grants %v", grants)
+	}
+}
+
+func TestKubernetesClient_LoginToContext_DeviceCode(t *testing.T) {
+	issuer := newMockIssuer(t)
+	client, kubeconfig := newTestClient(t, nil)
+	client.openBrowser = func(string) error {
+		t.Errorf("the browser should not be opened with the device code flow")
+		return nil
+	}
+	err := client.LoginToContext(context.Background(), ContextOptions{
+		Context: "dev",
+		Server:  "https://api.example.com:6443",
+		OIDC: &OIDCOptions{
+			IssuerURL:  issuer.URL,
+			ClientID:   "astra",
+			DeviceCode: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("LoginToContext() unexpected error: %v", err)
+	}
+	config, err := clientcmd.LoadFromFile(kubeconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := config.AuthInfos["dev"].AuthProvider.Config[cfgRefreshToken]; token != "refresh-token-urn:ietf:params:oauth:grant-type:device_code" {
+		t.Errorf("unexpected refresh token %q", token)
+	}
+}
+
+func TestKubernetesClient_LoginToContext_SwitchContext(t *testing.T) {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["cluster"] = &clientcmdapi.Cluster{Server: "https://api.example.com:6443"}
+	config.AuthInfos["user"] = &clientcmdapi.AuthInfo{Token: "token"}
+	config.Contexts["first"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user"}
+	config.Contexts["second"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user", Namespace: "ns"}
+	config.CurrentContext = "first"
+	client, kubeconfig := newTestClient(t, config)
+
+	if err := client.LoginToContext(context.Background(), ContextOptions{Context: "second"}); err != nil {
+		t.Fatalf("LoginToContext() unexpected error: %v", err)
+	}
+	got, err := clientcmd.LoadFromFile(kubeconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.CurrentContext != "second" {
+		t.Errorf("current context = %q, want %q", got.CurrentContext, "second")
+	}
+
+	if err = client.LoginToContext(context.Background(), ContextOptions{Context: "unknown"}); err == nil {
+		t.Errorf("LoginToContext() expected an error for an unknown context without server")
+	}
+}
+
+func Test_isTokenExpired(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{name: "valid token", token: newIDToken(now.Add(time.Hour)), want: false},
+		{name: "expired token", token: newIDToken(now.Add(-time.Hour)), want: true},
+		{name: "token expiring in a few seconds", token: newIDToken(now.Add(5 * time.Second)), want: true},
+		{name: "invalid token", token: "not-a-jwt", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTokenExpired(tt.token, now); got != tt.want {
+				t.Errorf("isTokenExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
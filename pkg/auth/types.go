@@ -0,0 +1,35 @@
+package auth
+
+// ContextOptions are the options to select or create a context of the kubeconfig file
+type ContextOptions struct {
+	// Context is the name of the context to select or create. The current context is used if empty
+	Context string
+	// Server is the URL of the API server of the cluster, required to create a context
+	Server string
+	// Namespace is the namespace of the context, if not empty
+	Namespace string
+	// CAFile is the path of the certificate authority file of the cluster, if not empty
+	CAFile string
+	// InsecureSkipTLS disables the verification of the certificate of the cluster
+	InsecureSkipTLS bool
+	// Token is the bearer token used to authenticate to the cluster, if not empty
+	Token string
+	// OIDC is used to authenticate to the cluster with an OIDC provider, if not nil
+	OIDC *OIDCOptions
+}
+
+// OIDCOptions are the options to log in with an OIDC provider
+type OIDCOptions struct {
+	// IssuerURL is the URL of the OIDC provider
+	IssuerURL string
+	// ClientID is the ID of the client registered on the OIDC provider
+	ClientID string
+	// ClientSecret is the secret of the client, empty for a public client
+	ClientSecret string
+	// ExtraScopes are requested in addition to the openid scope
+	ExtraScopes []string
+	// DeviceCode uses the device authorization flow instead of the authorization code flow
+	DeviceCode bool
+	// ListenPort is the local port receiving the authorization code, a random port is used if 0
+	ListenPort int
+}
@@ -28,7 +28,8 @@ func (o *BindingClient) SelectNamespace(flags map[string]string) (string, error)
 	return backend.SelectNamespace(flags)
 }
 
-// ValidateAddBinding calls Validate method of the adequate backend and then checks if the ServiceBinding Operator is installed in the cluster.
+// ValidateAddBinding calls Validate method of the adequate backend.
+// The ServiceBinding Operator does not need to be installed in the cluster, as bindings can be processed by astra without the operator.
 func (o *BindingClient) ValidateAddBinding(flags map[string]string, withDevfile bool) error {
 	var backend backendpkg.AddBindingBackend
 	if len(flags) == 0 {
@@ -36,12 +37,7 @@ func (o *BindingClient) ValidateAddBinding(flags map[string]string, withDevfile
 	} else {
 		backend = o.flagsBackend
 	}
-	err := backend.Validate(flags, withDevfile)
-	if err != nil {
-		return err
-	}
-
-	return o.checkServiceBindingOperatorInstalled()
+	return backend.Validate(flags, withDevfile)
 }
 
 func (o *BindingClient) SelectServiceInstance(flags map[string]string, serviceMap map[string]unstructured.Unstructured) (string, error) {
@@ -206,7 +202,17 @@ func (o *BindingClient) AddBinding(
 			output = string(yamlDesc)
 
 		case asker.CreateOnCluster:
-			_, err = o.kubernetesClient.PatchDynamicResource(serviceBindingUnstructured)
+			var operatorless bool
+			operatorless, err = o.isOperatorless()
+			if err != nil {
+				return nil, "", "", err
+			}
+			if operatorless {
+				// Project the binding into the workload client-side, as there is no operator to process the ServiceBinding resource
+				err = o.bindWithoutOperator(serviceBindingUnstructured, workloadName, workloadGVK)
+			} else {
+				_, err = o.kubernetesClient.PatchDynamicResource(serviceBindingUnstructured)
+			}
 			if err != nil {
 				return nil, "", "", err
 			}
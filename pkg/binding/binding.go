@@ -71,10 +71,14 @@ func (o *BindingClient) GetFlags(flags map[string]string) map[string]string {
 }
 
 func (o *BindingClient) GetServiceInstances(namespace string) (map[string]unstructured.Unstructured, error) {
-	err := o.checkServiceBindingOperatorInstalled()
+	operatorless, err := o.isOperatorless()
 	if err != nil {
 		return nil, err
 	}
+	if operatorless {
+		klog.V(2).Infof("Service Binding Operator is not installed on the cluster, listing the services which can be bound without the operator")
+		return o.getOperatorlessServiceInstances(namespace)
+	}
 
 	// Get the BindableKinds/bindable-kinds object
 	bindableKind, err := o.kubernetesClient.GetBindableKinds()
@@ -140,6 +144,18 @@ func (o *BindingClient) GetBindingsFromDevfile(devfileObj parser.DevfileObj, con
 	allComponents = append(allComponents, ocpComponents...)
 
 	var warning error
+	// operatorless is determined only if a binding is found in the devfile
+	var operatorless *bool
+	isOperatorless := func() (bool, error) {
+		if operatorless == nil {
+			value, err := o.isOperatorless()
+			if err != nil {
+				return false, err
+			}
+			operatorless = &value
+		}
+		return *operatorless, nil
+	}
 	for _, component := range allComponents {
 		strCRD, err := libdevfile.GetK8sManifestsWithVariablesSubstituted(devfileObj, component.Name, context, devfilefs.DefaultFs{})
 		if err != nil {
@@ -164,7 +180,14 @@ func (o *BindingClient) GetBindingsFromDevfile(devfileObj parser.DevfileObj, con
 			if err != nil {
 				return nil, err
 			}
-			sb.Status, err = o.getStatusFromBinding(sb.Name)
+			withoutOperator, err := isOperatorless()
+			if err == nil {
+				if withoutOperator {
+					sb.Status, err = o.getStatusWithoutOperator(sb.Name, sb.Spec.BindAsFiles)
+				} else {
+					sb.Status, err = o.getStatusFromBinding(sb.Name)
+				}
+			}
 			if err != nil {
 				warning = clierrors.NewWarning(kclient.NewNoConnectionError().Error(), err)
 			}
@@ -222,11 +245,28 @@ func (o *BindingClient) GetBindingFromCluster(name string) (api.ServiceBinding,
 		return sb, nil
 	}
 
-	// In case of notFound error, this time we return the error
-	if kerrors.IsNotFound(err) {
-		return api.ServiceBinding{}, fmt.Errorf("ServiceBinding %q not found", name)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return api.ServiceBinding{}, err
+	}
+
+	// The binding may have been processed without the Service Binding Operator
+	operatorless, err := o.isOperatorless()
+	if err != nil {
+		return api.ServiceBinding{}, err
 	}
-	return api.ServiceBinding{}, err
+	if operatorless {
+		var sb api.ServiceBinding
+		sb, err = o.getBindingWithoutOperator(name)
+		if err == nil {
+			return sb, nil
+		}
+		if !kerrors.IsNotFound(err) {
+			return api.ServiceBinding{}, err
+		}
+	}
+
+	// In case of notFound error, this time we return the error
+	return api.ServiceBinding{}, fmt.Errorf("ServiceBinding %q not found", name)
 }
 
 // getStatusFromBinding returns status information from a ServiceBinding in the cluster
@@ -310,20 +350,6 @@ func (o *BindingClient) getStatusFromSpec(name string) (*api.ServiceBindingStatu
 	}, nil
 }
 
-func (o *BindingClient) checkServiceBindingOperatorInstalled() error {
-	isServiceBindingInstalled, err := o.kubernetesClient.IsServiceBindingSupported()
-	if err != nil {
-		return err
-	}
-	if !isServiceBindingInstalled {
-		//revive:disable:error-strings This is a top-level error message displayed as is to the end user
-		return fmt.Errorf("Service Binding Operator is not installed on the cluster, please ensure it is installed before proceeding. " +
-			"See installation instructions: https://astra.dev/docs/command-reference/add-binding#installing-the-service-binding-operator")
-		//revive:enable:error-strings
-	}
-	return nil
-}
-
 func (o *BindingClient) CheckServiceBindingsInjectionDone(componentName string, appName string) (bool, error) {
 
 	deployment, err := o.kubernetesClient.GetOneDeployment(componentName, appName, true)
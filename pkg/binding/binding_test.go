@@ -103,6 +103,34 @@ func TestBindingClient_GetServiceInstances(t *testing.T) {
 			},
 		},
 	}
+	dbSecretUnstructured := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "db-credentials"},
+		"type":       "Opaque",
+	}}
+	tokenSecretUnstructured := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "default-token"},
+		"type":       "kubernetes.io/service-account-token",
+	}}
+	dbServiceUnstructured := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name": "db",
+			"annotations": map[string]interface{}{
+				"service.binding/host": "path={.spec.clusterIP}",
+			},
+		},
+	}}
+	otherServiceUnstructured := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "other"},
+	}}
+
 	type fields struct {
 		kubernetesClient func(ctrl *gomock.Controller) kclient.ClientInterface
 	}
@@ -190,14 +218,24 @@ func TestBindingClient_GetServiceInstances(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "error out if the servicebinding CRD is not found",
+			name: "list secrets and services with binding hints if the servicebinding CRD is not found",
 			fields: fields{kubernetesClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
 				client := kclient.NewMockClientInterface(ctrl)
 				client.EXPECT().IsServiceBindingSupported().Return(false, nil)
+				client.EXPECT().ListDynamicResources("", secretsGVR, "").Return(&unstructured.UnstructuredList{
+					Items: []unstructured.Unstructured{dbSecretUnstructured, tokenSecretUnstructured},
+				}, nil)
+				client.EXPECT().ListDynamicResources("", servicesGVR, "").Return(&unstructured.UnstructuredList{
+					Items: []unstructured.Unstructured{dbServiceUnstructured, otherServiceUnstructured},
+				}, nil)
+				client.EXPECT().IsCSVSupported().Return(false, nil)
 				return client
 			}},
-			want:    nil,
-			wantErr: true,
+			want: map[string]unstructured.Unstructured{
+				"db-credentials (Secret)": dbSecretUnstructured,
+				"db (Service)":            dbServiceUnstructured,
+			},
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
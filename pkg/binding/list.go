@@ -61,7 +61,20 @@ func (o *BindingClient) ListAllBindings(devfileObj *parser.DevfileObj, context s
 		}
 	}
 
-	if o.kubernetesClient != nil {
+	operatorless, err := o.isOperatorless()
+	if err != nil {
+		return nil, nil, err
+	}
+	if operatorless {
+		var bindings []api.ServiceBinding
+		bindings, err = o.listBindingsWithoutOperator()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, binding := range bindings {
+			bindingList.add(binding)
+		}
+	} else if o.kubernetesClient != nil {
 		specs, bindings, err := o.kubernetesClient.ListServiceBindingsFromAllGroups()
 		if err != nil {
 			return nil, nil, err
@@ -98,6 +98,7 @@ func TestBindingClient_ListAllBindings(t *testing.T) {
 			fields: fields{
 				func(ctrl *gomock.Controller) kclient.ClientInterface {
 					client := kclient.NewMockClientInterface(ctrl)
+					client.EXPECT().IsServiceBindingSupported().Return(true, nil).AnyTimes()
 					client.EXPECT().ListServiceBindingsFromAllGroups().Return(nil, nil, nil)
 					client.EXPECT().GetBindingServiceBinding(gomock.Any()).Return(
 						v1alpha1.ServiceBinding{},
@@ -123,6 +124,7 @@ func TestBindingClient_ListAllBindings(t *testing.T) {
 			fields: fields{
 				func(ctrl *gomock.Controller) kclient.ClientInterface {
 					client := kclient.NewMockClientInterface(ctrl)
+					client.EXPECT().IsServiceBindingSupported().Return(true, nil).AnyTimes()
 					client.EXPECT().ListServiceBindingsFromAllGroups().Return(nil, []v1alpha1.ServiceBinding{
 						*bindingServiceBinding,
 					}, nil)
@@ -170,6 +172,7 @@ func TestBindingClient_ListAllBindings(t *testing.T) {
 			fields: fields{
 				func(ctrl *gomock.Controller) kclient.ClientInterface {
 					client := kclient.NewMockClientInterface(ctrl)
+					client.EXPECT().IsServiceBindingSupported().Return(true, nil).AnyTimes()
 					client.EXPECT().ListServiceBindingsFromAllGroups().Return(nil, []v1alpha1.ServiceBinding{
 						*bindingServiceBinding,
 					}, nil)
@@ -209,6 +212,52 @@ func TestBindingClient_ListAllBindings(t *testing.T) {
 			},
 			wantInDevfile: nil,
 		},
+		{
+			name: "a servicebinding processed without the Service Binding Operator",
+			fields: fields{
+				func(ctrl *gomock.Controller) kclient.ClientInterface {
+					client := kclient.NewMockClientInterface(ctrl)
+					client.EXPECT().IsServiceBindingSupported().Return(false, nil).AnyTimes()
+					client.EXPECT().ListSecrets("app.kubernetes.io/link-name").Return([]corev1.Secret{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "my-nodejs-app-db-credentials",
+								Labels: map[string]string{
+									"app.kubernetes.io/link-name":    "my-nodejs-app-db-credentials",
+									"app.kubernetes.io/service-name": "Secret-db-credentials",
+									"app.kubernetes.io/service-kind": "Secret",
+									"astra.dev/mode":                 "Dev",
+								},
+							},
+							Data: map[string][]byte{
+								"password": []byte("secret"),
+								"username": []byte("admin"),
+							},
+						},
+					}, nil)
+					return client
+				},
+			}, args: args{},
+			want: []api.ServiceBinding{
+				{
+					Name: "my-nodejs-app-db-credentials",
+					Spec: api.ServiceBindingSpec{
+						Services: []api.ServiceBindingReference{
+							{
+								Name: "db-credentials",
+								Kind: "Secret",
+							},
+						},
+					},
+					Status: &api.ServiceBindingStatus{
+						BindingEnvVars: []string{"password", "username"},
+						RunningIn:      api.RunningModes{"dev": true, "deploy": false},
+						Operatorless:   true,
+					},
+				},
+			},
+			wantInDevfile: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
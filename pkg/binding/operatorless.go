@@ -0,0 +1,240 @@
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/labels"
+	"github\.com/danielpickens/astra/pkg/service"
+)
+
+// When the Service Binding Operator is not installed on the cluster, bindings are processed client-side
+// by the Service Binding library (see service.BindWithoutOperator). The binding data is collected into a secret
+// labelled with the name of the binding, which is used to get the status of the binding.
+
+var (
+	secretsGVR  = corev1.SchemeGroupVersion.WithResource("secrets")
+	servicesGVR = corev1.SchemeGroupVersion.WithResource("services")
+)
+
+// ignoredSecretTypes are the types of secrets which cannot be bound to an application
+var ignoredSecretTypes = map[corev1.SecretType]struct{}{
+	corev1.SecretTypeServiceAccountToken: {},
+	corev1.SecretTypeDockercfg:           {},
+	corev1.SecretTypeDockerConfigJson:    {},
+	"helm.sh/release.v1":                 {},
+}
+
+// isOperatorless returns true if the Service Binding Operator is not installed on the cluster
+func (o *BindingClient) isOperatorless() (bool, error) {
+	if o.kubernetesClient == nil {
+		return false, nil
+	}
+	supported, err := o.kubernetesClient.IsServiceBindingSupported()
+	if err != nil {
+		return false, err
+	}
+	return !supported, nil
+}
+
+// bindWithoutOperator binds the workload to the service referenced by the ServiceBinding u, without the Service Binding Operator.
+// The secret containing the binding data is owned by the workload, so that it is garbage-collected with it,
+// and has the labels of the component when the workload is managed by astra, so that `astra delete component` deletes it.
+func (o *BindingClient) bindWithoutOperator(u unstructured.Unstructured, workloadName string, workloadGVK schema.GroupVersionKind) error {
+	gvr, err := o.kubernetesClient.GetGVRFromGVK(workloadGVK)
+	if err != nil {
+		return err
+	}
+	workload, err := o.kubernetesClient.GetDynamicResource(gvr, workloadName)
+	if err != nil {
+		return fmt.Errorf("unable to get the workload %q: %w", workloadName, err)
+	}
+	ownerReference := metav1.OwnerReference{
+		APIVersion: workload.GetAPIVersion(),
+		Kind:       workload.GetKind(),
+		Name:       workload.GetName(),
+		UID:        workload.GetUID(),
+	}
+
+	var secretLabels map[string]string
+	workloadLabels := workload.GetLabels()
+	if labels.IsManagedByastra(workloadLabels) {
+		secretLabels = labels.GetLabels(labels.GetComponentName(workloadLabels), labels.GetAppName(workloadLabels), "", labels.GetMode(workloadLabels), false)
+	}
+	return service.BindWithoutOperator(o.kubernetesClient, u, secretLabels, &ownerReference)
+}
+
+// getOperatorlessServiceInstances returns the services which can be bound without the Service Binding Operator:
+// the plain Secrets, and the Services and operator backed resources exposing binding hints
+func (o *BindingClient) getOperatorlessServiceInstances(namespace string) (map[string]unstructured.Unstructured, error) {
+	var bindableObjectMap = map[string]unstructured.Unstructured{}
+
+	secrets, err := o.kubernetesClient.ListDynamicResources(namespace, secretsGVR, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range secrets.Items {
+		secretType, _, _ := unstructured.NestedString(item.Object, "type")
+		if _, ignored := ignoredSecretTypes[corev1.SecretType(secretType)]; ignored {
+			continue
+		}
+		if service.IsLinkSecret(item.GetLabels()) {
+			// the secret has been created for another binding
+			continue
+		}
+		bindableObjectMap[serviceInstanceName(item)] = item
+	}
+
+	services, err := o.kubernetesClient.ListDynamicResources(namespace, servicesGVR, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range services.Items {
+		if service.HasBindingHints(item) {
+			bindableObjectMap[serviceInstanceName(item)] = item
+		}
+	}
+
+	csvSupported, err := o.kubernetesClient.IsCSVSupported()
+	if err != nil {
+		return nil, err
+	}
+	if csvSupported {
+		// the kinds provided by the operators installed with OLM are known, without requiring the BindableKinds of the Service Binding Operator
+		instances, failed, err := service.ListOperatorServices(o.kubernetesClient)
+		if err != nil {
+			return nil, err
+		}
+		if len(failed) > 0 {
+			klog.V(3).Infof("unable to list instances of %v", failed)
+		}
+		for _, item := range instances {
+			if namespace != "" && item.GetNamespace() != namespace {
+				continue
+			}
+			if service.HasBindingHints(item) {
+				bindableObjectMap[serviceInstanceName(item)] = item
+			}
+		}
+	}
+
+	return bindableObjectMap, nil
+}
+
+// serviceInstanceName returns the name of a service instance, in the format `<name> (<kind>.<group>)`
+// or `<name> (<kind>)` for core resources
+func serviceInstanceName(u unstructured.Unstructured) string {
+	group := u.GroupVersionKind().Group
+	if group == "" {
+		return fmt.Sprintf("%s (%s)", u.GetName(), u.GetKind())
+	}
+	return fmt.Sprintf("%s (%s.%s)", u.GetName(), u.GetKind(), group)
+}
+
+// getLinkSecret returns the secret containing the binding data collected for the binding,
+// or nil if the binding has not been processed yet
+func (o *BindingClient) getLinkSecret(name string) (*corev1.Secret, error) {
+	secrets, err := o.kubernetesClient.ListSecrets(fmt.Sprintf("%s=%s", service.LinkLabel, name))
+	if err != nil {
+		return nil, err
+	}
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+	return &secrets[0], nil
+}
+
+// getStatusWithoutOperator returns status information from the secret created by the Service Binding library
+func (o *BindingClient) getStatusWithoutOperator(name string, bindAsFiles bool) (*api.ServiceBindingStatus, error) {
+	secret, err := o.getLinkSecret(name)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	return statusFromLinkSecret(name, bindAsFiles, *secret), nil
+}
+
+func statusFromLinkSecret(name string, bindAsFiles bool, secret corev1.Secret) *api.ServiceBindingStatus {
+	keys := make([]string, 0, len(secret.Data))
+	for k := range secret.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	status := &api.ServiceBindingStatus{
+		Operatorless: true,
+	}
+	if bindAsFiles {
+		for _, k := range keys {
+			status.BindingFiles = append(status.BindingFiles, filepath.ToSlash(filepath.Join("${SERVICE_BINDING_ROOT}", name, k)))
+		}
+		return status
+	}
+	status.BindingEnvVars = keys
+	return status
+}
+
+// bindingFromLinkSecret returns information about a binding processed by the Service Binding library,
+// from the secret containing its binding data
+func bindingFromLinkSecret(secret corev1.Secret) (api.ServiceBinding, error) {
+	name := secret.GetLabels()[service.LinkLabel]
+	result := api.ServiceBinding{
+		Name: name,
+	}
+	if spec, ok := secret.GetAnnotations()[service.BindingSpecAnnotation]; ok {
+		err := json.Unmarshal([]byte(spec), &result.Spec)
+		if err != nil {
+			return api.ServiceBinding{}, fmt.Errorf("invalid annotation %q on secret %q: %w", service.BindingSpecAnnotation, secret.GetName(), err)
+		}
+	} else {
+		// secret created by a previous version, the spec is partially known from the labels
+		result.Spec.Services = []api.ServiceBindingReference{{
+			Kind: secret.GetLabels()[service.ServiceKind],
+			Name: strings.TrimPrefix(secret.GetLabels()[service.ServiceLabel], secret.GetLabels()[service.ServiceKind]+"-"),
+		}}
+	}
+	result.Status = statusFromLinkSecret(name, result.Spec.BindAsFiles, secret)
+	return setRunningMode(result, labels.GetMode(secret.GetLabels())), nil
+}
+
+// getBindingWithoutOperator returns information about a binding processed by the Service Binding library
+func (o *BindingClient) getBindingWithoutOperator(name string) (api.ServiceBinding, error) {
+	secret, err := o.getLinkSecret(name)
+	if err != nil {
+		return api.ServiceBinding{}, err
+	}
+	if secret == nil {
+		return api.ServiceBinding{}, kerrors.NewNotFound(schema.GroupResource{Resource: "servicebindings"}, name)
+	}
+	return bindingFromLinkSecret(*secret)
+}
+
+// listBindingsWithoutOperator returns the bindings processed by the Service Binding library in the current namespace
+func (o *BindingClient) listBindingsWithoutOperator() ([]api.ServiceBinding, error) {
+	secrets, err := o.kubernetesClient.ListSecrets(service.LinkLabel)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]api.ServiceBinding, 0, len(secrets))
+	for _, secret := range secrets {
+		if !service.IsLinkSecret(secret.GetLabels()) {
+			continue
+		}
+		sb, err := bindingFromLinkSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, sb)
+	}
+	return result, nil
+}
@@ -49,6 +49,7 @@ func ApplyKubernetes(
 	// Retrieve the component type from the devfile and also inject it into the list of annotations
 	annotations := make(map[string]string)
 	astralabels.SetProjectType(annotations, GetComponentTypeFromDevfileMetadata(devfile.Data.GetMetadata()))
+	astralabels.SetDevfileName(annotations, devfile.Data.GetMetadata().Name)
 
 	// Get the Kubernetes component
 	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(devfile, kubernetes.Name, path, devfilefs.DefaultFs{})
@@ -108,10 +108,12 @@ func Log(platformClient platform.Client, componentName string, appName string, f
 // We then return a list of "components" intended for listing / output purposes specifically for commands such as:
 // `astra list`
 // that are both astra and non-astra components.
-func ListAllClusterComponents(client kclient.ClientInterface, namespace string) ([]api.ComponentAbstract, error) {
+//
+// Only the resources matching the label selector are considered, if not empty.
+func ListAllClusterComponents(client kclient.ClientInterface, namespace string, selector string) ([]api.ComponentAbstract, error) {
 
 	// Get all the dynamic resources available
-	resourceList, err := client.GetAllResourcesFromSelector("", namespace)
+	resourceList, err := client.GetAllResourcesFromSelector(selector, namespace)
 	if err != nil {
 		return nil, fmt.Errorf("unable to list all dynamic resources required to find components: %w", err)
 	}
@@ -168,8 +170,16 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 			Type:             componentType,
 			ManagedByVersion: managedByVersion,
 			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
-			RunningOn: commonflags.PlatformCluster,
-			Platform:  commonflags.PlatformCluster,
+			RunningOn:   commonflags.PlatformCluster,
+			Platform:    commonflags.PlatformCluster,
+			DevfileName: astralabels.GetDevfileName(labels, annotations),
+		}
+		created, lastModified := getResourceTimes(resource)
+		if !created.IsZero() {
+			component.Created = &created
+		}
+		if !lastModified.IsZero() {
+			component.LastModified = &lastModified
 		}
 		mode := astralabels.GetMode(labels)
 		componentFound := false
@@ -188,6 +198,15 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 				if otherCompo.ManagedBy == api.TypeUnknown && component.ManagedBy != api.TypeUnknown {
 					components[v].ManagedBy = component.ManagedBy
 				}
+				if otherCompo.DevfileName == "" {
+					components[v].DevfileName = component.DevfileName
+				}
+				if component.Created != nil && (otherCompo.Created == nil || component.Created.Before(*otherCompo.Created)) {
+					components[v].Created = component.Created
+				}
+				if component.LastModified != nil && (otherCompo.LastModified == nil || component.LastModified.After(*otherCompo.LastModified)) {
+					components[v].LastModified = component.LastModified
+				}
 			}
 		}
 		if !componentFound {
@@ -204,13 +223,23 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 	return components, nil
 }
 
-func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, namespace string, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
+// ListAllComponents returns the components running on the cluster and on Podman matching the filter,
+// and the component defined in the Devfile, if any, when only the namespace is specified in the filter.
+func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, filter ListFilter, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
 	var (
 		allComponents []api.ComponentAbstract
 	)
 
 	if client != nil {
-		clusterComponents, err := ListAllClusterComponents(client, namespace)
+		var (
+			clusterComponents []api.ComponentAbstract
+			err               error
+		)
+		if filter.AllNamespaces {
+			clusterComponents, err = ListAllClusterComponentsFromAllNamespaces(client, filter.Selector)
+		} else {
+			clusterComponents, err = ListAllClusterComponents(client, filter.Namespace, filter.Selector)
+		}
 		if err != nil {
 			return nil, "", err
 		}
@@ -219,13 +248,18 @@ func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Clien
 
 	// PdomanClient can be nil if podman platform is not accessible
 	if podmanClient != nil {
-		podmanComponents, err := podmanClient.ListAllComponents()
+		podmanComponents, err := podmanClient.ListAllComponents(filter.Selector)
 		if err != nil {
 			return nil, "", err
 		}
 		allComponents = append(allComponents, podmanComponents...)
 	}
 
+	if !filter.IsNamespaceOnly() {
+		// The component defined in the Devfile is not displayed when listing a selection of the running components
+		return filter.apply(allComponents), "", nil
+	}
+
 	localComponent := api.ComponentAbstract{
 		Name:      componentName,
 		ManagedBy: "",
@@ -174,7 +174,7 @@ func TestListAllClusterComponents(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			got, err := ListAllClusterComponents(tt.fields.kubeClient(ctrl), tt.args.namespace)
+			got, err := ListAllClusterComponents(tt.fields.kubeClient(ctrl), tt.args.namespace, "")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ListAllClusterComponents error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -185,6 +185,24 @@ func (do DeleteComponentClient) ListClusterResourcesToDeleteFromDevfile(devfileO
 
 // ExecutePreStopEvents executes preStop events if any, as a precondition to deleting a devfile component deployment
 func (do *DeleteComponentClient) ExecutePreStopEvents(ctx context.Context, devfileObj parser.DevfileObj, appName string, componentName string) error {
+	return executePreStopEvents(ctx, do.kubeClient, do.execClient, do.configAutomountClient, devfileObj, appName, componentName)
+}
+
+// ExecutePodmanPreStopEvents executes preStop events if any, as a precondition to deleting the pod of a devfile component from podman
+func (do *DeleteComponentClient) ExecutePodmanPreStopEvents(ctx context.Context, devfileObj parser.DevfileObj, appName string, componentName string) error {
+	// the exec client of the clientset executes commands on the platform selected by the user, which may be the cluster
+	return executePreStopEvents(ctx, do.podmanClient, exec.NewExecClient(do.podmanClient), nil, devfileObj, appName, componentName)
+}
+
+func executePreStopEvents(
+	ctx context.Context,
+	platformClient platform.Client,
+	execClient exec.Client,
+	configAutomountClient configAutomount.Client,
+	devfileObj parser.DevfileObj,
+	appName string,
+	componentName string,
+) error {
 	if !libdevfile.HasPreStopEvents(devfileObj) {
 		return nil
 	}
@@ -193,7 +211,7 @@ func (do *DeleteComponentClient) ExecutePreStopEvents(ctx context.Context, devfi
 
 	klog.V(3).Infof("Checking component status for %q", componentName)
 	selector := astralabels.GetSelector(componentName, appName, astralabels.ComponentDevMode, false)
-	pod, err := do.kubeClient.GetRunningPodFromSelector(selector)
+	pod, err := platformClient.GetRunningPodFromSelector(selector)
 	if err != nil {
 		klog.V(1).Info("Component not found on the cluster.")
 
@@ -216,9 +234,9 @@ func (do *DeleteComponentClient) ExecutePreStopEvents(ctx context.Context, devfi
 	// ignore the failures if any; delete should not fail because preStop events failed to execute
 	handler := component.NewRunHandler(
 		ctx,
-		do.kubeClient,
-		do.execClient,
-		do.configAutomountClient,
+		platformClient,
+		execClient,
+		configAutomountClient,
 		// Tastra(feloy) set these values when we want to support Apply Image commands for PreStop events
 		nil, nil,
 
@@ -236,6 +254,25 @@ func (do *DeleteComponentClient) ExecutePreStopEvents(ctx context.Context, devfi
 	return nil
 }
 
+// FailedPod is a pod that failed to be deleted, with the cause of the failure
+type FailedPod struct {
+	Pod *corev1.Pod
+	Err error
+}
+
+// DeletePodmanResources deletes the pods and their volumes from podman, and returns the pods that failed to be deleted
+func (do *DeleteComponentClient) DeletePodmanResources(pods []*corev1.Pod) []FailedPod {
+	var failed []FailedPod
+	for _, pod := range pods {
+		err := do.podmanClient.CleanupPodResources(pod, true)
+		if err != nil {
+			klog.V(3).Infof("failed to delete the pod %q from podman: %v", pod.GetName(), err)
+			failed = append(failed, FailedPod{Pod: pod, Err: err})
+		}
+	}
+	return failed
+}
+
 func (do *DeleteComponentClient) ListPodmanResourcesToDelete(appName string, componentName string, mode string) (isInnerLoopDeployed bool, pods []*corev1.Pod, err error) {
 	if mode == astralabels.ComponentDeployMode {
 		return false, nil, nil
@@ -688,7 +688,7 @@ func TestDeleteComponentClient_ExecutePreStopEvents(t *testing.T) {
 					selector := astralabels.GetSelector(componentName, "app", astralabels.ComponentDevMode, false)
 					client.EXPECT().GetRunningPodFromSelector(selector).Return(astraTestingUtil.CreateFakePod(componentName, "mypod", "runtime"), nil)
 
-					cmd := []string{"/bin/sh", "-c", "cd /projects/nodejs-starter && (echo \"Hello World!\") 1>>/proc/1/fd/1 2>>/proc/1/fd/2"}
+					cmd := []string{"/bin/sh", "-c", ": > /opt/astra/.astra_cmd_runtime.log && if command -v tee >/dev/null 2>&1; then status=$( { { { cd /projects/nodejs-starter && (echo \"Hello World!\") 2>&1 1>&3 3>&- 4>&-; echo $? >&4; } | tee -a /opt/astra/.astra_cmd_runtime.log 1>>/proc/1/fd/2; } 3>&1 | tee -a /opt/astra/.astra_cmd_runtime.log 1>>/proc/1/fd/1; } 4>&1 ) && exit $status; else { cd /projects/nodejs-starter && (echo \"Hello World!\"); } 1>>/proc/1/fd/1 2>>/proc/1/fd/2; fi"}
 					client.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "mypod", cmd, gomock.Any(), gomock.Any(), nil, false).Return(nil)
 
 					return client
@@ -713,7 +713,7 @@ func TestDeleteComponentClient_ExecutePreStopEvents(t *testing.T) {
 
 					client.EXPECT().GetPodLogs(fakePod.Name, gomock.Any(), gomock.Any()).Return(nil, errors.New("an error"))
 
-					cmd := []string{"/bin/sh", "-c", "cd /projects/nodejs-starter && (echo \"Hello World!\") 1>>/proc/1/fd/1 2>>/proc/1/fd/2"}
+					cmd := []string{"/bin/sh", "-c", ": > /opt/astra/.astra_cmd_runtime.log && if command -v tee >/dev/null 2>&1; then status=$( { { { cd /projects/nodejs-starter && (echo \"Hello World!\") 2>&1 1>&3 3>&- 4>&-; echo $? >&4; } | tee -a /opt/astra/.astra_cmd_runtime.log 1>>/proc/1/fd/2; } 3>&1 | tee -a /opt/astra/.astra_cmd_runtime.log 1>>/proc/1/fd/1; } 4>&1 ) && exit $status; else { cd /projects/nodejs-starter && (echo \"Hello World!\"); } 1>>/proc/1/fd/1 2>>/proc/1/fd/2; fi"}
 					client.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "mypod", cmd, gomock.Any(), gomock.Any(), nil, false).Return(errors.New("some error"))
 
 					return client
@@ -936,3 +936,49 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 		})
 	}
 }
+
+func TestDeleteComponentClient_DeletePodmanResources(t *testing.T) {
+	pod1 := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}}
+	pod2 := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod2"}}
+	tests := []struct {
+		name         string
+		podmanClient func(ctrl *gomock.Controller) podman.Client
+		pods         []*corev1.Pod
+		want         []FailedPod
+	}{
+		{
+			name: "2 pods deleted successfully",
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				podmanCli := podman.NewMockClient(ctrl)
+				podmanCli.EXPECT().CleanupPodResources(pod1, true).Return(nil)
+				podmanCli.EXPECT().CleanupPodResources(pod2, true).Return(nil)
+				return podmanCli
+			},
+			pods: []*corev1.Pod{pod1, pod2},
+			want: nil,
+		},
+		{
+			name: "2 pods, 1 failed to be deleted",
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				podmanCli := podman.NewMockClient(ctrl)
+				podmanCli.EXPECT().CleanupPodResources(pod1, true).Return(errors.New("some error"))
+				podmanCli.EXPECT().CleanupPodResources(pod2, true).Return(nil)
+				return podmanCli
+			},
+			pods: []*corev1.Pod{pod1, pod2},
+			want: []FailedPod{{Pod: pod1, Err: errors.New("some error")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			do := &DeleteComponentClient{
+				podmanClient: tt.podmanClient(ctrl),
+			}
+			got := do.DeletePodmanResources(tt.pods)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DeleteComponentClient.DeletePodmanResources() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
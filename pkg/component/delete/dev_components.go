@@ -0,0 +1,92 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	astralabels "github\.com/danielpickens/astra/pkg/labels"
+	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+)
+
+// DevComponent is a component running in Dev mode, with the information about the session which deployed it
+type DevComponent struct {
+	Name     string
+	Platform string
+	// Namespace is the namespace in which the component is running, empty on Podman
+	Namespace string
+	// Session identifies the astra dev session which has deployed the component
+	Session astralabels.DevSession
+	// Heartbeat is the last time the session signaled it was running, zero if unknown
+	Heartbeat time.Time
+	// Created is the time the component has been deployed, zero if unknown
+	Created time.Time
+	// ClusterResources are the resources to delete on the cluster
+	ClusterResources []unstructured.Unstructured
+	// PodmanPods are the pods to delete on Podman
+	PodmanPods []*corev1.Pod
+}
+
+// ListClusterDevComponents returns the components running in Dev mode in the current namespace of the cluster
+func (do *DeleteComponentClient) ListClusterDevComponents(ctx context.Context) ([]DevComponent, error) {
+	namespace := do.kubeClient.GetCurrentNamespace()
+	deployments, err := do.kubeClient.GetDeploymentFromSelector(astralabels.GetDevModeSelector())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the components running in Dev mode on the cluster: %w", err)
+	}
+	var result []DevComponent
+	for _, deployment := range deployments {
+		name := astralabels.GetComponentName(deployment.GetLabels())
+		if name == "" {
+			continue
+		}
+		resources, err := do.ListClusterResourcesToDelete(ctx, name, namespace, astralabels.ComponentDevMode)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the resources of the component %q: %w", name, err)
+		}
+		result = append(result, DevComponent{
+			Name:             name,
+			Platform:         commonflags.PlatformCluster,
+			Namespace:        namespace,
+			Session:          astralabels.GetDevSession(deployment.GetAnnotations()),
+			Heartbeat:        astralabels.GetHeartbeat(deployment.GetAnnotations()),
+			Created:          deployment.GetCreationTimestamp().Time,
+			ClusterResources: resources,
+		})
+	}
+	return result, nil
+}
+
+// ListPodmanDevComponents returns the components running in Dev mode on Podman,
+// including the components whose pods are stopped, as they are typically abandoned
+func (do *DeleteComponentClient) ListPodmanDevComponents(ctx context.Context) ([]DevComponent, error) {
+	components, err := do.podmanClient.ListAllComponentsIncludingStopped(astralabels.GetDevModeSelector())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the components running in Dev mode on Podman: %w", err)
+	}
+	var result []DevComponent
+	for _, component := range components {
+		_, pods, err := do.ListPodmanResourcesToDelete(astracontext.GetApplication(ctx), component.Name, astralabels.ComponentDevMode)
+		if err != nil {
+			return nil, err
+		}
+		if len(pods) == 0 {
+			continue
+		}
+		devComponent := DevComponent{
+			Name:       component.Name,
+			Platform:   commonflags.PlatformPodman,
+			Session:    astralabels.GetDevSession(pods[0].GetLabels()),
+			PodmanPods: pods,
+		}
+		if component.Created != nil {
+			devComponent.Created = *component.Created
+		}
+		result = append(result, devComponent)
+	}
+	return result, nil
+}
@@ -0,0 +1,149 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/kclient"
+	astralabels "github\.com/danielpickens/astra/pkg/labels"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+	"github\.com/danielpickens/astra/pkg/podman"
+)
+
+func TestDeleteComponentClient_ListClusterDevComponents(t *testing.T) {
+	created := time.Date(2023, 6, 15, 10, 0, 0, 0, time.UTC)
+	heartbeat := created.Add(time.Hour)
+
+	annotations := map[string]string{}
+	astralabels.SetDevSession(annotations, astralabels.DevSession{Host: "laptop", PID: 1234, Directory: "/path/to/my-cmp"})
+	astralabels.SetHeartbeat(annotations, heartbeat)
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "my-cmp-app",
+			Labels:            astralabels.GetLabels("my-cmp", "app", "", astralabels.ComponentDevMode, false),
+			Annotations:       annotations,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+	oldDeployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "old-cmp-app",
+			Labels:            astralabels.GetLabels("old-cmp", "app", "", astralabels.ComponentDevMode, false),
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+	resource := getUnstructured("my-cmp-app", "Deployment", "apps/v1", "ns")
+	oldResource := getUnstructured("old-cmp-app", "Deployment", "apps/v1", "ns")
+
+	tests := []struct {
+		name       string
+		kubeClient func(ctrl *gomock.Controller) kclient.ClientInterface
+		want       []DevComponent
+		wantErr    bool
+	}{
+		{
+			name: "components with and without session information",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespace().Return("ns")
+				client.EXPECT().GetDeploymentFromSelector(astralabels.GetDevModeSelector()).
+					Return([]appsv1.Deployment{deployment, oldDeployment}, nil)
+				client.EXPECT().GetAllResourcesFromSelector(astralabels.GetSelector("my-cmp", "app", astralabels.ComponentDevMode, false), "ns").
+					Return([]unstructured.Unstructured{resource}, nil)
+				client.EXPECT().GetAllResourcesFromSelector(astralabels.GetSelector("old-cmp", "app", astralabels.ComponentDevMode, false), "ns").
+					Return([]unstructured.Unstructured{oldResource}, nil)
+				return client
+			},
+			want: []DevComponent{
+				{
+					Name:             "my-cmp",
+					Platform:         "cluster",
+					Namespace:        "ns",
+					Session:          astralabels.DevSession{Host: "laptop", PID: 1234, Directory: "/path/to/my-cmp"},
+					Heartbeat:        heartbeat,
+					Created:          created,
+					ClusterResources: []unstructured.Unstructured{resource},
+				},
+				{
+					Name:             "old-cmp",
+					Platform:         "cluster",
+					Namespace:        "ns",
+					Created:          created,
+					ClusterResources: []unstructured.Unstructured{oldResource},
+				},
+			},
+		},
+		{
+			name: "error listing deployments",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetCurrentNamespace().Return("ns")
+				client.EXPECT().GetDeploymentFromSelector(astralabels.GetDevModeSelector()).Return(nil, errors.New("forbidden"))
+				return client
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			do := NewDeleteComponentClient(tt.kubeClient(ctrl), nil, nil, nil)
+			ctx := astracontext.WithApplication(context.Background(), "app")
+			got, err := do.ListClusterDevComponents(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListClusterDevComponents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListClusterDevComponents() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteComponentClient_ListPodmanDevComponents(t *testing.T) {
+	created := time.Date(2023, 6, 15, 10, 0, 0, 0, time.UTC)
+
+	pod := corev1.Pod{}
+	pod.SetName("my-cmp-app")
+	pod.SetLabels(map[string]string{})
+	astralabels.SetDevSession(pod.GetLabels(), astralabels.DevSession{Host: "laptop", PID: 1234, Directory: "/path/to/my-cmp"})
+
+	ctrl := gomock.NewController(t)
+	podmanClient := podman.NewMockClient(ctrl)
+	podmanClient.EXPECT().ListAllComponentsIncludingStopped(astralabels.GetDevModeSelector()).Return([]api.ComponentAbstract{
+		{Name: "my-cmp", Created: &created},
+		{Name: "gone-cmp"},
+	}, nil)
+	podmanClient.EXPECT().PodLs().Return(map[string]bool{"my-cmp-app": true}, nil).Times(2)
+	podmanClient.EXPECT().KubeGenerate("my-cmp-app").Return(&pod, nil)
+
+	do := NewDeleteComponentClient(nil, podmanClient, nil, nil)
+	ctx := astracontext.WithApplication(context.Background(), "app")
+	got, err := do.ListPodmanDevComponents(ctx)
+	if err != nil {
+		t.Fatalf("ListPodmanDevComponents() unexpected error: %v", err)
+	}
+	want := []DevComponent{
+		{
+			Name:       "my-cmp",
+			Platform:   "podman",
+			Session:    astralabels.DevSession{Host: "laptop", PID: 1234, Directory: "/path/to/my-cmp"},
+			Created:    created,
+			PodmanPods: []*corev1.Pod{&pod},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListPodmanDevComponents() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -17,6 +17,10 @@ type Client interface {
 	DeleteResources(resources []unstructured.Unstructured, wait bool) []unstructured.Unstructured
 	// ExecutePreStopEvents executes preStop events if any, as a precondition to deleting a devfile component deployment
 	ExecutePreStopEvents(ctx context.Context, devfileObj parser.DevfileObj, appName string, componentName string) error
+	// ExecutePodmanPreStopEvents executes preStop events if any, as a precondition to deleting the pod of a devfile component from podman
+	ExecutePodmanPreStopEvents(ctx context.Context, devfileObj parser.DevfileObj, appName string, componentName string) error
+	// DeletePodmanResources deletes the pods and their volumes from podman, and returns the pods that failed to be deleted
+	DeletePodmanResources(pods []*corev1.Pod) []FailedPod
 	// ListClusterResourcesToDeleteFromDevfile parses all the devfile components and returns a list of resources that are present on the cluster that can be deleted,
 	// and a bool that indicates if the devfile component has been pushed to the innerloop.
 	// The mode indicates which component to list, either Dev, Deploy or Any (using constant labels.Component*Mode).
@@ -25,4 +29,10 @@ type Client interface {
 	// and a bool that indicates if the devfile component has been pushed to the innerloop.
 	// The mode indicates which component to list, either Dev, Deploy or Any (using constant labels.Component*Mode).
 	ListPodmanResourcesToDelete(appName string, componentName string, mode string) (isInnerLoopDeployed bool, pods []*corev1.Pod, err error)
+	// ListClusterDevComponents returns the components running in Dev mode in the current namespace of the cluster,
+	// with the information about the sessions which have deployed them and the resources to delete for each of them
+	ListClusterDevComponents(ctx context.Context) ([]DevComponent, error)
+	// ListPodmanDevComponents returns the components running in Dev mode on Podman, or whose pods are stopped,
+	// with the information about the sessions which have deployed them and the pods to delete for each of them
+	ListPodmanDevComponents(ctx context.Context) ([]DevComponent, error)
 }
@@ -37,6 +37,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DeletePodmanResources mocks base method.
+func (m *MockClient) DeletePodmanResources(pods []*v1.Pod) []FailedPod {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePodmanResources", pods)
+	ret0, _ := ret[0].([]FailedPod)
+	return ret0
+}
+
+// DeletePodmanResources indicates an expected call of DeletePodmanResources.
+func (mr *MockClientMockRecorder) DeletePodmanResources(pods interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePodmanResources", reflect.TypeOf((*MockClient)(nil).DeletePodmanResources), pods)
+}
+
 // DeleteResources mocks base method.
 func (m *MockClient) DeleteResources(resources []unstructured.Unstructured, wait bool) []unstructured.Unstructured {
 	m.ctrl.T.Helper()
@@ -51,6 +65,20 @@ func (mr *MockClientMockRecorder) DeleteResources(resources, wait interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResources", reflect.TypeOf((*MockClient)(nil).DeleteResources), resources, wait)
 }
 
+// ExecutePodmanPreStopEvents mocks base method.
+func (m *MockClient) ExecutePodmanPreStopEvents(ctx context.Context, devfileObj parser.DevfileObj, appName, componentName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutePodmanPreStopEvents", ctx, devfileObj, appName, componentName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecutePodmanPreStopEvents indicates an expected call of ExecutePodmanPreStopEvents.
+func (mr *MockClientMockRecorder) ExecutePodmanPreStopEvents(ctx, devfileObj, appName, componentName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutePodmanPreStopEvents", reflect.TypeOf((*MockClient)(nil).ExecutePodmanPreStopEvents), ctx, devfileObj, appName, componentName)
+}
+
 // ExecutePreStopEvents mocks base method.
 func (m *MockClient) ExecutePreStopEvents(ctx context.Context, devfileObj parser.DevfileObj, appName, componentName string) error {
 	m.ctrl.T.Helper()
@@ -96,6 +124,36 @@ func (mr *MockClientMockRecorder) ListClusterResourcesToDeleteFromDevfile(devfil
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterResourcesToDeleteFromDevfile", reflect.TypeOf((*MockClient)(nil).ListClusterResourcesToDeleteFromDevfile), devfileObj, appName, componentName, mode)
 }
 
+// ListClusterDevComponents mocks base method.
+func (m *MockClient) ListClusterDevComponents(ctx context.Context) ([]DevComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterDevComponents", ctx)
+	ret0, _ := ret[0].([]DevComponent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterDevComponents indicates an expected call of ListClusterDevComponents.
+func (mr *MockClientMockRecorder) ListClusterDevComponents(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDevComponents", reflect.TypeOf((*MockClient)(nil).ListClusterDevComponents), ctx)
+}
+
+// ListPodmanDevComponents mocks base method.
+func (m *MockClient) ListPodmanDevComponents(ctx context.Context) ([]DevComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPodmanDevComponents", ctx)
+	ret0, _ := ret[0].([]DevComponent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodmanDevComponents indicates an expected call of ListPodmanDevComponents.
+func (mr *MockClientMockRecorder) ListPodmanDevComponents(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodmanDevComponents", reflect.TypeOf((*MockClient)(nil).ListPodmanDevComponents), ctx)
+}
+
 // ListPodmanResourcesToDelete mocks base method.
 func (m *MockClient) ListPodmanResourcesToDelete(appName, componentName, mode string) (bool, []*v1.Pod, error) {
 	m.ctrl.T.Helper()
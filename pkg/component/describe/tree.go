@@ -0,0 +1,357 @@
+package describe
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"k8s.io/utils/pointer"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/kclient"
+	astralabels "github\.com/danielpickens/astra/pkg/labels"
+	"github\.com/danielpickens/astra/pkg/astra/commonflags"
+	"github\.com/danielpickens/astra/pkg/podman"
+)
+
+// maxEventsPerResource is the number of Warning events returned for each resource
+const maxEventsPerResource = 3
+
+// GetResourceTree returns the resources deployed for the component on the cluster and on Podman,
+// the resources owned by other resources being returned as their children.
+// A nil client is ignored
+func GetResourceTree(
+	componentName string,
+	appName string,
+	kubeClient kclient.ClientInterface,
+	podmanClient podman.Client,
+) ([]api.ResourceNode, error) {
+	selector := astralabels.GetSelector(componentName, appName, astralabels.ComponentAnyMode, false)
+
+	var result []api.ResourceNode
+	if kubeClient != nil {
+		nodes, err := getClusterResourceTree(kubeClient, selector)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nodes...)
+	}
+	if podmanClient != nil {
+		nodes, err := getPodmanResourceTree(podmanClient, selector)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nodes...)
+	}
+	return result, nil
+}
+
+func getClusterResourceTree(kubeClient kclient.ClientInterface, selector string) ([]api.ResourceNode, error) {
+	deployments, err := kubeClient.GetDeploymentFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := kubeClient.ListJobs(selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := kubeClient.GetPodsMatchingSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	services, err := kubeClient.ListServices(selector)
+	if err != nil {
+		return nil, err
+	}
+	pvcs, err := kubeClient.ListPVCs(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := kubeClient.ListWarningEvents()
+	if err != nil {
+		// The events are not essential, the user may not be allowed to list them
+		klog.V(2).Infof("unable to list events: %v", err)
+	}
+	eventsByUID := groupEventsByUID(events)
+
+	// The pods owned by a ReplicaSet or a Job are displayed as children of their owner
+	ownedPods := map[types.UID]bool{}
+	getPodNodes := func(owner types.UID) []api.ResourceNode {
+		var nodes []api.ResourceNode
+		for _, pod := range pods.Items {
+			if isOwnedBy(pod.OwnerReferences, owner) {
+				ownedPods[pod.UID] = true
+				nodes = append(nodes, getPodNode(pod, eventsByUID))
+			}
+		}
+		return nodes
+	}
+
+	var result []api.ResourceNode
+	for _, deployment := range deployments {
+		node := getDeploymentNode(deployment, eventsByUID)
+		replicaSets, err := kubeClient.ListReplicaSets(metav1.FormatLabelSelector(deployment.Spec.Selector))
+		if err != nil {
+			return nil, err
+		}
+		for _, replicaSet := range replicaSets {
+			if !isOwnedBy(replicaSet.OwnerReferences, deployment.UID) {
+				continue
+			}
+			// The old ReplicaSets without replicas are not displayed
+			if pointer.Int32Deref(replicaSet.Spec.Replicas, 1) == 0 && replicaSet.Status.Replicas == 0 {
+				continue
+			}
+			rsNode := getReplicaSetNode(replicaSet, eventsByUID)
+			rsNode.Children = getPodNodes(replicaSet.UID)
+			node.Children = append(node.Children, rsNode)
+		}
+		result = append(result, node)
+	}
+	for _, job := range jobs.Items {
+		node := getJobNode(job, eventsByUID)
+		node.Children = getPodNodes(job.UID)
+		result = append(result, node)
+	}
+	for _, pod := range pods.Items {
+		if !ownedPods[pod.UID] {
+			result = append(result, getPodNode(pod, eventsByUID))
+		}
+	}
+	for _, service := range services {
+		result = append(result, api.ResourceNode{
+			Kind:   "Service",
+			Name:   service.Name,
+			Status: string(service.Spec.Type),
+			Events: eventsByUID[service.UID],
+		})
+	}
+	for _, pvc := range pvcs {
+		result = append(result, api.ResourceNode{
+			Kind:   "PersistentVolumeClaim",
+			Name:   pvc.Name,
+			Status: string(pvc.Status.Phase),
+			Ready:  pointer.Bool(pvc.Status.Phase == corev1.ClaimBound),
+			Events: eventsByUID[pvc.UID],
+		})
+	}
+	result = append(result, getBindingNodes(kubeClient, selector, eventsByUID)...)
+
+	for i := range result {
+		result[i].Platform = commonflags.PlatformCluster
+	}
+	return result, nil
+}
+
+func getBindingNodes(kubeClient kclient.ClientInterface, selector string, eventsByUID map[types.UID][]api.ResourceEvent) []api.ResourceNode {
+	specBindings, bindings, err := kubeClient.ListServiceBindingsFromAllGroups()
+	if err != nil {
+		// The Service Binding Operator may not be installed
+		klog.V(2).Infof("unable to list service bindings: %v", err)
+		return nil
+	}
+	labelSelector, err := k8slabels.Parse(selector)
+	if err != nil {
+		klog.V(2).Infof("invalid selector %q: %v", selector, err)
+		return nil
+	}
+	var result []api.ResourceNode
+	for _, binding := range specBindings {
+		if labelSelector.Matches(k8slabels.Set(binding.Labels)) {
+			result = append(result, getConditionsNode("ServiceBinding", binding.Name, binding.Status.Conditions, eventsByUID[binding.UID]))
+		}
+	}
+	for _, binding := range bindings {
+		if labelSelector.Matches(k8slabels.Set(binding.Labels)) {
+			result = append(result, getConditionsNode("ServiceBinding", binding.Name, binding.Status.Conditions, eventsByUID[binding.UID]))
+		}
+	}
+	return result
+}
+
+func getConditionsNode(kind string, name string, conditions []metav1.Condition, events []api.ResourceEvent) api.ResourceNode {
+	node := api.ResourceNode{
+		Kind:   kind,
+		Name:   name,
+		Events: events,
+	}
+	for _, condition := range conditions {
+		if condition.Type == "Ready" {
+			node.Ready = pointer.Bool(condition.Status == metav1.ConditionTrue)
+			node.Status = condition.Reason
+		}
+	}
+	return node
+}
+
+func getDeploymentNode(deployment appsv1.Deployment, eventsByUID map[types.UID][]api.ResourceEvent) api.ResourceNode {
+	node := api.ResourceNode{
+		Kind:   "Deployment",
+		Name:   deployment.Name,
+		Status: fmt.Sprintf("%d/%d ready", deployment.Status.ReadyReplicas, pointer.Int32Deref(deployment.Spec.Replicas, 1)),
+		Events: eventsByUID[deployment.UID],
+	}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentAvailable {
+			node.Ready = pointer.Bool(condition.Status == corev1.ConditionTrue)
+		}
+	}
+	return node
+}
+
+func getReplicaSetNode(replicaSet appsv1.ReplicaSet, eventsByUID map[types.UID][]api.ResourceEvent) api.ResourceNode {
+	replicas := pointer.Int32Deref(replicaSet.Spec.Replicas, 1)
+	return api.ResourceNode{
+		Kind:   "ReplicaSet",
+		Name:   replicaSet.Name,
+		Status: fmt.Sprintf("%d/%d ready", replicaSet.Status.ReadyReplicas, replicas),
+		Ready:  pointer.Bool(replicaSet.Status.ReadyReplicas == replicas),
+		Events: eventsByUID[replicaSet.UID],
+	}
+}
+
+func getJobNode(job batchv1.Job, eventsByUID map[types.UID][]api.ResourceEvent) api.ResourceNode {
+	node := api.ResourceNode{
+		Kind:   "Job",
+		Name:   job.Name,
+		Status: "Running",
+		Events: eventsByUID[job.UID],
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			node.Status = "Complete"
+			node.Ready = pointer.Bool(true)
+		case batchv1.JobFailed:
+			node.Status = "Failed"
+			node.Ready = pointer.Bool(false)
+		}
+	}
+	return node
+}
+
+func getPodNode(pod corev1.Pod, eventsByUID map[types.UID][]api.ResourceEvent) api.ResourceNode {
+	node := api.ResourceNode{
+		Kind:   "Pod",
+		Name:   pod.Name,
+		Status: string(pod.Status.Phase),
+		Events: eventsByUID[pod.UID],
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			node.Ready = pointer.Bool(condition.Status == corev1.ConditionTrue)
+		}
+	}
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		containerNode := api.ResourceNode{
+			Kind:     "Container",
+			Name:     status.Name,
+			Ready:    pointer.Bool(status.Ready),
+			Restarts: status.RestartCount,
+		}
+		switch {
+		case status.State.Running != nil:
+			containerNode.Status = "Running"
+		case status.State.Waiting != nil:
+			containerNode.Status = status.State.Waiting.Reason
+			// A container waiting for a reason (CrashLoopBackOff, ImagePullBackOff, etc) is more informative than the phase of the pod
+			if status.State.Waiting.Reason != "" && status.State.Waiting.Reason != "PodInitializing" && status.State.Waiting.Reason != "ContainerCreating" {
+				node.Status = status.State.Waiting.Reason
+			}
+		case status.State.Terminated != nil:
+			containerNode.Status = status.State.Terminated.Reason
+		}
+		node.Restarts += status.RestartCount
+		node.Children = append(node.Children, containerNode)
+	}
+	return node
+}
+
+func getPodmanResourceTree(podmanClient podman.Client, selector string) ([]api.ResourceNode, error) {
+	pods, err := podmanClient.GetAllResourcesFromSelector(selector, "")
+	if err != nil {
+		return nil, err
+	}
+	var result []api.ResourceNode
+	for _, pod := range pods {
+		inspect, err := podmanClient.PodInspect(pod.GetName())
+		if err != nil {
+			// The pod has disappeared in the meantime, forget it
+			klog.V(2).Infof("unable to inspect pod %q: %v", pod.GetName(), err)
+			continue
+		}
+		node := api.ResourceNode{
+			Platform: commonflags.PlatformPodman,
+			Kind:     "Pod",
+			Name:     inspect.Name,
+			Status:   inspect.State,
+			Ready:    pointer.Bool(inspect.State == "Running"),
+		}
+		for _, container := range inspect.Containers {
+			// Names of users containers are prefixed with pod name by podman, the other ones are infra containers
+			if !strings.HasPrefix(container.Name, inspect.Name+"-") {
+				continue
+			}
+			node.Children = append(node.Children, api.ResourceNode{
+				Kind:   "Container",
+				Name:   strings.TrimPrefix(container.Name, inspect.Name+"-"),
+				Status: container.State,
+				Ready:  pointer.Bool(strings.EqualFold(container.State, "running")),
+			})
+		}
+		result = append(result, node)
+	}
+	return result, nil
+}
+
+func isOwnedBy(references []metav1.OwnerReference, owner types.UID) bool {
+	for _, reference := range references {
+		if reference.UID == owner {
+			return true
+		}
+	}
+	return false
+}
+
+// groupEventsByUID returns the last events concerning each object, the most recent first
+func groupEventsByUID(events []corev1.Event) map[types.UID][]api.ResourceEvent {
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(events[i]).After(eventTime(events[j]).Time)
+	})
+	result := map[types.UID][]api.ResourceEvent{}
+	for _, event := range events {
+		uid := event.InvolvedObject.UID
+		if len(result[uid]) >= maxEventsPerResource {
+			continue
+		}
+		result[uid] = append(result[uid], api.ResourceEvent{
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Count:         event.Count,
+			LastTimestamp: eventTime(event).Time,
+		})
+	}
+	return result
+}
+
+func eventTime(event corev1.Event) metav1.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp
+	}
+	if event.Series != nil {
+		return metav1.Time{Time: event.Series.LastObservedTime.Time}
+	}
+	return metav1.Time{Time: event.EventTime.Time}
+}
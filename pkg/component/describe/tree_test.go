@@ -0,0 +1,312 @@
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/kclient"
+	astralabels "github\.com/danielpickens/astra/pkg/labels"
+	"github\.com/danielpickens/astra/pkg/podman"
+)
+
+func TestGetResourceTree(t *testing.T) {
+	selector := astralabels.GetSelector("my-cmp", "app", astralabels.ComponentAnyMode, false)
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cmp-app", UID: "deploy-uid"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(1),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"component": "my-cmp"}},
+		},
+		Status: appsv1.DeploymentStatus{
+			ReadyReplicas: 0,
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+			},
+		},
+	}
+	replicaSet := appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-cmp-app-6d8",
+			UID:             "rs-uid",
+			OwnerReferences: []metav1.OwnerReference{{UID: "deploy-uid"}},
+		},
+		Spec:   appsv1.ReplicaSetSpec{Replicas: pointer.Int32(1)},
+		Status: appsv1.ReplicaSetStatus{Replicas: 1},
+	}
+	oldReplicaSet := appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-cmp-app-5c7",
+			UID:             "old-rs-uid",
+			OwnerReferences: []metav1.OwnerReference{{UID: "deploy-uid"}},
+		},
+		Spec: appsv1.ReplicaSetSpec{Replicas: pointer.Int32(0)},
+	}
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-cmp-app-6d8-x2x",
+			UID:             "pod-uid",
+			OwnerReferences: []metav1.OwnerReference{{UID: "rs-uid"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "runtime",
+					RestartCount: 4,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+				},
+			},
+		},
+	}
+	jobPod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-cmp-build-abc",
+			UID:             "job-pod-uid",
+			OwnerReferences: []metav1.OwnerReference{{UID: "job-uid"}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+	}
+	job := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cmp-build", UID: "job-uid"},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+	service := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cmp-app", UID: "svc-uid"},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+	}
+	pvc := corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cmp-app-cache", UID: "pvc-uid"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+	}
+	events := []corev1.Event{
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "pod-uid"},
+			Reason:         "Pulled",
+			Message:        "older event",
+			Count:          1,
+			LastTimestamp:  metav1.NewTime(now.Add(-4 * time.Minute)),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "pod-uid"},
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			Count:          5,
+			LastTimestamp:  metav1.NewTime(now),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "pod-uid"},
+			Reason:         "Unhealthy",
+			Message:        "Liveness probe failed",
+			Count:          2,
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "pod-uid"},
+			Reason:         "Failed",
+			Message:        "Error: failed to start container",
+			Count:          1,
+			LastTimestamp:  metav1.NewTime(now.Add(-2 * time.Minute)),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "pvc-uid"},
+			Reason:         "ProvisioningFailed",
+			Message:        "storageclass not found",
+			Count:          1,
+			LastTimestamp:  metav1.NewTime(now),
+		},
+	}
+
+	tests := []struct {
+		name         string
+		kubeClient   func(ctrl *gomock.Controller) kclient.ClientInterface
+		podmanClient func(ctrl *gomock.Controller) podman.Client
+		want         []api.ResourceNode
+		wantErr      bool
+	}{
+		{
+			name: "no client",
+		},
+		{
+			name: "resources on cluster",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetDeploymentFromSelector(selector).Return([]appsv1.Deployment{deployment}, nil)
+				client.EXPECT().ListJobs(selector).Return(&batchv1.JobList{Items: []batchv1.Job{job}}, nil)
+				client.EXPECT().GetPodsMatchingSelector(selector).Return(&corev1.PodList{Items: []corev1.Pod{pod, jobPod}}, nil)
+				client.EXPECT().ListServices(selector).Return([]corev1.Service{service}, nil)
+				client.EXPECT().ListPVCs(selector).Return([]corev1.PersistentVolumeClaim{pvc}, nil)
+				client.EXPECT().ListWarningEvents().Return(events, nil)
+				client.EXPECT().ListReplicaSets("component=my-cmp").Return([]appsv1.ReplicaSet{replicaSet, oldReplicaSet}, nil)
+				client.EXPECT().ListServiceBindingsFromAllGroups().Return(nil, nil, errors.New("no kind ServiceBinding"))
+				return client
+			},
+			want: []api.ResourceNode{
+				{
+					Platform: "cluster",
+					Kind:     "Deployment",
+					Name:     "my-cmp-app",
+					Status:   "0/1 ready",
+					Ready:    pointer.Bool(false),
+					Children: []api.ResourceNode{
+						{
+							Kind:   "ReplicaSet",
+							Name:   "my-cmp-app-6d8",
+							Status: "0/1 ready",
+							Ready:  pointer.Bool(false),
+							Children: []api.ResourceNode{
+								{
+									Kind:     "Pod",
+									Name:     "my-cmp-app-6d8-x2x",
+									Status:   "CrashLoopBackOff",
+									Ready:    pointer.Bool(false),
+									Restarts: 4,
+									Events: []api.ResourceEvent{
+										{Reason: "BackOff", Message: "Back-off restarting failed container", Count: 5, LastTimestamp: now},
+										{Reason: "Unhealthy", Message: "Liveness probe failed", Count: 2, LastTimestamp: now.Add(-time.Minute)},
+										{Reason: "Failed", Message: "Error: failed to start container", Count: 1, LastTimestamp: now.Add(-2 * time.Minute)},
+									},
+									Children: []api.ResourceNode{
+										{
+											Kind:     "Container",
+											Name:     "runtime",
+											Status:   "CrashLoopBackOff",
+											Ready:    pointer.Bool(false),
+											Restarts: 4,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Platform: "cluster",
+					Kind:     "Job",
+					Name:     "my-cmp-build",
+					Status:   "Complete",
+					Ready:    pointer.Bool(true),
+					Children: []api.ResourceNode{
+						{
+							Kind:   "Pod",
+							Name:   "my-cmp-build-abc",
+							Status: "Succeeded",
+						},
+					},
+				},
+				{
+					Platform: "cluster",
+					Kind:     "Service",
+					Name:     "my-cmp-app",
+					Status:   "ClusterIP",
+				},
+				{
+					Platform: "cluster",
+					Kind:     "PersistentVolumeClaim",
+					Name:     "my-cmp-app-cache",
+					Status:   "Pending",
+					Ready:    pointer.Bool(false),
+					Events: []api.ResourceEvent{
+						{Reason: "ProvisioningFailed", Message: "storageclass not found", Count: 1, LastTimestamp: now},
+					},
+				},
+			},
+		},
+		{
+			name: "error listing deployments",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().GetDeploymentFromSelector(selector).Return(nil, errors.New("forbidden"))
+				return client
+			},
+			wantErr: true,
+		},
+		{
+			name: "resources on podman",
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				client := podman.NewMockClient(ctrl)
+				podResource := unstructured.Unstructured{}
+				podResource.SetName("my-cmp-app")
+				goneResource := unstructured.Unstructured{}
+				goneResource.SetName("gone")
+				client.EXPECT().GetAllResourcesFromSelector(selector, "").Return([]unstructured.Unstructured{podResource, goneResource}, nil)
+				client.EXPECT().PodInspect("my-cmp-app").Return(podman.PodInspectData{
+					Name:  "my-cmp-app",
+					State: "Degraded",
+					Containers: []podman.InspectPodContainerInfo{
+						{ID: "1", Name: "abcdef-infra", State: "running"},
+						{ID: "2", Name: "my-cmp-app-runtime", State: "running"},
+						{ID: "3", Name: "my-cmp-app-tools", State: "exited"},
+					},
+				}, nil)
+				client.EXPECT().PodInspect("gone").Return(podman.PodInspectData{}, errors.New("no such pod"))
+				return client
+			},
+			want: []api.ResourceNode{
+				{
+					Platform: "podman",
+					Kind:     "Pod",
+					Name:     "my-cmp-app",
+					Status:   "Degraded",
+					Ready:    pointer.Bool(false),
+					Children: []api.ResourceNode{
+						{
+							Kind:   "Container",
+							Name:   "runtime",
+							Status: "running",
+							Ready:  pointer.Bool(true),
+						},
+						{
+							Kind:   "Container",
+							Name:   "tools",
+							Status: "exited",
+							Ready:  pointer.Bool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			var kubeClient kclient.ClientInterface
+			if tt.kubeClient != nil {
+				kubeClient = tt.kubeClient(ctrl)
+			}
+			var podmanClient podman.Client
+			if tt.podmanClient != nil {
+				podmanClient = tt.podmanClient(ctrl)
+			}
+			got, err := GetResourceTree("my-cmp", "app", kubeClient, podmanClient)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetResourceTree() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetResourceTree() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
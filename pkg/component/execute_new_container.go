@@ -92,6 +92,7 @@ func ExecuteInNewContainer(
 	job.Annotations = map[string]string{}
 	astralabels.AddCommonAnnotations(job.Annotations)
 	astralabels.SetProjectType(job.Annotations, GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
+	astralabels.SetDevfileName(job.Annotations, devfileObj.Data.GetMetadata().Name)
 
 	//	Make sure there are no existing jobs
 	checkAndDeleteExistingJob := func() {
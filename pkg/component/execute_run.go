@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -19,59 +20,85 @@ import (
 
 const numberOfLinesToOutputLog = 100
 
+// Attributes of a run or debug command defining how its process is stopped before being restarted
+const (
+	// StopSignalAttribute is the signal sent to stop the process (e.g. INT or SIGINT), TERM by default
+	StopSignalAttribute = "dev.astra.stop.signal"
+	// StopTimeoutAttribute is the duration to wait for the process to exit after the stop signal, before killing it (e.g. 30s)
+	StopTimeoutAttribute = "dev.astra.stop.timeout"
+	// PreStopExecAttribute is a command-line executed in the container before sending the stop signal
+	PreStopExecAttribute = "dev.astra.stop.exec"
+)
+
+// supportedStopSignals are the signals which can be defined with the StopSignalAttribute attribute
+var supportedStopSignals = []string{"TERM", "INT", "QUIT", "HUP", "USR1", "USR2", "KILL"}
+
 // ExecuteRunCommand executes a Devfile command in the specified pod
 // If componentExists, the previous instance of the command will be stopped before (if hotReloadCapable is not set)
-func ExecuteRunCommand(ctx context.Context, execClient exec.Client, platformClient platform.Client, devfileCmd devfilev1.Command, componentExists bool, podName string, appName string, componentName string) error {
+// The process of the command is supervised by supervisor, if not nil
+func ExecuteRunCommand(ctx context.Context, execClient exec.Client, platformClient platform.Client, devfileCmd devfilev1.Command, componentExists bool, podName string, appName string, componentName string, supervisor *RunSupervisor) error {
 	remoteProcessHandler := remotecmd.NewKubeExecProcessHandler(execClient)
 
-	statusHandlerFunc := func(s *log.Status) remotecmd.CommandOutputHandler {
+	statusHandlerFunc := func(s *log.Status, exited func(exitCode int)) remotecmd.CommandOutputHandler {
 		return func(status remotecmd.RemoteProcessStatus, stdout []string, stderr []string, err error) {
 			switch status {
 			case remotecmd.Starting:
 				// Creating with no spin because the command could be long-running, and we cannot determine when it will end.
 				s.Start(fmt.Sprintf("Executing the application (command: %s)", devfileCmd.Id), true)
 			case remotecmd.Stopped, remotecmd.Errored:
-				s.EndWithStatus(fmt.Sprintf("Finished executing the application (command: %s)", devfileCmd.Id), status == remotecmd.Stopped)
+				exitCode := 0
+				var exitErr *remotecmd.ExitError
+				if errors.As(err, &exitErr) {
+					exitCode = exitErr.ExitCode
+					err = nil
+				} else if status == remotecmd.Errored {
+					exitCode = -1
+				}
+				msg := fmt.Sprintf("Finished executing the application (command: %s)", devfileCmd.Id)
+				if exitCode > 0 {
+					msg = fmt.Sprintf("Finished executing the application (command: %s, exit code: %d)", devfileCmd.Id, exitCode)
+				}
+				s.EndWithStatus(msg, status == remotecmd.Stopped)
 				if err != nil {
 					klog.V(2).Infof("error while running background command: %v", err)
 				}
+				exited(exitCode)
 			}
 		}
 	}
 
-	// Spinner created but not started yet.
-	// It will be displayed when the statusHandlerFunc function is called with the "Starting" state.
-	spinner := log.NewStatus(log.GetStdout())
+	cmdDef, err := devfileCommandToRemoteCmdDefinition(devfileCmd)
+	if err != nil {
+		return err
+	}
+
+	start := func(exited func(exitCode int)) error {
+		// Spinner created but not started yet.
+		// It will be displayed when the statusHandlerFunc function is called with the "Starting" state.
+		spinner := log.NewStatus(log.GetStdout())
+		return remoteProcessHandler.StartProcessForCommand(ctx, cmdDef, podName, devfileCmd.Exec.Component, statusHandlerFunc(spinner, exited))
+	}
 
 	// if we need to restart, issue the remote process handler command to stop all running commands first.
 	// We do not need to restart Hot reload capable commands.
 	if componentExists {
-		if devfileCmd.Exec == nil || !util.SafeGetBool(devfileCmd.Exec.HotReloadCapable) {
+		if !util.SafeGetBool(devfileCmd.Exec.HotReloadCapable) {
 			klog.V(2).Infof("restart required for command %s", devfileCmd.Id)
 
-			cmdDef, err := devfileCommandToRemoteCmdDefinition(devfileCmd)
-			if err != nil {
-				return err
-			}
-
+			supervisor.Stopping(devfileCmd)
 			err = remoteProcessHandler.StopProcessForCommand(ctx, cmdDef, podName, devfileCmd.Exec.Component)
 			if err != nil {
 				return err
 			}
 
-			if err = remoteProcessHandler.StartProcessForCommand(ctx, cmdDef, podName, devfileCmd.Exec.Component, statusHandlerFunc(spinner)); err != nil {
+			if err = supervisor.Supervise(ctx, devfileCmd, start); err != nil {
 				return err
 			}
 		} else {
 			klog.V(2).Infof("command is hot-reload capable, not restarting %s", devfileCmd.Id)
 		}
 	} else {
-		cmdDef, err := devfileCommandToRemoteCmdDefinition(devfileCmd)
-		if err != nil {
-			return err
-		}
-
-		if err := remoteProcessHandler.StartProcessForCommand(ctx, cmdDef, podName, devfileCmd.Exec.Component, statusHandlerFunc(spinner)); err != nil {
+		if err = supervisor.Supervise(ctx, devfileCmd, start); err != nil {
 			return err
 		}
 	}
@@ -86,7 +113,7 @@ func ExecuteRunCommand(ctx context.Context, execClient exec.Client, platformClie
 		totalWaitTime += s.Seconds()
 	}
 
-	_, err := task.NewRetryable(fmt.Sprintf("process for command %q", devfileCmd.Id), func() (bool, interface{}, error) {
+	_, err = task.NewRetryable(fmt.Sprintf("process for command %q", devfileCmd.Id), func() (bool, interface{}, error) {
 		klog.V(4).Infof("checking if process for command %q is running", devfileCmd.Id)
 		remoteProcess, err := remoteProcessHandler.GetProcessInfoForCommand(ctx, remotecmd.CommandDefinition{Id: devfileCmd.Id}, podName, devfileCmd.Exec.Component)
 		if err != nil {
@@ -116,12 +143,63 @@ func devfileCommandToRemoteCmdDefinition(devfileCmd devfilev1.Command) (remotecm
 		envVars = append(envVars, remotecmd.CommandEnvVar{Key: e.Name, Value: e.Value})
 	}
 
-	return remotecmd.CommandDefinition{
+	cmdDef := remotecmd.CommandDefinition{
 		Id:         devfileCmd.Id,
 		WorkingDir: devfileCmd.Exec.WorkingDir,
 		EnvVars:    envVars,
 		CmdLine:    devfileCmd.Exec.CommandLine,
-	}, nil
+	}
+	if err := setStopOptions(&cmdDef, devfileCmd); err != nil {
+		return remotecmd.CommandDefinition{}, err
+	}
+	return cmdDef, nil
+}
+
+// setStopOptions sets the options to stop the process of the command, from the StopSignalAttribute,
+// StopTimeoutAttribute and PreStopExecAttribute attributes of the Devfile command
+func setStopOptions(cmdDef *remotecmd.CommandDefinition, devfileCmd devfilev1.Command) error {
+	var err error
+	attributes := devfileCmd.Attributes
+	if attributes.Exists(StopSignalAttribute) {
+		value := attributes.GetString(StopSignalAttribute, &err)
+		if err != nil {
+			return fmt.Errorf("invalid value for attribute %q of command %q: %w", StopSignalAttribute, devfileCmd.Id, err)
+		}
+		signal := strings.TrimPrefix(strings.ToUpper(value), "SIG")
+		if !isSupportedStopSignal(signal) {
+			return fmt.Errorf("invalid value %q for attribute %q of command %q, must be one of %s",
+				value, StopSignalAttribute, devfileCmd.Id, strings.Join(supportedStopSignals, ", "))
+		}
+		cmdDef.StopSignal = signal
+	}
+	if attributes.Exists(StopTimeoutAttribute) {
+		value := attributes.GetString(StopTimeoutAttribute, &err)
+		if err != nil {
+			return fmt.Errorf("invalid value for attribute %q of command %q: %w", StopTimeoutAttribute, devfileCmd.Id, err)
+		}
+		timeout, parseErr := time.ParseDuration(value)
+		if parseErr != nil || timeout <= 0 {
+			return fmt.Errorf("invalid value %q for attribute %q of command %q, must be a positive duration (e.g. 30s)",
+				value, StopTimeoutAttribute, devfileCmd.Id)
+		}
+		cmdDef.StopTimeout = timeout
+	}
+	if attributes.Exists(PreStopExecAttribute) {
+		cmdDef.PreStopCmdLine = attributes.GetString(PreStopExecAttribute, &err)
+		if err != nil {
+			return fmt.Errorf("invalid value for attribute %q of command %q: %w", PreStopExecAttribute, devfileCmd.Id, err)
+		}
+	}
+	return nil
+}
+
+func isSupportedStopSignal(signal string) bool {
+	for _, supported := range supportedStopSignals {
+		if signal == supported {
+			return true
+		}
+	}
+	return false
 }
 
 // checkRemoteCommandStatus checks if the command is running .
@@ -11,6 +11,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/log"
 	"github\.com/danielpickens/astra/pkg/machineoutput"
 	"github\.com/danielpickens/astra/pkg/platform"
+	"github\.com/danielpickens/astra/pkg/remotecmd"
 	"github\.com/danielpickens/astra/pkg/util"
 	"k8s.io/klog"
 	"k8s.io/utils/pointer"
@@ -90,21 +91,19 @@ func getCmdline(command v1alpha2.Command, redirectToPid1 bool) []string {
 	}
 
 	// Change to the workdir and execute the command
-	// Redirecting to /proc/1/fd/* allows to redirect the process output to the output streams of PID 1 process inside the container.
-	// This way, returning the container logs with 'astra logs' or 'kubectl logs' would work seamlessly.
-	// See https://stackoverflow.com/questions/58716574/where-exactly-do-the-logs-of-kubernetes-pods-come-from-at-the-container-level
-	redirectString := ""
-	if redirectToPid1 {
-		redirectString = "1>>/proc/1/fd/1 2>>/proc/1/fd/2"
-	}
-	var cmd []string
+	cmdLine = "(" + cmdLine + ")"
 	if command.Exec.WorkingDir != "" {
 		// since we are using /bin/sh -c, the command needs to be within a single double quote instance, for example "cd /tmp && pwd"
-		cmd = []string{ShellExecutable, "-c", "cd " + command.Exec.WorkingDir + " && (" + cmdLine + ") " + redirectString}
-	} else {
-		cmd = []string{ShellExecutable, "-c", "(" + cmdLine + ") " + redirectString}
+		cmdLine = "cd " + command.Exec.WorkingDir + " && " + cmdLine
+	}
+	if redirectToPid1 {
+		// Redirecting to /proc/1/fd/* allows to redirect the process output to the output streams of PID 1 process inside the container.
+		// This way, returning the container logs with 'astra logs' or 'kubectl logs' would work seamlessly.
+		// See https://stackoverflow.com/questions/58716574/where-exactly-do-the-logs-of-kubernetes-pods-come-from-at-the-container-level
+		// The output is also written to the output file of the command, to be able to get the output of this command only.
+		cmdLine = remotecmd.GetTerminatingCommandLine(remotecmd.CommandDefinition{Id: command.Id}, cmdLine)
 	}
-	return cmd
+	return []string{ShellExecutable, "-c", cmdLine}
 }
 
 func closeWriterAndWaitForAck(stdoutWriter *io.PipeWriter, stdoutChannel chan interface{}, stderrWriter *io.PipeWriter, stderrChannel chan interface{}) {
@@ -2,6 +2,8 @@ package component
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -16,10 +18,12 @@ import (
 	astralabels "github\.com/danielpickens/astra/pkg/labels"
 	"github\.com/danielpickens/astra/pkg/libdevfile"
 	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/astra/commonflags"
 	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
 	"github\.com/danielpickens/astra/pkg/platform"
 	"github\.com/danielpickens/astra/pkg/remotecmd"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+	"github\.com/danielpickens/astra/pkg/tracing"
 )
 
 type runHandler struct {
@@ -32,6 +36,7 @@ type runHandler struct {
 	containersRunning     []string
 	msg                   string
 	directRun             bool
+	supervisor            *RunSupervisor
 
 	fs           filesystem.Filesystem
 	imageBackend image.Backend
@@ -41,6 +46,8 @@ type runHandler struct {
 }
 
 var _ libdevfile.Handler = (*runHandler)(nil)
+var _ libdevfile.OutputsChecker = (*runHandler)(nil)
+var _ libdevfile.TargetIdentifier = (*runHandler)(nil)
 
 type HandlerOptions struct {
 	PodName           string
@@ -48,6 +55,8 @@ type HandlerOptions struct {
 	ContainersRunning []string
 	Msg               string
 	DirectRun         bool
+	// Supervisor supervises the processes of the run and debug commands, if not nil
+	Supervisor *RunSupervisor
 
 	// For apply Kubernetes / Openshift
 	Devfile parser.DevfileObj
@@ -77,6 +86,7 @@ func NewRunHandler(
 		containersRunning:     options.ContainersRunning,
 		msg:                   options.Msg,
 		directRun:             options.DirectRun,
+		supervisor:            options.Supervisor,
 
 		fs:           fs,
 		imageBackend: imageBackend,
@@ -90,11 +100,13 @@ func (a *runHandler) ApplyImage(img devfilev1.Component) error {
 	return image.BuildPushSpecificImage(a.ctx, a.imageBackend, a.fs, img, envcontext.GetEnvConfig(a.ctx).PushImages)
 }
 
-func (a *runHandler) ApplyKubernetes(kubernetes devfilev1.Component, kind v1alpha2.CommandGroupKind) error {
+func (a *runHandler) ApplyKubernetes(kubernetes devfilev1.Component, kind v1alpha2.CommandGroupKind) (err error) {
 	var (
 		componentName = astracontext.GetComponentName(a.ctx)
 		appName       = astracontext.GetApplication(a.ctx)
 	)
+	_, span := tracing.Start(a.ctx, "apply kubernetes component", tracing.String("astra.component", kubernetes.Name))
+	defer span.End(&err)
 	mode := astralabels.ComponentDevMode
 	if kind == v1alpha2.DeployCommandGroupKind {
 		mode = astralabels.ComponentDeployMode
@@ -113,13 +125,15 @@ func (a *runHandler) ApplyOpenShift(openshift devfilev1.Component, kind v1alpha2
 	return a.ApplyKubernetes(openshift, kind)
 }
 
-func (a *runHandler) ExecuteNonTerminatingCommand(ctx context.Context, command devfilev1.Command) error {
+func (a *runHandler) ExecuteNonTerminatingCommand(ctx context.Context, command devfilev1.Command) (err error) {
 	var (
 		componentName = astracontext.GetComponentName(a.ctx)
 		appName       = astracontext.GetApplication(a.ctx)
 	)
+	ctx, span := tracing.Start(ctx, "exec command", tracing.String("astra.command", command.Id))
+	defer span.End(&err)
 	if isContainerRunning(command.Exec.Component, a.containersRunning) {
-		return ExecuteRunCommand(ctx, a.execClient, a.platformClient, command, a.ComponentExists, a.podName, appName, componentName)
+		return ExecuteRunCommand(ctx, a.execClient, a.platformClient, command, a.ComponentExists, a.podName, appName, componentName, a.supervisor)
 	}
 	switch platform := a.platformClient.(type) {
 	case kclient.ClientInterface:
@@ -131,11 +145,13 @@ func (a *runHandler) ExecuteNonTerminatingCommand(ctx context.Context, command d
 	}
 }
 
-func (a *runHandler) ExecuteTerminatingCommand(ctx context.Context, command devfilev1.Command) error {
+func (a *runHandler) ExecuteTerminatingCommand(ctx context.Context, command devfilev1.Command) (err error) {
 	var (
 		componentName = astracontext.GetComponentName(a.ctx)
 		appName       = astracontext.GetApplication(a.ctx)
 	)
+	ctx, span := tracing.Start(ctx, "exec command", tracing.String("astra.command", command.Id))
+	defer span.End(&err)
 	if isContainerRunning(command.Exec.Component, a.containersRunning) {
 		return ExecuteTerminatingCommand(ctx, a.execClient, a.platformClient, command, a.ComponentExists, a.podName, appName, componentName, a.msg, a.directRun)
 	}
@@ -159,6 +175,52 @@ func (a *runHandler) IsRemoteProcessForCommandRunning(ctx context.Context, comma
 	return remoteProcess.Status == remotecmd.Running, nil
 }
 
+// OutputsExist returns true if all the outputs of the command exist in its container, relative to the working directory of the command
+func (a *runHandler) OutputsExist(ctx context.Context, command devfilev1.Command, outputs []string) (bool, error) {
+	if command.Exec == nil || !isContainerRunning(command.Exec.Component, a.containersRunning) {
+		return false, nil
+	}
+	tests := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		tests = append(tests, fmt.Sprintf("test -e %q", output))
+	}
+	cmdLine := strings.Join(tests, " && ")
+	if command.Exec.WorkingDir != "" {
+		cmdLine = fmt.Sprintf("cd %s && %s", command.Exec.WorkingDir, cmdLine)
+	}
+	_, _, err := a.execClient.ExecuteCommand(ctx, []string{remotecmd.ShellExecutable, "-c", cmdLine}, a.podName, command.Exec.Component, false, nil, nil)
+	if err != nil {
+		klog.V(4).Infof("outputs of command %q not found: %v", command.Id, err)
+		return false, nil
+	}
+	return true, nil
+}
+
+// GetTargetId returns an identifier of the platform, of the pod and of the container executing the command
+func (a *runHandler) GetTargetId(ctx context.Context, command devfilev1.Command) (string, error) {
+	var (
+		componentName = astracontext.GetComponentName(a.ctx)
+		appName       = astracontext.GetApplication(a.ctx)
+	)
+	if command.Exec == nil || !isContainerRunning(command.Exec.Component, a.containersRunning) {
+		return "", fmt.Errorf("command %q is not executed in a running container", command.Id)
+	}
+	platformName := commonflags.PlatformPodman
+	if _, ok := a.platformClient.(kclient.ClientInterface); ok {
+		platformName = commonflags.PlatformCluster
+	}
+	pod, err := a.platformClient.GetRunningPodFromSelector(astralabels.GetSelector(componentName, appName, astralabels.ComponentDevMode, false))
+	if err != nil {
+		return "", err
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == command.Exec.Component && status.ContainerID != "" {
+			return fmt.Sprintf("%s/%s/%s", platformName, pod.GetUID(), status.ContainerID), nil
+		}
+	}
+	return "", fmt.Errorf("container %q not found in pod %q", command.Exec.Component, pod.GetName())
+}
+
 func isContainerRunning(container string, containers []string) bool {
 	for _, cnt := range containers {
 		if container == cnt {
@@ -0,0 +1,92 @@
+package component
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/kclient"
+)
+
+// ListFilter selects the components returned by ListAllComponents
+type ListFilter struct {
+	// Namespace in which the components are searched on the cluster, ignored if AllNamespaces is true
+	Namespace string
+	// AllNamespaces searches the components in all the namespaces the user has access to
+	AllNamespaces bool
+	// Selector is a label selector the resources of the components must match
+	Selector string
+	// Mode selects the components running in this mode (dev or deploy)
+	Mode string
+	// ModifiedBefore selects the components whose resources have not been modified since this time
+	ModifiedBefore time.Time
+}
+
+// IsNamespaceOnly returns true if the filter only restricts the namespace of the components
+func (o ListFilter) IsNamespaceOnly() bool {
+	return !o.AllNamespaces && o.Selector == "" && o.Mode == "" && o.ModifiedBefore.IsZero()
+}
+
+// apply returns the components running in the mode and not modified since the time set in the filter
+func (o ListFilter) apply(components []api.ComponentAbstract) []api.ComponentAbstract {
+	var result []api.ComponentAbstract
+	for _, component := range components {
+		if o.Mode != "" && !component.RunningIn[api.RunningMode(o.Mode)] {
+			continue
+		}
+		if !o.ModifiedBefore.IsZero() && (component.LastModified == nil || !component.LastModified.Before(o.ModifiedBefore)) {
+			continue
+		}
+		result = append(result, component)
+	}
+	return result
+}
+
+// ListAllClusterComponentsFromAllNamespaces returns the components running in all the namespaces
+// (or projects on OpenShift) the user has access to, matching the label selector if not empty
+func ListAllClusterComponentsFromAllNamespaces(client kclient.ClientInterface, selector string) ([]api.ComponentAbstract, error) {
+	isProject, err := client.IsProjectSupported()
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect project support: %w", err)
+	}
+	var namespaces []string
+	if isProject {
+		namespaces, err = client.ListProjectNames()
+	} else {
+		namespaces, err = client.GetNamespaces()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the namespaces: %w", err)
+	}
+
+	var result []api.ComponentAbstract
+	for _, namespace := range namespaces {
+		components, err := ListAllClusterComponents(client, namespace, selector)
+		if err != nil {
+			// The user may not be allowed to list the resources of some namespaces
+			klog.V(2).Infof("unable to list components in namespace %q: %v", namespace, err)
+			continue
+		}
+		for i := range components {
+			components[i].Namespace = namespace
+		}
+		result = append(result, components...)
+	}
+	return result, nil
+}
+
+// getResourceTimes returns the creation time of the resource, and the last time it has been created or modified,
+// based on the times of its managed fields
+func getResourceTimes(resource unstructured.Unstructured) (created time.Time, lastModified time.Time) {
+	created = resource.GetCreationTimestamp().Time
+	lastModified = created
+	for _, field := range resource.GetManagedFields() {
+		if field.Time != nil && field.Time.After(lastModified) {
+			lastModified = field.Time.Time
+		}
+	}
+	return created, lastModified
+}
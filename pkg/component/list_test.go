@@ -0,0 +1,159 @@
+package component
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/kclient"
+	"github\.com/danielpickens/astra/pkg/labels"
+	"github\.com/danielpickens/astra/pkg/podman"
+)
+
+func TestListAllComponents_WithFilter(t *testing.T) {
+	const astraVersion = "v3.7.0"
+	now := time.Date(2023, 6, 15, 10, 0, 0, 0, time.UTC)
+	oldTime := now.Add(-10 * 24 * time.Hour)
+	recentTime := now.Add(-time.Hour)
+
+	getResource := func(name, namespace, mode string, created time.Time, modified time.Time) unstructured.Unstructured {
+		u := unstructured.Unstructured{}
+		u.SetName(name)
+		u.SetKind("Deployment")
+		u.SetNamespace(namespace)
+		u.SetLabels(labels.Builder().WithComponentName(name).WithManager("astra").WithManagedByVersion(astraVersion).WithMode(mode).Labels())
+		u.SetAnnotations(map[string]string{
+			"astra.dev/project-type": "nodejs",
+			"astra.dev/devfile-name": "nodejs-starter",
+		})
+		u.SetCreationTimestamp(metav1.NewTime(created))
+		u.SetManagedFields([]metav1.ManagedFieldsEntry{
+			{Manager: "astra", Time: &metav1.Time{Time: modified}},
+		})
+		return u
+	}
+	oldDev := getResource("old-dev", "ns1", labels.ComponentDevMode, oldTime, oldTime)
+	recentDev := getResource("recent-dev", "ns2", labels.ComponentDevMode, oldTime, recentTime)
+	oldDeploy := getResource("old-deploy", "ns2", labels.ComponentDeployMode, oldTime, oldTime)
+
+	tests := []struct {
+		name         string
+		kubeClient   func(ctrl *gomock.Controller) kclient.ClientInterface
+		podmanClient func(ctrl *gomock.Controller) podman.Client
+		filter       ListFilter
+		want         []api.ComponentAbstract
+		wantErr      bool
+	}{
+		{
+			name: "all namespaces, stale components in Dev mode",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().IsProjectSupported().Return(false, nil)
+				client.EXPECT().GetNamespaces().Return([]string{"ns1", "ns2", "forbidden"}, nil)
+				client.EXPECT().GetAllResourcesFromSelector("", "ns1").Return([]unstructured.Unstructured{oldDev}, nil)
+				client.EXPECT().GetAllResourcesFromSelector("", "ns2").Return([]unstructured.Unstructured{recentDev, oldDeploy}, nil)
+				client.EXPECT().GetAllResourcesFromSelector("", "forbidden").Return(nil, errors.New("forbidden"))
+				return client
+			},
+			podmanClient: func(ctrl *gomock.Controller) podman.Client {
+				client := podman.NewMockClient(ctrl)
+				client.EXPECT().ListAllComponents("").Return([]api.ComponentAbstract{
+					{Name: "podman-dev", RunningIn: api.RunningModes{"dev": true}, Platform: "podman"},
+				}, nil)
+				return client
+			},
+			filter: ListFilter{
+				AllNamespaces:  true,
+				Mode:           "dev",
+				ModifiedBefore: now.Add(-7 * 24 * time.Hour),
+			},
+			want: []api.ComponentAbstract{
+				{
+					Name:             "old-dev",
+					ManagedBy:        "astra",
+					ManagedByVersion: astraVersion,
+					RunningIn:        api.RunningModes{"dev": true, "deploy": false},
+					Type:             "nodejs",
+					RunningOn:        "cluster",
+					Platform:         "cluster",
+					Namespace:        "ns1",
+					DevfileName:      "nodejs-starter",
+					Created:          &oldTime,
+					LastModified:     &oldTime,
+				},
+			},
+		},
+		{
+			name: "projects on OpenShift, with selector",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().IsProjectSupported().Return(true, nil)
+				client.EXPECT().ListProjectNames().Return([]string{"ns2"}, nil)
+				client.EXPECT().GetAllResourcesFromSelector("team=a", "ns2").Return([]unstructured.Unstructured{oldDeploy}, nil)
+				return client
+			},
+			filter: ListFilter{
+				AllNamespaces: true,
+				Selector:      "team=a",
+			},
+			want: []api.ComponentAbstract{
+				{
+					Name:             "old-deploy",
+					ManagedBy:        "astra",
+					ManagedByVersion: astraVersion,
+					RunningIn:        api.RunningModes{"dev": false, "deploy": true},
+					Type:             "nodejs",
+					RunningOn:        "cluster",
+					Platform:         "cluster",
+					Namespace:        "ns2",
+					DevfileName:      "nodejs-starter",
+					Created:          &oldTime,
+					LastModified:     &oldTime,
+				},
+			},
+		},
+		{
+			name: "error listing namespaces",
+			kubeClient: func(ctrl *gomock.Controller) kclient.ClientInterface {
+				client := kclient.NewMockClientInterface(ctrl)
+				client.EXPECT().IsProjectSupported().Return(false, nil)
+				client.EXPECT().GetNamespaces().Return(nil, errors.New("forbidden"))
+				return client
+			},
+			filter: ListFilter{
+				AllNamespaces: true,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			var kubeClient kclient.ClientInterface
+			if tt.kubeClient != nil {
+				kubeClient = tt.kubeClient(ctrl)
+			}
+			var podmanClient podman.Client
+			if tt.podmanClient != nil {
+				podmanClient = tt.podmanClient(ctrl)
+			}
+			got, componentInDevfile, err := ListAllComponents(kubeClient, podmanClient, tt.filter, nil, "local-component")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListAllComponents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if componentInDevfile != "" {
+				t.Errorf("ListAllComponents() componentInDevfile = %q, expected empty", componentInDevfile)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListAllComponents() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
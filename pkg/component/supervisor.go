@@ -0,0 +1,211 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/events"
+	"github\.com/danielpickens/astra/pkg/log"
+)
+
+// RestartPolicyAttribute is the attribute of a run or debug command defining if astra dev restarts the command when it exits
+const RestartPolicyAttribute = "dev.astra.restart-policy"
+
+// RestartPolicy defines when a run or debug command is restarted after its process exits
+type RestartPolicy string
+
+const (
+	// RestartNever never restarts the command, this is the default
+	RestartNever RestartPolicy = "never"
+	// RestartOnFailure restarts the command when it exits with a non-zero status code
+	RestartOnFailure RestartPolicy = "on-failure"
+	// RestartAlways restarts the command whenever it exits
+	RestartAlways RestartPolicy = "always"
+)
+
+// stableRunDuration is the duration after which a process is considered as started successfully,
+// and the backoff delay is reset when it exits
+const stableRunDuration = time.Minute
+
+// GetRestartPolicy returns the restart policy of the command, defined by its RestartPolicyAttribute attribute
+func GetRestartPolicy(command devfilev1.Command) (RestartPolicy, error) {
+	if !command.Attributes.Exists(RestartPolicyAttribute) {
+		return RestartNever, nil
+	}
+	var err error
+	value := command.Attributes.GetString(RestartPolicyAttribute, &err)
+	if err != nil {
+		return "", fmt.Errorf("invalid value for attribute %q of command %q: %w", RestartPolicyAttribute, command.Id, err)
+	}
+	switch policy := RestartPolicy(value); policy {
+	case RestartNever, RestartOnFailure, RestartAlways:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid value %q for attribute %q of command %q, must be %q, %q or %q",
+		value, RestartPolicyAttribute, command.Id, RestartNever, RestartOnFailure, RestartAlways)
+}
+
+// Backoff computes the delays to wait before successive restarts
+type Backoff interface {
+	// Delay returns the delay to wait before the next attempt
+	Delay() time.Duration
+	// Reset restarts the computation of the delays from the first attempt
+	Reset()
+}
+
+// RunSupervisor supervises the processes of the run and debug commands started during a Dev session:
+// it reports the exit of a process, and restarts it according to the restart policy of its command.
+// The methods of a nil RunSupervisor start the processes without supervising them.
+type RunSupervisor struct {
+	eventsClient *events.EventsClient
+	newBackoff   func() Backoff
+
+	mu        sync.Mutex
+	processes map[string]*supervisedProcess
+}
+
+// supervisedProcess is the state of the supervision of the process of a command
+type supervisedProcess struct {
+	// generation is incremented each time the process is started or stopped by the Dev session,
+	// so the exits of the previous processes are ignored
+	generation int
+	startedAt  time.Time
+	backoff    Backoff
+	// restartTimer is set when a restart is scheduled
+	restartTimer *time.Timer
+}
+
+// StartFunc starts a process for a command. The started process must call exited with its exit code when it exits
+type StartFunc func(exited func(exitCode int)) error
+
+// NewRunSupervisor returns a supervisor publishing the exits of the processes to eventsClient,
+// and waiting between successive restarts of a process for the delays computed by a Backoff returned by newBackoff
+func NewRunSupervisor(eventsClient *events.EventsClient, newBackoff func() Backoff) *RunSupervisor {
+	return &RunSupervisor{
+		eventsClient: eventsClient,
+		newBackoff:   newBackoff,
+		processes:    map[string]*supervisedProcess{},
+	}
+}
+
+// Supervise starts a process for the command using start, and supervises it according to the restart policy of the command
+func (o *RunSupervisor) Supervise(ctx context.Context, command devfilev1.Command, start StartFunc) error {
+	if o == nil {
+		return start(func(int) {})
+	}
+	policy, err := GetRestartPolicy(command)
+	if err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	process := o.getProcess(command.Id)
+	process.stopRestart()
+	// The process is started by the Dev session, the delays restart from the beginning
+	process.backoff.Reset()
+	process.generation++
+	generation := process.generation
+	process.startedAt = time.Now()
+	o.mu.Unlock()
+
+	return start(o.exitedFunc(ctx, command, policy, start, generation))
+}
+
+// Stopping must be called before the process for the command is stopped by the Dev session,
+// so its exit is not reported and the process is not restarted
+func (o *RunSupervisor) Stopping(command devfilev1.Command) {
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	process := o.getProcess(command.Id)
+	process.stopRestart()
+	process.generation++
+}
+
+// getProcess returns the state of the supervision of the process for the command, the lock must be held
+func (o *RunSupervisor) getProcess(commandId string) *supervisedProcess {
+	process, ok := o.processes[commandId]
+	if !ok {
+		process = &supervisedProcess{
+			backoff: o.newBackoff(),
+		}
+		o.processes[commandId] = process
+	}
+	return process
+}
+
+// exitedFunc returns the function to be called when the process of the given generation exits
+func (o *RunSupervisor) exitedFunc(ctx context.Context, command devfilev1.Command, policy RestartPolicy, start StartFunc, generation int) func(int) {
+	return func(exitCode int) {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		process := o.getProcess(command.Id)
+		if process.generation != generation {
+			klog.V(4).Infof("process of generation %d for command %q stopped by the Dev session", generation, command.Id)
+			return
+		}
+
+		data := events.CommandData{
+			Name:      command.Id,
+			ExitCode:  exitCode,
+			Component: command.Exec.Component,
+		}
+		if command.Exec.Group != nil {
+			data.Kind = string(command.Exec.Group.Kind)
+		}
+		if exitCode != 0 {
+			data.Error = fmt.Sprintf("process exited with status %d", exitCode)
+		}
+		o.eventsClient.Publish(events.CommandExited, data)
+
+		if ctx.Err() != nil {
+			return
+		}
+		if policy == RestartNever || (policy == RestartOnFailure && exitCode == 0) {
+			return
+		}
+
+		if time.Since(process.startedAt) > stableRunDuration {
+			process.backoff.Reset()
+		}
+		delay := process.backoff.Delay()
+		log.Warningf("Restarting the application (command: %s) in %s, as its restart policy is %q", command.Id, delay.Round(time.Millisecond), policy)
+		process.restartTimer = time.AfterFunc(delay, func() {
+			o.mu.Lock()
+			if process.generation != generation || ctx.Err() != nil {
+				o.mu.Unlock()
+				return
+			}
+			process.restartTimer = nil
+			process.generation++
+			nextGeneration := process.generation
+			process.startedAt = time.Now()
+			o.mu.Unlock()
+
+			o.eventsClient.Publish(events.CommandStarted, events.CommandData{
+				Name:      data.Name,
+				Kind:      data.Kind,
+				Component: data.Component,
+			})
+			err := start(o.exitedFunc(ctx, command, policy, start, nextGeneration))
+			if err != nil {
+				log.Warningf("Failed to restart the application (command: %s): %v", command.Id, err)
+			}
+		})
+	}
+}
+
+// stopRestart cancels the restart scheduled for the process, if any
+func (o *supervisedProcess) stopRestart() {
+	if o.restartTimer != nil {
+		o.restartTimer.Stop()
+		o.restartTimer = nil
+	}
+}
@@ -0,0 +1,133 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+
+	"github\.com/danielpickens/astra/pkg/events"
+)
+
+// fakeBackoff returns delays short enough for the tests
+type fakeBackoff struct {
+	resets int
+}
+
+func (o *fakeBackoff) Delay() time.Duration {
+	return time.Millisecond
+}
+
+func (o *fakeBackoff) Reset() {
+	o.resets++
+}
+
+func newCommandWithPolicy(policy string) devfilev1.Command {
+	command := devfilev1.Command{
+		Id: "run",
+		CommandUnion: devfilev1.CommandUnion{
+			Exec: &devfilev1.ExecCommand{
+				Component: "runtime",
+			},
+		},
+	}
+	if policy != "" {
+		command.Attributes = attributes.Attributes{}.PutString(RestartPolicyAttribute, policy)
+	}
+	return command
+}
+
+func TestGetRestartPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		want    RestartPolicy
+		wantErr bool
+	}{
+		{name: "no attribute", want: RestartNever},
+		{name: "never", policy: "never", want: RestartNever},
+		{name: "on-failure", policy: "on-failure", want: RestartOnFailure},
+		{name: "always", policy: "always", want: RestartAlways},
+		{name: "invalid value", policy: "sometimes", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetRestartPolicy(newCommandWithPolicy(tt.policy))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRestartPolicy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetRestartPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunSupervisor_Supervise(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		exitCode    int
+		wantRestart bool
+		stopped     bool
+	}{
+		{name: "never restarts", policy: "never", exitCode: 1},
+		{name: "on-failure restarts after a failure", policy: "on-failure", exitCode: 1, wantRestart: true},
+		{name: "on-failure does not restart after a success", policy: "on-failure", exitCode: 0},
+		{name: "always restarts after a success", policy: "always", exitCode: 0, wantRestart: true},
+		{name: "process stopped by the Dev session", policy: "always", exitCode: 143, stopped: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			eventsClient := events.NewEventsClient()
+			eventsCh := eventsClient.Subscribe(ctx)
+			supervisor := NewRunSupervisor(eventsClient, func() Backoff {
+				return &fakeBackoff{}
+			})
+			command := newCommandWithPolicy(tt.policy)
+
+			starts := make(chan func(int), 2)
+			start := func(exited func(exitCode int)) error {
+				starts <- exited
+				return nil
+			}
+			err := supervisor.Supervise(ctx, command, start)
+			if err != nil {
+				t.Fatalf("Supervise() unexpected error: %v", err)
+			}
+			exited := <-starts
+			if tt.stopped {
+				supervisor.Stopping(command)
+			}
+			exited(tt.exitCode)
+
+			if !tt.stopped {
+				select {
+				case event := <-eventsCh:
+					data, ok := event.Data.(events.CommandData)
+					if event.Type != events.CommandExited || !ok || data.ExitCode != tt.exitCode {
+						t.Errorf("expected a CommandExited event with exit code %d, got %+v", tt.exitCode, event)
+					}
+				case <-time.After(time.Second):
+					t.Errorf("timeout waiting for the CommandExited event")
+				}
+			}
+
+			select {
+			case <-starts:
+				if !tt.wantRestart {
+					t.Errorf("the command has been restarted")
+				}
+			case <-time.After(100 * time.Millisecond):
+				if tt.wantRestart {
+					t.Errorf("the command has not been restarted")
+				}
+			}
+		})
+	}
+}
@@ -22,6 +22,17 @@ type Configuration struct {
 	astraContainerBackendGlobalArgs []string      `env:"astra_CONTAINER_BACKEND_GLOBAL_ARGS,noinit,delimiter=;"`
 	astraImageBuildArgs             []string      `env:"astra_IMAGE_BUILD_ARGS,noinit,delimiter=;"`
 	astraContainerRunArgs           []string      `env:"astra_CONTAINER_RUN_ARGS,noinit,delimiter=;"`
+	SecretProviders               []string      `env:"astra_SECRET_PROVIDERS,noinit,delimiter=;"`
+	SkipPreflight                 bool          `env:"astra_SKIP_PREFLIGHT,default=false"`
+	TelemetrySink                 string        `env:"astra_TELEMETRY_SINK,default=segment"`
+	TelemetryOTLPEndpoint         string        `env:"astra_TELEMETRY_OTLP_ENDPOINT,default="`
+	TelemetryOTLPHeaders          []string      `env:"astra_TELEMETRY_OTLP_HEADERS,noinit,delimiter=;"`
+	TelemetryFile                 string        `env:"astra_TELEMETRY_FILE,default="`
+	TelemetryFileMaxSize          int           `env:"astra_TELEMETRY_FILE_MAX_SIZE,default=10"`
+	TelemetryFileMaxBackups       int           `env:"astra_TELEMETRY_FILE_MAX_BACKUPS,default=3"`
+	TraceOTLPEndpoint             string        `env:"astra_TRACE_OTLP_ENDPOINT,default="`
+	TraceOTLPHeaders              []string      `env:"astra_TRACE_OTLP_HEADERS,noinit,delimiter=;"`
+	TraceFile                     string        `env:"astra_TRACE_FILE,default="`
 }
 
 // GetConfiguration initializes a Configuration for astra by using the system environment.
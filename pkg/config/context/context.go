@@ -23,3 +23,22 @@ func GetEnvConfig(ctx context.Context) config.Configuration {
 	}
 	panic("GetEnvConfig can be called only after WithEnvConfig has been called")
 }
+
+type projectContextKey struct{}
+
+var projectKey = projectContextKey{}
+
+// WithProjectConfig sets the configuration of the project in ctx
+func WithProjectConfig(ctx context.Context, val config.ProjectConfiguration) context.Context {
+	return context.WithValue(ctx, projectKey, val)
+}
+
+// GetProjectConfig returns the configuration of the project from ctx,
+// or an empty configuration if WithProjectConfig has not been called
+func GetProjectConfig(ctx context.Context) config.ProjectConfiguration {
+	value := ctx.Value(projectKey)
+	if cast, ok := value.(config.ProjectConfiguration); ok {
+		return cast
+	}
+	return config.ProjectConfiguration{}
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+)
+
+const (
+	projectConfigDirectory = ".astra"
+	projectConfigFileName  = "config.yaml"
+)
+
+// ProjectConfiguration holds the defaults shared by the team working on a project,
+// read from the .astra/config.yaml file committed in the project.
+// Its values take precedence over the user preferences, but flags and environment variables take precedence over them.
+type ProjectConfiguration struct {
+	// Path is the path of the file from which the configuration has been read, empty if the project has no configuration file
+	Path string `json:"-"`
+
+	// Ignore lists additional patterns, in the .gitignore format, of the files not synchronized into the containers
+	Ignore []string `json:"ignore,omitempty"`
+	// Platform is the platform used when the --platform flag is not set ("cluster" or "podman")
+	Platform string `json:"platform,omitempty"`
+	// PortForward lists the port-forward mappings used when the --port-forward flag of astra dev is not set
+	PortForward []string `json:"portForward,omitempty"`
+	// BuildCommand is the name of the build command used when the --build-command flag of astra dev is not set
+	BuildCommand string `json:"buildCommand,omitempty"`
+	// RunCommand is the name of the run command used when the --run-command flag of astra dev is not set
+	RunCommand string `json:"runCommand,omitempty"`
+	// PushTimeout overrides the PushTimeout preference
+	PushTimeout *metav1.Duration `json:"pushTimeout,omitempty"`
+	// ImageRegistry overrides the ImageRegistry preference
+	ImageRegistry *string `json:"imageRegistry,omitempty"`
+	// VarFiles lists the files containing variables to override Devfile variables, used when the --var-file flag is not set.
+	// The variables of a file override the ones of the previous files.
+	VarFiles []string `json:"varFiles,omitempty"`
+}
+
+// GetProjectConfigurationPath returns the path of the configuration file of the project in dir
+func GetProjectConfigurationPath(dir string) string {
+	return filepath.Join(dir, projectConfigDirectory, projectConfigFileName)
+}
+
+// GetProjectConfiguration reads the configuration file of the project in dir.
+// It returns an empty configuration if the project has no configuration file.
+// The paths of the variables files are returned relative to dir.
+func GetProjectConfiguration(fs filesystem.Filesystem, dir string) (ProjectConfiguration, error) {
+	path := GetProjectConfigurationPath(dir)
+	content, err := fs.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ProjectConfiguration{}, nil
+		}
+		return ProjectConfiguration{}, fmt.Errorf("unable to read the project configuration file %s: %w", path, err)
+	}
+
+	var result ProjectConfiguration
+	err = yaml.UnmarshalStrict(content, &result)
+	if err != nil {
+		return ProjectConfiguration{}, fmt.Errorf("invalid project configuration file %s: %w", path, err)
+	}
+	err = result.validate()
+	if err != nil {
+		return ProjectConfiguration{}, fmt.Errorf("invalid project configuration file %s: %w", path, err)
+	}
+
+	result.Path = path
+	for i, varFile := range result.VarFiles {
+		if !filepath.IsAbs(varFile) {
+			result.VarFiles[i] = filepath.Join(dir, varFile)
+		}
+	}
+	return result, nil
+}
+
+func (o ProjectConfiguration) validate() error {
+	switch o.Platform {
+	case "", "cluster", "podman":
+	default:
+		return fmt.Errorf(`%q is not a valid platform, please select either "cluster" or "podman"`, o.Platform)
+	}
+	if o.PushTimeout != nil && o.PushTimeout.Duration <= 0 {
+		return fmt.Errorf("pushTimeout must be a positive duration, got %s", o.PushTimeout.Duration)
+	}
+	return nil
+}
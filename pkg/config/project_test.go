@@ -0,0 +1,92 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+)
+
+func TestGetProjectConfiguration(t *testing.T) {
+	const dir = "/project"
+	path := filepath.Join(dir, ".astra", "config.yaml")
+
+	tests := []struct {
+		name    string
+		content *string
+		want    ProjectConfiguration
+		wantErr bool
+	}{
+		{
+			name: "no configuration file",
+			want: ProjectConfiguration{},
+		},
+		{
+			name: "complete configuration file",
+			content: pointer.String(`ignore:
+- "*.log"
+- tmp/
+platform: podman
+portForward:
+- 8080:3000
+buildCommand: build-dev
+runCommand: run-dev
+pushTimeout: 5m
+imageRegistry: quay.io/team
+varFiles:
+- vars/common.env
+- /etc/astra/local.env
+`),
+			want: ProjectConfiguration{
+				Path:          path,
+				Ignore:        []string{"*.log", "tmp/"},
+				Platform:      "podman",
+				PortForward:   []string{"8080:3000"},
+				BuildCommand:  "build-dev",
+				RunCommand:    "run-dev",
+				PushTimeout:   &metav1.Duration{Duration: 5 * time.Minute},
+				ImageRegistry: pointer.String("quay.io/team"),
+				VarFiles:      []string{filepath.Join(dir, "vars", "common.env"), "/etc/astra/local.env"},
+			},
+		},
+		{
+			name:    "unknown field",
+			content: pointer.String("platfrom: podman\n"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid platform",
+			content: pointer.String("platform: kubernetes\n"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid push timeout",
+			content: pointer.String("pushTimeout: -1s\n"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			if tt.content != nil {
+				if err := fs.WriteFile(path, []byte(*tt.content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := GetProjectConfiguration(fs, dir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProjectConfiguration() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetProjectConfiguration() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -8,12 +8,15 @@ import (
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 
 	"github\.com/danielpickens/astra/pkg/component"
+	envcontext "github\.com/danielpickens/astra/pkg/config/context"
 	"github\.com/danielpickens/astra/pkg/configAutomount"
 	"github\.com/danielpickens/astra/pkg/devfile/image"
 	"github\.com/danielpickens/astra/pkg/kclient"
 	"github\.com/danielpickens/astra/pkg/libdevfile"
 	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+	"github\.com/danielpickens/astra/pkg/preflight"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+	"k8s.io/klog"
 )
 
 type DeployClient struct {
@@ -44,6 +47,11 @@ func (o *DeployClient) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	err = o.runPreflightChecks(ctx, *devfileObj, path)
+	if err != nil {
+		return err
+	}
+
 	handler := component.NewRunHandler(
 		ctx,
 		o.kubeClient,
@@ -70,6 +78,22 @@ func (o *DeployClient) Deploy(ctx context.Context) error {
 	return libdevfile.Deploy(ctx, *devfileObj, handler)
 }
 
+// runPreflightChecks checks that the resources of the Devfile can be created in the namespace,
+// and reports all the problems found before creating anything
+func (o *DeployClient) runPreflightChecks(ctx context.Context, devfileObj parser.DevfileObj, path string) error {
+	if envcontext.GetEnvConfig(ctx).SkipPreflight {
+		klog.V(4).Info("skipping the preflight checks")
+		return nil
+	}
+	plan, err := preflight.NewDeployPlan(o.kubeClient, devfileObj, path)
+	if err != nil {
+		return err
+	}
+	report := preflight.Run(o.kubeClient, plan)
+	report.Print()
+	return report.Err()
+}
+
 func (o *DeployClient) buildPushAutoImageComponents(handler libdevfile.Handler, devfileObj parser.DevfileObj) error {
 	components, err := libdevfile.GetImageComponentsToPushAutomatically(devfileObj)
 	if err != nil {
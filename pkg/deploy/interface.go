@@ -9,4 +9,7 @@ type Client interface {
 	// The filesystem specified is used to download and store the Dockerfiles needed to build the necessary container images,
 	// in case such Dockerfiles are referenced as remote URLs in the Devfile.
 	Deploy(ctx context.Context) error
+
+	// Run executes the exec command commandName of the Devfile in a container of the resources deployed in Deploy mode
+	Run(ctx context.Context, commandName string) error
 }
@@ -0,0 +1,103 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/component"
+	"github\.com/danielpickens/astra/pkg/exec"
+	astralabels "github\.com/danielpickens/astra/pkg/labels"
+	"github\.com/danielpickens/astra/pkg/libdevfile"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+)
+
+// Run executes the exec command commandName of the Devfile in a container of the resources deployed in Deploy mode.
+// The command is executed in the container having the name of the container component of the command,
+// or in the only container of the Pod.
+func (o *DeployClient) Run(ctx context.Context, commandName string) error {
+	var (
+		componentName = astracontext.GetComponentName(ctx)
+		appName       = astracontext.GetApplication(ctx)
+		devfileObj    = astracontext.GetEffectiveDevfileObj(ctx)
+	)
+
+	commands, err := devfileObj.Data.GetCommands(common.DevfileOptions{
+		FilterByName: commandName,
+	})
+	if err != nil {
+		return err
+	}
+	if len(commands) != 1 {
+		return libdevfile.NewNoCommandFoundError("", commandName)
+	}
+	command := commands[0]
+	if command.Exec == nil {
+		return fmt.Errorf("only exec commands can be run in Deploy mode, %q is not an exec command", commandName)
+	}
+
+	pod, err := o.getDeployPod(componentName, appName)
+	if err != nil {
+		return err
+	}
+	containerName, err := getContainerForCommand(pod, command.Exec.Component)
+	if err != nil {
+		return err
+	}
+	klog.V(2).Infof("executing command %q in container %q of pod %q", command.Id, containerName, pod.Name)
+
+	execCommand := *command.Exec
+	execCommand.Component = containerName
+	command.Exec = &execCommand
+
+	return component.ExecuteTerminatingCommand(ctx, exec.NewExecClient(o.kubeClient), o.kubeClient, command, false, pod.Name, appName, componentName, "", true)
+}
+
+// getDeployPod returns a running Pod of the resources deployed in Deploy mode for the component
+func (o *DeployClient) getDeployPod(componentName, appName string) (corev1.Pod, error) {
+	selector := astralabels.GetSelector(componentName, appName, astralabels.ComponentDeployMode, false)
+
+	pods := map[string]corev1.Pod{}
+	podList, err := o.kubeClient.GetPodsMatchingSelector(selector)
+	if err != nil {
+		return corev1.Pod{}, err
+	}
+	// Pods created by the deployed resources do not necessarily have the labels of the component
+	ownedPods, err := o.kubeClient.GetAllPodsInNamespaceMatchingSelector(selector, o.kubeClient.GetCurrentNamespace())
+	if err != nil {
+		return corev1.Pod{}, err
+	}
+	for _, pod := range append(podList.Items, ownedPods.Items...) {
+		if pod.Status.Phase == corev1.PodRunning && pod.GetDeletionTimestamp() == nil {
+			pods[pod.GetName()] = pod
+		}
+	}
+	if len(pods) == 0 {
+		return corev1.Pod{}, fmt.Errorf("no running pod found for component %q in Deploy mode. Please check the command 'astra deploy' has been run", componentName)
+	}
+
+	names := make([]string, 0, len(pods))
+	for name := range pods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return pods[names[0]], nil
+}
+
+// getContainerForCommand returns the name of the container of the pod in which to execute a command
+// defined for the container component componentName
+func getContainerForCommand(pod corev1.Pod, componentName string) (string, error) {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == componentName {
+			return container.Name, nil
+		}
+	}
+	if len(pod.Spec.Containers) == 1 {
+		return pod.Spec.Containers[0].Name, nil
+	}
+	return "", fmt.Errorf("no container named %q in pod %q, and the pod has %d containers", componentName, pod.GetName(), len(pod.Spec.Containers))
+}
@@ -0,0 +1,25 @@
+package common
+
+import (
+	"context"
+	"os"
+
+	"k8s.io/klog"
+
+	astralabels "github\.com/danielpickens/astra/pkg/labels"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+)
+
+// GetDevSession returns the information identifying the current astra dev session,
+// to be set on the resources deployed by the session
+func GetDevSession(ctx context.Context) astralabels.DevSession {
+	host, err := os.Hostname()
+	if err != nil {
+		klog.V(4).Infof("unable to get the name of the host: %v", err)
+	}
+	return astralabels.DevSession{
+		Host:      host,
+		PID:       astracontext.GetPID(ctx),
+		Directory: astracontext.GetWorkingDirectory(ctx),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -30,6 +31,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/service"
 	storagepkg "github\.com/danielpickens/astra/pkg/storage"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+	"github\.com/danielpickens/astra/pkg/tracing"
 	"github\.com/danielpickens/astra/pkg/util"
 	"github\.com/danielpickens/astra/pkg/watch"
 
@@ -66,14 +68,24 @@ func (o *DevClient) createComponents(ctx context.Context, parameters common.Push
 		componentStatus.ImageComponentsAutoApplied = make(map[string]devfilev1.ImageComponent)
 	}
 
-	klog.V(4).Infof("component state: %q\n", componentStatus.GetState())
-	err = o.buildPushAutoImageComponents(ctx, o.filesystem, parameters.Devfile, componentStatus)
+	var deployment *appsv1.Deployment
+	deployment, o.deploymentExists, err = o.getComponentDeployment(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	var deployment *appsv1.Deployment
-	deployment, o.deploymentExists, err = o.getComponentDeployment(ctx)
+	if !o.preflightDone {
+		_, span := tracing.Start(ctx, "preflight checks")
+		err = o.runPreflightChecks(ctx, parameters)
+		span.End(&err)
+		if err != nil {
+			return false, err
+		}
+		o.preflightDone = true
+	}
+
+	klog.V(4).Infof("component state: %q\n", componentStatus.GetState())
+	err = o.buildPushAutoImageComponents(ctx, o.filesystem, parameters.Devfile, componentStatus)
 	if err != nil {
 		return false, err
 	}
@@ -82,18 +94,77 @@ func (o *DevClient) createComponents(ctx context.Context, parameters common.Push
 		log.SpinnerNoSpin("Waiting for Kubernetes resources")
 	}
 
+	var updated bool
+	deployment, updated, err = o.applyResources(ctx, parameters, deployment)
+	if err != nil {
+		return false, err
+	}
+
+	if updated {
+		klog.V(4).Infof("Deployment has been updated to generation %d. Waiting new event...\n", deployment.GetGeneration())
+		componentStatus.SetState(watch.StateWaitDeployment)
+		o.startPodWaitSpan(ctx)
+		return false, nil
+	}
+
+	numberReplicas := deployment.Status.ReadyReplicas
+	if numberReplicas != 1 {
+		klog.V(4).Infof("Deployment has %d ready replicas. Waiting new event...\n", numberReplicas)
+		componentStatus.SetState(watch.StateWaitDeployment)
+		o.startPodWaitSpan(ctx)
+		return false, nil
+	}
+
+	o.podWaitSpan.End(nil)
+	o.podWaitSpan = nil
+
+	injected, err := o.bindingClient.CheckServiceBindingsInjectionDone(componentName, appName)
+	if err != nil {
+		return false, err
+	}
+
+	if !injected {
+		klog.V(4).Infof("Waiting for all service bindings to be injected...\n")
+		return false, errors.New("some servicebindings are not injected")
+	}
+
+	// Check if endpoints changed in Devfile
+	o.portsToForward, err = libdevfile.GetDevfileContainerEndpointMapping(parameters.Devfile, parameters.StartOptions.Debug)
+	if err != nil {
+		return false, err
+	}
+	o.portsChanged = !reflect.DeepEqual(o.portsToForward, o.portForwardClient.GetForwardedPorts())
+
+	if componentStatus.GetState() == watch.StateReady && !o.portsChanged {
+		// If the deployment is already in Ready State, no need to continue
+		return false, nil
+	}
+	return true, nil
+}
+
+// applyResources creates or updates the deployment of the component and the other Kubernetes resources defined in the Devfile,
+// and deletes the resources not defined anymore in the Devfile.
+// It returns true if the deployment has been updated.
+func (o *DevClient) applyResources(ctx context.Context, parameters common.PushParameters, deployment *appsv1.Deployment) (_ *appsv1.Deployment, updated bool, err error) {
+	var (
+		appName       = astracontext.GetApplication(ctx)
+		componentName = astracontext.GetComponentName(ctx)
+	)
+
+	ctx, span := tracing.Start(ctx, "apply resources")
+	defer span.End(&err)
+
 	// Set the mode to Dev since we are using "astra dev" here
 	runtime := component.GetComponentRuntimeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata())
 	labels := astralabels.GetLabels(componentName, appName, runtime, astralabels.ComponentDevMode, false)
 
-	var updated bool
 	deployment, updated, err = o.createOrUpdateComponent(ctx, parameters, o.deploymentExists, libdevfile.DevfileCommands{
 		BuildCmd: parameters.StartOptions.BuildCommand,
 		RunCmd:   parameters.StartOptions.RunCommand,
 		DebugCmd: parameters.StartOptions.DebugCommand,
 	}, deployment)
 	if err != nil {
-		return false, fmt.Errorf("unable to create or update component: %w", err)
+		return nil, false, fmt.Errorf("unable to create or update component: %w", err)
 	}
 	ownerReference := generator.GetOwnerReference(deployment)
 
@@ -102,12 +173,12 @@ func (o *DevClient) createComponents(ctx context.Context, parameters common.Push
 
 	objectsToRemove, serviceBindingSecretsToRemove, err := o.getRemoteResourcesNotPresentInDevfile(ctx, parameters, selector)
 	if err != nil {
-		return false, fmt.Errorf("unable to determine resources to delete: %w", err)
+		return nil, false, fmt.Errorf("unable to determine resources to delete: %w", err)
 	}
 
 	err = o.deleteRemoteResources(objectsToRemove)
 	if err != nil {
-		return false, fmt.Errorf("unable to delete remote resources: %w", err)
+		return nil, false, fmt.Errorf("unable to delete remote resources: %w", err)
 	}
 
 	// this is mainly useful when the Service Binding Operator is not installed;
@@ -115,56 +186,31 @@ func (o *DevClient) createComponents(ctx context.Context, parameters common.Push
 	if len(serviceBindingSecretsToRemove) != 0 {
 		err = o.deleteServiceBindingSecrets(serviceBindingSecretsToRemove, deployment)
 		if err != nil {
-			return false, fmt.Errorf("unable to delete service binding secrets: %w", err)
+			return nil, false, fmt.Errorf("unable to delete service binding secrets: %w", err)
 		}
 	}
 
 	// Create all the K8s components defined in the devfile
 	_, err = o.pushDevfileKubernetesComponents(ctx, parameters, labels, astralabels.ComponentDevMode, ownerReference)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 
 	err = o.updatePVCsOwnerReferences(ctx, ownerReference)
 	if err != nil {
-		return false, err
-	}
-
-	if updated {
-		klog.V(4).Infof("Deployment has been updated to generation %d. Waiting new event...\n", deployment.GetGeneration())
-		componentStatus.SetState(watch.StateWaitDeployment)
-		return false, nil
-	}
-
-	numberReplicas := deployment.Status.ReadyReplicas
-	if numberReplicas != 1 {
-		klog.V(4).Infof("Deployment has %d ready replicas. Waiting new event...\n", numberReplicas)
-		componentStatus.SetState(watch.StateWaitDeployment)
-		return false, nil
-	}
-
-	injected, err := o.bindingClient.CheckServiceBindingsInjectionDone(componentName, appName)
-	if err != nil {
-		return false, err
-	}
-
-	if !injected {
-		klog.V(4).Infof("Waiting for all service bindings to be injected...\n")
-		return false, errors.New("some servicebindings are not injected")
+		return nil, false, err
 	}
 
-	// Check if endpoints changed in Devfile
-	o.portsToForward, err = libdevfile.GetDevfileContainerEndpointMapping(parameters.Devfile, parameters.StartOptions.Debug)
-	if err != nil {
-		return false, err
-	}
-	o.portsChanged = !reflect.DeepEqual(o.portsToForward, o.portForwardClient.GetForwardedPorts())
+	return deployment, updated, nil
+}
 
-	if componentStatus.GetState() == watch.StateReady && !o.portsChanged {
-		// If the deployment is already in Ready State, no need to continue
-		return false, nil
+// startPodWaitSpan starts the span measuring the time spent waiting for the pod of the component to be ready,
+// if it is not already started.
+// This time includes the binding of the PersistentVolumeClaims of the component, which is not measured separately
+func (o *DevClient) startPodWaitSpan(ctx context.Context) {
+	if o.podWaitSpan == nil {
+		_, o.podWaitSpan = tracing.Start(ctx, "wait for pod")
 	}
-	return true, nil
 }
 
 func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, fs filesystem.Filesystem, devfileObj parser.DevfileObj, compStatus *watch.ComponentStatus) error {
@@ -256,6 +302,7 @@ func (o *DevClient) createOrUpdateComponent(
 
 	annotations := make(map[string]string)
 	astralabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata()))
+	astralabels.SetDevfileName(annotations, parameters.Devfile.Data.GetMetadata().Name)
 	astralabels.AddCommonAnnotations(annotations)
 	klog.V(4).Infof("We are deploying these annotations: %s", annotations)
 
@@ -324,6 +371,15 @@ func (o *DevClient) createOrUpdateComponent(
 		deployment.Annotations["app.openshift.io/vcs-uri"] = vcsUri
 	}
 
+	// The information about the session is set on the Deployment only, as the pod must not be restarted when it changes
+	deploymentAnnotations := make(map[string]string, len(deployment.Annotations))
+	for k, v := range deployment.Annotations {
+		deploymentAnnotations[k] = v
+	}
+	astralabels.SetDevSession(deploymentAnnotations, common.GetDevSession(ctx))
+	astralabels.SetHeartbeat(deploymentAnnotations, time.Now())
+	deployment.Annotations = deploymentAnnotations
+
 	// add the annotations to the service for linking
 	serviceAnnotations := make(map[string]string)
 	serviceAnnotations["service.binding/backend_ip"] = "path={.spec.clusterIP}"
@@ -581,6 +637,7 @@ func (o *DevClient) pushDevfileKubernetesComponents(
 	// Set the annotations for the component type
 	annotations := make(map[string]string)
 	astralabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(parameters.Devfile.Data.GetMetadata()))
+	astralabels.SetDevfileName(annotations, parameters.Devfile.Data.GetMetadata().Name)
 
 	// create the Kubernetes objects from the manifest and delete the ones not in the devfile
 	err = service.PushKubernetesResources(o.kubernetesClient, parameters.Devfile, k8sComponents, labels, annotations, path, mode, reference)
@@ -13,11 +13,13 @@ import (
 	"github\.com/danielpickens/astra/pkg/component"
 	"github\.com/danielpickens/astra/pkg/dev/common"
 	"github\.com/danielpickens/astra/pkg/devfile/image"
+	"github\.com/danielpickens/astra/pkg/events"
 	"github\.com/danielpickens/astra/pkg/libdevfile"
 	"github\.com/danielpickens/astra/pkg/log"
 	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
 	"github\.com/danielpickens/astra/pkg/port"
 	"github\.com/danielpickens/astra/pkg/sync"
+	"github\.com/danielpickens/astra/pkg/tracing"
 	"github\.com/danielpickens/astra/pkg/watch"
 
 	"k8s.io/klog"
@@ -38,7 +40,9 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 
 	podChanged := componentStatus.GetState() == watch.StateWaitDeployment
 
+	_, span := tracing.Start(ctx, "sync files")
 	execRequired, err := o.syncFiles(ctx, parameters, pod, podChanged)
+	span.End(&err)
 	if err != nil {
 		componentStatus.SetState(watch.StateReady)
 		return fmt.Errorf("failed to sync to component with name %s: %w", componentName, err)
@@ -60,7 +64,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 				Msg:               "Executing post-start command in container",
 			},
 		)
-		err = libdevfile.ExecPostStartEvents(ctx, parameters.Devfile, handler)
+		err = libdevfile.ExecPostStartEvents(ctx, parameters.Devfile, events.NewCommandHandler(o.eventsClient, handler))
 		if err != nil {
 			return err
 		}
@@ -107,6 +111,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 					ContainersRunning: component.GetContainersNames(pod),
 					Devfile:           parameters.Devfile,
 					Path:              path,
+					Supervisor:        o.runSupervisor,
 				},
 			)
 
@@ -125,7 +130,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 			}
 
 			cmdHandler.ComponentExists = running || isComposite
-			runHandler = cmdHandler
+			runHandler = events.NewCommandHandler(o.eventsClient, cmdHandler)
 		}
 
 		klog.V(4).Infof("running=%v, execRequired=%v",
@@ -149,7 +154,7 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 						Msg:               "Building your application in container",
 					},
 				)
-				return libdevfile.Build(ctx, parameters.Devfile, parameters.StartOptions.BuildCommand, execHandler)
+				return libdevfile.Build(ctx, parameters.Devfile, parameters.StartOptions.BuildCommand, events.NewCommandHandler(o.eventsClient, execHandler))
 			}
 			if err = doExecuteBuildCommand(); err != nil {
 				componentStatus.SetState(watch.StateReady)
@@ -179,7 +184,9 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 	if innerLoopWithCommands && hasRunOrDebugCmd && len(o.portsToForward) != 0 {
 		// Check that the application is actually listening on the ports declared in the Devfile, so we are sure that port-forwarding will work
 		appReadySpinner := log.Spinner("Waiting for the application to be ready")
+		_, span = tracing.Start(ctx, "check application ports")
 		err = o.checkAppPorts(ctx, pod.Name, o.portsToForward)
+		span.End(&err)
 		appReadySpinner.End(err == nil)
 		if err != nil {
 			log.Warningf("Port forwarding might not work correctly: %v", err)
@@ -188,7 +195,9 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 		}
 	}
 
+	_, span = tracing.Start(ctx, "port forwarding")
 	err = o.portForwardClient.StartPortForwarding(ctx, parameters.Devfile, componentName, parameters.StartOptions.Debug, parameters.StartOptions.RandomPorts, log.GetStdout(), parameters.StartOptions.ErrOut, parameters.StartOptions.CustomForwardedPorts, parameters.StartOptions.CustomAddress)
+	span.End(&err)
 	if err != nil {
 		return common.NewErrPortForward(err)
 	}
@@ -7,18 +7,21 @@ import (
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 
 	"github\.com/danielpickens/astra/pkg/binding"
+	"github\.com/danielpickens/astra/pkg/component"
 	_delete "github\.com/danielpickens/astra/pkg/component/delete"
 	"github\.com/danielpickens/astra/pkg/configAutomount"
 	"github\.com/danielpickens/astra/pkg/dev"
 	"github\.com/danielpickens/astra/pkg/dev/common"
 	"github\.com/danielpickens/astra/pkg/devfile"
 	"github\.com/danielpickens/astra/pkg/devfile/location"
+	"github\.com/danielpickens/astra/pkg/events"
 	"github\.com/danielpickens/astra/pkg/exec"
 	"github\.com/danielpickens/astra/pkg/kclient"
 	"github\.com/danielpickens/astra/pkg/portForward"
 	"github\.com/danielpickens/astra/pkg/preference"
 	"github\.com/danielpickens/astra/pkg/sync"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+	"github\.com/danielpickens/astra/pkg/tracing"
 	"github\.com/danielpickens/astra/pkg/watch"
 
 	"k8s.io/klog"
@@ -35,13 +38,20 @@ type DevClient struct {
 	execClient            exec.Client
 	deleteClient          _delete.Client
 	configAutomountClient configAutomount.Client
+	eventsClient          *events.EventsClient
+	// runSupervisor supervises the processes of the run and debug commands
+	runSupervisor *component.RunSupervisor
 
 	// deploymentExists is true when the deployment is already created when calling createComponents
 	deploymentExists bool
+	// preflightDone is true when the preflight checks have passed during the session
+	preflightDone bool
 	// portsChanged is true of ports have changed since the last call to createComponents
 	portsChanged bool
 	// portsToForward lists the port to forward during inner loop (Tastra move port forward to createComponents)
 	portsToForward map[string][]devfilev1.Endpoint
+	// podWaitSpan measures the time spent waiting for the pod of the component to be ready
+	podWaitSpan *tracing.Span
 }
 
 var _ dev.Client = (*DevClient)(nil)
@@ -57,6 +67,7 @@ func NewDevClient(
 	execClient exec.Client,
 	deleteClient _delete.Client,
 	configAutomountClient configAutomount.Client,
+	eventsClient *events.EventsClient,
 ) *DevClient {
 	return &DevClient{
 		kubernetesClient:      kubernetesClient,
@@ -69,6 +80,10 @@ func NewDevClient(
 		execClient:            execClient,
 		deleteClient:          deleteClient,
 		configAutomountClient: configAutomountClient,
+		eventsClient:          eventsClient,
+		runSupervisor: component.NewRunSupervisor(eventsClient, func() component.Backoff {
+			return watch.NewExpBackoff()
+		}),
 	}
 }
 
@@ -98,7 +113,7 @@ func (o *DevClient) Start(
 // RegenerateAdapterAndPush get the new devfile and pushes the files to remote pod
 func (o *DevClient) regenerateAdapterAndPush(ctx context.Context, pushParams common.PushParameters, componentStatus *watch.ComponentStatus) error {
 
-	devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(o.filesystem, ""), pushParams.StartOptions.Variables, o.prefClient.GetImageRegistry(), true)
+	devObj, err := devfile.ParseAndValidateFromFileWithSecrets(location.DevfileLocation(o.filesystem, ""), pushParams.StartOptions.Variables, o.prefClient.GetImageRegistry())
 	if err != nil {
 		return fmt.Errorf("unable to read devfile: %w", err)
 	}
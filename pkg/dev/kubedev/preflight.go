@@ -0,0 +1,37 @@
+package kubedev
+
+import (
+	"context"
+	"path/filepath"
+
+	envcontext "github\.com/danielpickens/astra/pkg/config/context"
+	"github\.com/danielpickens/astra/pkg/dev/common"
+	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
+	"github\.com/danielpickens/astra/pkg/preflight"
+	"k8s.io/klog"
+)
+
+// runPreflightChecks checks that the resources of the component can be created in the namespace,
+// and reports all the problems found before creating anything
+func (o *DevClient) runPreflightChecks(ctx context.Context, parameters common.PushParameters) error {
+	if envcontext.GetEnvConfig(ctx).SkipPreflight {
+		klog.V(4).Info("skipping the preflight checks")
+		return nil
+	}
+	path := filepath.Dir(astracontext.GetDevfilePath(ctx))
+	plan, err := preflight.NewDevPlan(o.kubernetesClient, parameters.Devfile, path, preflight.DevOptions{
+		ComponentExists:  o.deploymentExists,
+		EphemeralSources: o.prefClient.GetEphemeralSourceVolume(),
+		BuildCommand:     parameters.StartOptions.BuildCommand,
+		RunCommand:       parameters.StartOptions.RunCommand,
+		DebugCommand:     parameters.StartOptions.DebugCommand,
+		Debug:            parameters.StartOptions.Debug,
+		SkipCommands:     parameters.StartOptions.SkipCommands,
+	})
+	if err != nil {
+		return err
+	}
+	report := preflight.Run(o.kubernetesClient, plan)
+	report.Print()
+	return report.Err()
+}
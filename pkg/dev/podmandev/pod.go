@@ -144,6 +144,7 @@ func (o *DevClient) createPodFromComponent(
 	runtime := component.GetComponentRuntimeFromDevfileMetadata(devfileObj.Data.GetMetadata())
 	pod.SetLabels(labels.GetLabels(componentName, appName, runtime, labels.ComponentDevMode, true))
 	labels.SetProjectType(pod.GetLabels(), component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
+	labels.SetDevfileName(pod.GetLabels(), devfileObj.Data.GetMetadata().Name)
 
 	if pod.Annotations == nil {
 		pod.Annotations = make(map[string]string)
@@ -1449,7 +1449,7 @@ func Test_createPodFromComponent(t *testing.T) {
 			podmanClient := podman.NewMockClient(ctrl)
 			podmanClient.EXPECT().GetCapabilities().Return(tt.capabilities, nil)
 			client := NewDevClient(
-				nil, podmanClient, nil, nil, nil, nil, nil, nil,
+				nil, podmanClient, nil, nil, nil, nil, nil, nil, nil,
 			)
 			got, gotFwPorts, err := client.createPodFromComponent(
 				ctx,
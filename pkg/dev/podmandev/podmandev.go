@@ -8,10 +8,12 @@ import (
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"k8s.io/klog"
 
+	"github\.com/danielpickens/astra/pkg/component"
 	"github\.com/danielpickens/astra/pkg/dev"
 	"github\.com/danielpickens/astra/pkg/dev/common"
 	"github\.com/danielpickens/astra/pkg/devfile"
 	"github\.com/danielpickens/astra/pkg/devfile/location"
+	"github\.com/danielpickens/astra/pkg/events"
 	"github\.com/danielpickens/astra/pkg/exec"
 	"github\.com/danielpickens/astra/pkg/libdevfile"
 	"github\.com/danielpickens/astra/pkg/log"
@@ -37,6 +39,9 @@ type DevClient struct {
 	execClient        exec.Client
 	stateClient       state.Client
 	watchClient       watch.Client
+	eventsClient      *events.EventsClient
+	// runSupervisor supervises the processes of the run and debug commands
+	runSupervisor *component.RunSupervisor
 
 	deployedPod *corev1.Pod
 	usedPorts   []int
@@ -53,6 +58,7 @@ func NewDevClient(
 	execClient exec.Client,
 	stateClient state.Client,
 	watchClient watch.Client,
+	eventsClient *events.EventsClient,
 ) *DevClient {
 	return &DevClient{
 		fs:                fs,
@@ -63,6 +69,10 @@ func NewDevClient(
 		execClient:        execClient,
 		stateClient:       stateClient,
 		watchClient:       watchClient,
+		eventsClient:      eventsClient,
+		runSupervisor: component.NewRunSupervisor(eventsClient, func() component.Backoff {
+			return watch.NewExpBackoff()
+		}),
 	}
 }
 
@@ -174,7 +184,7 @@ func (o *DevClient) checkVolumesFree(pod *corev1.Pod) error {
 
 func (o *DevClient) watchHandler(ctx context.Context, pushParams common.PushParameters, componentStatus *watch.ComponentStatus) error {
 
-	devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(o.fs, ""), pushParams.StartOptions.Variables, o.prefClient.GetImageRegistry(), true)
+	devObj, err := devfile.ParseAndValidateFromFileWithSecrets(location.DevfileLocation(o.fs, ""), pushParams.StartOptions.Variables, o.prefClient.GetImageRegistry())
 	if err != nil {
 		return fmt.Errorf("unable to read devfile: %w", err)
 	}
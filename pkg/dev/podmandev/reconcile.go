@@ -18,10 +18,13 @@ import (
 	"github\.com/danielpickens/astra/pkg/dev"
 	"github\.com/danielpickens/astra/pkg/dev/common"
 	"github\.com/danielpickens/astra/pkg/devfile/image"
+	"github\.com/danielpickens/astra/pkg/events"
+	"github\.com/danielpickens/astra/pkg/labels"
 	"github\.com/danielpickens/astra/pkg/libdevfile"
 	"github\.com/danielpickens/astra/pkg/log"
 	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
 	"github\.com/danielpickens/astra/pkg/port"
+	"github\.com/danielpickens/astra/pkg/tracing"
 	"github\.com/danielpickens/astra/pkg/watch"
 
 	corev1 "k8s.io/api/core/v1"
@@ -56,7 +59,9 @@ func (o *DevClient) reconcile(
 	o.deployedPod = pod
 	componentStatus.SetState(watch.StateReady)
 
+	_, span := tracing.Start(ctx, "sync files")
 	execRequired, err := o.syncFiles(ctx, options, pod, path)
+	span.End(&err)
 	if err != nil {
 		return err
 	}
@@ -77,7 +82,7 @@ func (o *DevClient) reconcile(
 				Msg:               "Executing post-start command in container",
 			},
 		)
-		err = libdevfile.ExecPostStartEvents(ctx, devfileObj, execHandler)
+		err = libdevfile.ExecPostStartEvents(ctx, devfileObj, events.NewCommandHandler(o.eventsClient, execHandler))
 		if err != nil {
 			return err
 		}
@@ -103,7 +108,7 @@ func (o *DevClient) reconcile(
 						Msg:               "Building your application in container",
 					},
 				)
-				return libdevfile.Build(ctx, devfileObj, options.BuildCommand, execHandler)
+				return libdevfile.Build(ctx, devfileObj, options.BuildCommand, events.NewCommandHandler(o.eventsClient, execHandler))
 			}
 
 			err = doExecuteBuildCommand()
@@ -137,9 +142,10 @@ func (o *DevClient) reconcile(
 						PodName:           pod.Name,
 						ComponentExists:   componentStatus.RunExecuted,
 						ContainersRunning: component.GetContainersNames(pod),
+						Supervisor:        o.runSupervisor,
 					},
 				)
-				err = libdevfile.ExecuteCommandByNameAndKind(ctx, devfileObj, cmdName, cmdKind, cmdHandler, false)
+				err = libdevfile.ExecuteCommandByNameAndKind(ctx, devfileObj, cmdName, cmdKind, events.NewCommandHandler(o.eventsClient, cmdHandler), false)
 				if err != nil {
 					return err
 				}
@@ -157,7 +163,9 @@ func (o *DevClient) reconcile(
 	if innerLoopWithCommands && hasRunOrDebugCmd && len(fwPorts) != 0 {
 		// Check that the application is actually listening on the ports declared in the Devfile, so we are sure that port-forwarding will work
 		appReadySpinner := log.Spinner("Waiting for the application to be ready")
+		_, span = tracing.Start(ctx, "check application ports")
 		err = o.checkAppPorts(ctx, pod.Name, fwPorts)
+		span.End(&err)
 		appReadySpinner.End(err == nil)
 		if err != nil {
 			log.Warningf("Port forwarding might not work correctly: %v", err)
@@ -169,8 +177,10 @@ func (o *DevClient) reconcile(
 	// By default, Podman will not forward to container applications listening on the loopback interface.
 	// So we are trying to detect such cases and act accordingly.
 	// See https://github\.com/danielpickens/astra/issues/6510#issuecomment-1439986558
+	_, span = tracing.Start(ctx, "port forwarding")
 	err = o.handleLoopbackPorts(ctx, options, pod, fwPorts)
 	if err != nil {
+		span.End(&err)
 		return err
 	}
 
@@ -178,14 +188,17 @@ func (o *DevClient) reconcile(
 		// Port-forwarding is enabled by executing dedicated socat commands
 		err = o.portForwardClient.StartPortForwarding(ctx, devfileObj, componentName, options.Debug, options.RandomPorts, options.Out, options.ErrOut, fwPorts, options.CustomAddress)
 		if err != nil {
+			span.End(&err)
 			return common.NewErrPortForward(err)
 		}
 	} // else port-forwarding is done via the main container ports in the pod spec
+	span.End(nil)
 
 	for _, fwPort := range fwPorts {
 		s := fmt.Sprintf("Forwarding from %s:%d -> %d", fwPort.LocalAddress, fwPort.LocalPort, fwPort.ContainerPort)
 		fmt.Fprintf(options.Out, " -  %s", log.SboldColor(color.FgGreen, s))
 	}
+	o.eventsClient.Publish(events.PortForwardsChanged, events.PortForwardsData{Ports: fwPorts})
 	err = o.stateClient.SetForwardedPorts(ctx, fwPorts)
 	if err != nil {
 		return err
@@ -227,12 +240,20 @@ func (o *DevClient) buildPushAutoImageComponents(ctx context.Context, devfileObj
 	return nil
 }
 
-// deployPod deploys the component as a Pod in podman
-func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, devfileObj parser.DevfileObj) (*corev1.Pod, []api.ForwardedPort, error) {
+// deployPod deploys the component as a Pod in podman.
+// The time spent creating the volumes and starting the containers of the pod is measured by the "wait for pod" span,
+// as there is no binding of the volumes to wait for with podman
+func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, devfileObj parser.DevfileObj) (_ *corev1.Pod, _ []api.ForwardedPort, err error) {
 
 	spinner := log.Spinner("Deploying pod")
 	defer spinner.End(false)
 
+	_, span := tracing.Start(ctx, "apply resources")
+	defer func() {
+		// ends the "apply resources" or the "wait for pod" span, whichever is in progress
+		span.End(&err)
+	}()
+
 	pod, fwPorts, err := o.createPodFromComponent(
 		ctx,
 		options.Debug,
@@ -249,6 +270,7 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, dev
 	if err != nil {
 		return nil, nil, err
 	}
+	labels.SetDevSession(pod.GetLabels(), common.GetDevSession(ctx))
 	o.usedPorts = getUsedPorts(fwPorts)
 
 	if equality.Semantic.DeepEqual(o.deployedPod, pod) {
@@ -270,6 +292,8 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions, dev
 		}
 	}
 
+	span.End(nil)
+	_, span = tracing.Start(ctx, "wait for pod")
 	err = o.podmanClient.PlayKube(pod)
 	if err != nil {
 		// there are cases when pod is created even if there is an error with the pod def; for e.g. incorrect image
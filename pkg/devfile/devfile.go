@@ -1,6 +1,7 @@
 package devfile
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -11,8 +12,10 @@ import (
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	"k8s.io/utils/pointer"
 
+	"github\.com/danielpickens/astra/pkg/config"
 	"github\.com/danielpickens/astra/pkg/devfile/validate"
 	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/secrets"
 )
 
 func parseRawDevfile(args parser.ParserArgs) (parser.DevfileObj, error) {
@@ -32,7 +35,10 @@ func parseRawDevfile(args parser.ParserArgs) (parser.DevfileObj, error) {
 	return devfileObj, nil
 }
 
-func parseEffectiveDevfile(args parser.ParserArgs) (parser.DevfileObj, error) {
+// parseEffectiveDevfile returns the effective Devfile.
+// If withSecrets is false, the variables referencing secrets are not resolved,
+// as resolving them can execute the commands of the exec providers or access the keyring
+func parseEffectiveDevfile(args parser.ParserArgs, withSecrets bool) (parser.DevfileObj, error) {
 	// Effective Devfile with everything resolved (e.g., parent flattened, K8s URIs inlined, ...)
 	args.SetBooleanDefaults = pointer.Bool(false)
 	args.FlattenedDevfile = pointer.Bool(true)
@@ -50,6 +56,21 @@ func parseEffectiveDevfile(args parser.ParserArgs) (parser.DevfileObj, error) {
 		return parser.DevfileObj{}, err
 	}
 
+	// Secrets are resolved only when referenced, and only in the effective Devfile, which is never written back
+	if refs := getSecretReferences(varWarnings); withSecrets && len(refs) != 0 {
+		args.ExternalVariables, err = resolveSecrets(args.ExternalVariables, refs)
+		if err != nil {
+			return parser.DevfileObj{}, err
+		}
+		devfileObj, varWarnings, err = devfile.ParseDevfileAndValidate(args)
+		if err != nil {
+			return parser.DevfileObj{}, err
+		}
+		for _, ref := range refs {
+			delete(devfileObj.Data.GetDevfileWorkspaceSpec().Variables, ref)
+		}
+	}
+
 	// astra specific validations
 	err = validate.ValidateDevfileData(devfileObj.Data)
 	if err != nil {
@@ -72,11 +93,19 @@ func ParseAndValidateFromFile(devfilePath string, imageRegistry string, wantEffe
 		},
 	}
 	if wantEffective {
-		return parseEffectiveDevfile(parserArgs)
+		return parseEffectiveDevfile(parserArgs, false)
 	}
 	return parseRawDevfile(parserArgs)
 }
 
+// ParseAndValidateFromData parses and validates a devfile from its content, without reading or writing any file.
+// If there are warnings, it logs them on stdout
+func ParseAndValidateFromData(data []byte) (parser.DevfileObj, error) {
+	return parseRawDevfile(parser.ParserArgs{
+		Data: data,
+	})
+}
+
 // ParseAndValidateFromFileWithVariables reads, parses and validates  devfile from a file
 // variables are used to override devfile variables.
 // If wantEffective is true, it returns a complete view of the Devfile, where everything is resolved.
@@ -91,31 +120,93 @@ func ParseAndValidateFromFileWithVariables(devfilePath string, variables map[str
 		},
 	}
 	if wantEffective {
-		return parseEffectiveDevfile(parserArgs)
+		return parseEffectiveDevfile(parserArgs, false)
 	}
 	return parseRawDevfile(parserArgs)
 }
 
-func displayVariableWarnings(varWarnings variables.VariableWarning) {
-	variableWarning := func(section string, variable string, messages []string) string {
-		var quotedVars []string
-		for _, v := range messages {
-			quotedVars = append(quotedVars, fmt.Sprintf("%q", v))
+// ParseAndValidateFromFileWithSecrets reads, parses and validates the effective Devfile from a file, like ParseAndValidateFromFileWithVariables,
+// and resolves the variables referencing secrets.
+// It must be used only by the commands needing the values of the secrets, executing the commands or building the images of the Devfile.
+func ParseAndValidateFromFileWithSecrets(devfilePath string, variables map[string]string, imageRegistry string) (parser.DevfileObj, error) {
+	return parseEffectiveDevfile(parser.ParserArgs{
+		Path:              devfilePath,
+		ExternalVariables: variables,
+		ImageNamesAsSelector: &parser.ImageSelectorArgs{
+			Registry: imageRegistry,
+		},
+	}, true)
+}
+
+// getSecretReferences returns the names of the variables referencing secrets, reported as not found during the variable substitution
+func getSecretReferences(varWarnings variables.VariableWarning) []string {
+	found := map[string]struct{}{}
+	var refs []string
+	for _, section := range []map[string][]string{
+		varWarnings.Commands,
+		varWarnings.Components,
+		varWarnings.Projects,
+		varWarnings.StarterProjects,
+		varWarnings.DependentProjects,
+	} {
+		for _, names := range section {
+			for _, name := range names {
+				if _, ok := found[name]; ok || !secrets.IsReference(name) {
+					continue
+				}
+				found[name] = struct{}{}
+				refs = append(refs, name)
+			}
 		}
-		return fmt.Sprintf("Invalid variable(s) %s in %q section with name %q. ", strings.Join(quotedVars, ","), section, variable)
 	}
+	return refs
+}
 
-	for variable, messages := range varWarnings.Commands {
-		log.Warningf(variableWarning("commands", variable, messages))
+// resolveSecrets returns a copy of vars, with the values of the secrets refs added
+func resolveSecrets(vars map[string]string, refs []string) (map[string]string, error) {
+	envConfig, err := config.GetConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	resolver := secrets.NewResolver()
+	err = resolver.RegisterExecProviders(envConfig.SecretProviders)
+	if err != nil {
+		return nil, err
 	}
-	for variable, messages := range varWarnings.Components {
-		log.Warningf(variableWarning("components", variable, messages))
+
+	result := make(map[string]string, len(vars)+len(refs))
+	for k, v := range vars {
+		result[k] = v
 	}
-	for variable, messages := range varWarnings.Projects {
-		log.Warningf(variableWarning("projects", variable, messages))
+	for _, ref := range refs {
+		result[ref], err = resolver.Resolve(context.Background(), ref)
+		if err != nil {
+			return nil, err
+		}
 	}
-	for variable, messages := range varWarnings.StarterProjects {
-		log.Warningf(variableWarning("starterProjects", variable, messages))
+	return result, nil
+}
+
+func displayVariableWarnings(varWarnings variables.VariableWarning) {
+	displaySectionWarnings := func(section string, warnings map[string][]string) {
+		for variable, messages := range warnings {
+			var quotedVars []string
+			for _, v := range messages {
+				// variables referencing secrets are resolved only in the effective Devfile
+				if secrets.IsReference(v) {
+					continue
+				}
+				quotedVars = append(quotedVars, fmt.Sprintf("%q", v))
+			}
+			if len(quotedVars) == 0 {
+				continue
+			}
+			log.Warningf("Invalid variable(s) %s in %q section with name %q. ", strings.Join(quotedVars, ","), section, variable)
+		}
 	}
 
+	displaySectionWarnings("commands", varWarnings.Commands)
+	displaySectionWarnings("components", varWarnings.Components)
+	displaySectionWarnings("projects", varWarnings.Projects)
+	displaySectionWarnings("starterProjects", varWarnings.StarterProjects)
 }
@@ -15,6 +15,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/log"
 	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+	"github\.com/danielpickens/astra/pkg/tracing"
 )
 
 // Backend is in interface that must be implemented by container runtimes
@@ -56,7 +57,7 @@ func BuildPushImages(ctx context.Context, backend Backend, fs filesystem.Filesys
 	}
 
 	for _, component := range components {
-		err = buildPushImage(backend, fs, component.Image, path, push)
+		err = buildPushImage(ctx, backend, fs, component.Image, path, push)
 		if err != nil {
 			return err
 		}
@@ -78,12 +79,12 @@ func BuildPushSpecificImage(ctx context.Context, backend Backend, fs filesystem.
 		//revive:enable:error-strings
 	}
 
-	return buildPushImage(backend, fs, component.Image, path, push)
+	return buildPushImage(ctx, backend, fs, component.Image, path, push)
 }
 
 // buildPushImage build an image using the provided backend
 // If push is true, also push the image to its registry
-func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool) error {
+func buildPushImage(ctx context.Context, backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool) error {
 	if image == nil {
 		return errors.New("image should not be nil")
 	}
@@ -94,12 +95,16 @@ func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.Im
 		msg = "Building Image: %s"
 	}
 	log.Sectionf(msg, image.ImageName)
+	_, span := tracing.Start(ctx, "build image", tracing.String("astra.image", image.ImageName))
 	err := backend.Build(fs, image, devfilePath)
+	span.End(&err)
 	if err != nil {
 		return err
 	}
 	if push {
+		_, span = tracing.Start(ctx, "push image", tracing.String("astra.image", image.ImageName))
 		err = backend.Push(image.ImageName)
+		span.End(&err)
 		if err != nil {
 			return err
 		}
@@ -109,7 +109,7 @@ func TestBuildPushImage(t *testing.T) {
 			} else {
 				backend.EXPECT().Push(nil).Times(0)
 			}
-			err := buildPushImage(backend, fakeFs, tt.image, "", tt.push)
+			err := buildPushImage(context.Background(), backend, fakeFs, tt.image, "", tt.push)
 
 			if tt.wantErr != (err != nil) {
 				t.Errorf("%s: Error result wanted %v, got %v", tt.name, tt.wantErr, err != nil)
@@ -0,0 +1,291 @@
+package importer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	apidevfile "github.com/devfile/api/v2/pkg/devfile"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	"github\.com/danielpickens/astra/pkg/libdevfile/generator"
+	"github\.com/danielpickens/astra/pkg/util"
+)
+
+const (
+	// deployCommandId is the identifier of the composite command building and deploying the component
+	deployCommandId = "deploy"
+	// idleCommand is the command used to keep a Dev container running, the project being run with exec commands
+	idleCommand = "tail"
+)
+
+var idleArgs = []string{"-f", "/dev/null"}
+
+// builder accumulates the elements of a Devfile while the sources are processed
+type builder struct {
+	name string
+
+	components []v1alpha2.Component
+	commands   []v1alpha2.Command
+
+	// buildImageCommands and deployCommands are the apply commands referenced by the deploy composite command,
+	// images being built before manifests are applied
+	buildImageCommands []string
+	deployCommands     []string
+
+	hasRunCommand   bool
+	hasBuildCommand bool
+
+	unmapped []string
+}
+
+func newBuilder(name string) *builder {
+	return &builder{
+		name: name,
+	}
+}
+
+// unmappedf records an element of the sources which could not be represented in the Devfile
+func (b *builder) unmappedf(format string, a ...interface{}) {
+	b.unmapped = append(b.unmapped, fmt.Sprintf(format, a...))
+}
+
+// addContainer adds a container component.
+// If mountSources is true, the sources of the project are synchronized into the container at sourceMapping,
+// and the container is kept running so that the project can be built and run with exec commands.
+func (b *builder) addContainer(name string, container v1alpha2.Container, endpoints []v1alpha2.Endpoint, mountSources bool, sourceMapping string) {
+	if mountSources {
+		container.MountSources = pointer.Bool(true)
+		container.SourceMapping = sourceMapping
+		container.Command = []string{idleCommand}
+		container.Args = idleArgs
+	} else {
+		container.MountSources = pointer.Bool(false)
+	}
+	b.components = append(b.components, generator.GetContainerComponent(generator.ContainerComponentParams{
+		Name:      name,
+		Container: container,
+		Endpoints: endpoints,
+	}))
+}
+
+// addVolume adds a volume component, if not already defined
+func (b *builder) addVolume(name string) {
+	for _, component := range b.components {
+		if component.Name == name {
+			return
+		}
+	}
+	b.components = append(b.components, generator.GetVolumeComponent(generator.VolumeComponentParams{
+		Name: name,
+	}))
+}
+
+// addExecCommand adds an exec command of the given kind running commandLine in the container component.
+// Only the first command of each kind is marked as the default one.
+func (b *builder) addExecCommand(id string, kind v1alpha2.CommandGroupKind, component string, commandLine string, workingDir string) {
+	isDefault := false
+	switch kind {
+	case v1alpha2.RunCommandGroupKind:
+		isDefault = !b.hasRunCommand
+		b.hasRunCommand = true
+	case v1alpha2.BuildCommandGroupKind:
+		isDefault = !b.hasBuildCommand
+		b.hasBuildCommand = true
+	}
+	b.commands = append(b.commands, generator.GetExecCommand(generator.ExecCommandParams{
+		Id:          id,
+		CommandLine: commandLine,
+		Component:   component,
+		WorkingDir:  workingDir,
+		Kind:        kind,
+		IsDefault:   pointer.Bool(isDefault),
+	}))
+}
+
+// addImage adds an image component building imageName from the dockerfile,
+// and the apply command building it in Deploy mode
+func (b *builder) addImage(name string, imageName string, dockerfile string, buildContext string, args []string) {
+	b.components = append(b.components, generator.GetImageComponent(generator.ImageComponentParams{
+		Name: name,
+		Image: v1alpha2.Image{
+			ImageName: imageName,
+			ImageUnion: v1alpha2.ImageUnion{
+				Dockerfile: &v1alpha2.DockerfileImage{
+					DockerfileSrc: v1alpha2.DockerfileSrc{
+						Uri: dockerfile,
+					},
+					Dockerfile: v1alpha2.Dockerfile{
+						BuildContext: buildContext,
+						Args:         args,
+					},
+				},
+			},
+		},
+	}))
+	id := "build-" + name
+	b.commands = append(b.commands, generator.GetApplyCommand(generator.ApplyCommandParams{
+		Id:        id,
+		Component: name,
+	}))
+	b.buildImageCommands = append(b.buildImageCommands, id)
+}
+
+// addManifest adds a kubernetes component with the inlined manifest,
+// and the apply command deploying it in Deploy mode
+func (b *builder) addManifest(name string, manifest string) {
+	b.components = append(b.components, generator.GetKubernetesComponent(generator.KubernetesComponentParams{
+		Name: name,
+		Kubernetes: &v1alpha2.KubernetesComponent{
+			K8sLikeComponent: v1alpha2.K8sLikeComponent{
+				K8sLikeComponentLocation: v1alpha2.K8sLikeComponentLocation{
+					Inlined: manifest,
+				},
+			},
+		},
+	}))
+	id := "deploy-" + name
+	b.commands = append(b.commands, generator.GetApplyCommand(generator.ApplyCommandParams{
+		Id:        id,
+		Component: name,
+	}))
+	b.deployCommands = append(b.deployCommands, id)
+}
+
+// addDeployment adds the manifests of a Deployment running the container, and of a Service exposing its ports
+func (b *builder) addDeployment(name string, container corev1.Container) error {
+	labels := map[string]string{
+		"app.kubernetes.io/name": name,
+	}
+	deployment := appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{container},
+				},
+			},
+		},
+	}
+	manifest, err := toYAML(&deployment)
+	if err != nil {
+		return err
+	}
+	b.addManifest(name+"-deployment", manifest)
+
+	if len(container.Ports) == 0 {
+		return nil
+	}
+	service := corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+		},
+	}
+	for _, port := range container.Ports {
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Name:       port.Name,
+			Port:       port.ContainerPort,
+			TargetPort: intstr.FromInt(int(port.ContainerPort)),
+		})
+	}
+	manifest, err = toYAML(&service)
+	if err != nil {
+		return err
+	}
+	b.addManifest(name+"-service", manifest)
+	return nil
+}
+
+// build returns the Devfile content built from the accumulated elements
+func (b *builder) build() (data.DevfileData, error) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		return nil, err
+	}
+	devfileData.SetSchemaVersion(string(data.APISchemaVersion220))
+	devfileData.SetMetadata(apidevfile.DevfileMetadata{
+		Name: b.name,
+	})
+
+	if err = devfileData.AddComponents(b.components); err != nil {
+		return nil, err
+	}
+
+	commands := b.commands
+	deploySteps := append(append([]string{}, b.buildImageCommands...), b.deployCommands...)
+	if len(deploySteps) > 0 {
+		commands = append(commands, generator.GetCompositeCommand(generator.CompositeCommandParams{
+			Id:        deployCommandId,
+			Commands:  deploySteps,
+			Kind:      v1alpha2.DeployCommandGroupKind,
+			IsDefault: pointer.Bool(true),
+		}))
+	}
+	if err = devfileData.AddCommands(commands); err != nil {
+		return nil, err
+	}
+	return devfileData, nil
+}
+
+// componentName returns a valid component name derived from s
+func componentName(s string) string {
+	return util.GetDNS1123Name(s)
+}
+
+// endpointName returns the name of the endpoint for the given port
+func endpointName(port int) string {
+	return "port-" + strconv.Itoa(port)
+}
+
+// toYAML returns the YAML representation of the Kubernetes object,
+// without its status and the empty fields set by the Go types (creation timestamps, etc)
+func toYAML(obj interface{}) (string, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+	unstructured.RemoveNestedField(u, "status")
+	for _, fields := range [][]string{
+		{"metadata", "creationTimestamp"},
+		{"spec", "strategy"},
+		{"spec", "template", "metadata", "creationTimestamp"},
+	} {
+		value, found, _ := unstructured.NestedFieldNoCopy(u, fields...)
+		if m, isMap := value.(map[string]interface{}); found && (value == nil || (isMap && len(m) == 0)) {
+			unstructured.RemoveNestedField(u, fields...)
+		}
+	}
+	content, err := yaml.Marshal(u)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
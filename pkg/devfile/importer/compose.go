@@ -0,0 +1,409 @@
+package importer
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+)
+
+// composeService is a service defined in a Compose file
+type composeService struct {
+	name  string
+	image string
+
+	// buildContext and dockerfile are defined when the image of the service is built from sources
+	buildContext string
+	dockerfile   string
+	buildArgs    []string
+
+	endpoints   []v1alpha2.Endpoint
+	env         []v1alpha2.EnvVar
+	mounts      []v1alpha2.VolumeMount
+	entrypoint  []string
+	command     []string
+	workingDir  string
+	sourcesPath string
+}
+
+// composeKnownKeys lists the keys of a service definition handled by the importer
+var composeKnownKeys = map[string]bool{
+	"image":       true,
+	"build":       true,
+	"ports":       true,
+	"expose":      true,
+	"environment": true,
+	"volumes":     true,
+	"command":     true,
+	"entrypoint":  true,
+	"working_dir": true,
+}
+
+// importCompose adds to the builder, for each service of the Compose file:
+// - a container component; the sources of the project are synchronized into the container of the service built from the project directory,
+// - an image component if the image of the service is built from a Dockerfile,
+// - the manifests to deploy the service.
+func importCompose(b *builder, fs filesystem.Filesystem, dir string, composeDir string, content []byte) error {
+	var compose map[string]interface{}
+	if err := yaml.Unmarshal(content, &compose); err != nil {
+		return fmt.Errorf("unable to parse Compose file: %w", err)
+	}
+
+	for key := range compose {
+		switch key {
+		case "services", "volumes", "version", "name":
+		default:
+			b.unmappedf("top-level element %q", key)
+		}
+	}
+
+	servicesDef, ok := compose["services"].(map[string]interface{})
+	if !ok || len(servicesDef) == 0 {
+		return fmt.Errorf("no service defined in the Compose file")
+	}
+
+	names := make([]string, 0, len(servicesDef))
+	for name := range servicesDef {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	services := make([]composeService, 0, len(names))
+	mainService := -1
+	for _, name := range names {
+		def, ok := servicesDef[name].(map[string]interface{})
+		if !ok {
+			b.unmappedf("service %q: invalid definition", name)
+			continue
+		}
+		service := parseComposeService(b, name, def, dir, composeDir)
+		if service.image == "" && service.buildContext == "" {
+			b.unmappedf("service %q: no image or build defined, service ignored", name)
+			continue
+		}
+		services = append(services, service)
+		// The sources are synchronized into the first service built from the project directory,
+		// or into the first service built from sources if none is built from the project directory
+		if service.buildContext != "" {
+			if mainService == -1 || (service.buildContext == "." && services[mainService].buildContext != ".") {
+				mainService = len(services) - 1
+			}
+		}
+	}
+	if len(services) == 0 {
+		return fmt.Errorf("no service could be imported from the Compose file")
+	}
+	if mainService == -1 {
+		b.unmappedf("no service is built from the sources of the project, sources will not be synchronized")
+	}
+
+	for i, service := range services {
+		containerName := componentName(service.name)
+		image := service.image
+		if service.buildContext != "" {
+			// the image component and the container component share the same image name,
+			// so that the container uses the image built
+			image = containerName
+			if service.image != "" {
+				image = service.image
+			}
+			b.addImage(containerName+"-image", image, service.dockerfile, service.buildContext, service.buildArgs)
+		}
+
+		container := v1alpha2.Container{
+			Image:        image,
+			Env:          service.env,
+			VolumeMounts: service.mounts,
+		}
+		for _, mount := range service.mounts {
+			b.addVolume(mount.Name)
+		}
+
+		if i != mainService {
+			container.Command = service.entrypoint
+			container.Args = service.command
+			b.addContainer(containerName, container, service.endpoints, false, "")
+		} else {
+			sourceMapping := service.sourcesPath
+			if sourceMapping == "" {
+				sourceMapping = service.workingDir
+			}
+			commandLine := shellJoin(append(append([]string{}, service.entrypoint...), service.command...))
+			if commandLine == "" || sourceMapping == "" {
+				// get the missing information from the Dockerfile
+				stage, err := lastDockerfileStage(fs, filepath.Join(dir, service.dockerfile))
+				if err == nil {
+					if commandLine == "" {
+						commandLine = stage.commandLine()
+					}
+					if sourceMapping == "" {
+						sourceMapping = stage.workingDir
+					}
+				}
+			}
+			if sourceMapping == "" {
+				sourceMapping = defaultSourceMapping
+			}
+			b.addContainer(containerName, container, service.endpoints, true, sourceMapping)
+			if commandLine != "" {
+				b.addExecCommand("run", v1alpha2.RunCommandGroupKind, containerName, commandLine, sourceMapping)
+			} else {
+				b.unmappedf("service %q: no command found to run the service, no run command has been defined", service.name)
+			}
+		}
+
+		deployContainer := corev1.Container{
+			Name:       containerName,
+			Image:      image,
+			Command:    service.entrypoint,
+			Args:       service.command,
+			WorkingDir: service.workingDir,
+		}
+		for _, env := range service.env {
+			deployContainer.Env = append(deployContainer.Env, corev1.EnvVar{Name: env.Name, Value: env.Value})
+		}
+		for _, endpoint := range service.endpoints {
+			deployContainer.Ports = append(deployContainer.Ports, corev1.ContainerPort{
+				Name:          endpoint.Name,
+				ContainerPort: int32(endpoint.TargetPort),
+				Protocol:      corev1.Protocol(strings.ToUpper(string(endpoint.Protocol))),
+			})
+		}
+		if len(service.mounts) > 0 {
+			b.unmappedf("service %q: volumes are not deployed in Deploy mode", service.name)
+		}
+		if err := b.addDeployment(containerName, deployContainer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseComposeService extracts the information of a service from its definition
+func parseComposeService(b *builder, name string, def map[string]interface{}, dir string, composeDir string) composeService {
+	service := composeService{
+		name: name,
+	}
+
+	keys := make([]string, 0, len(def))
+	for key := range def {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !composeKnownKeys[key] {
+			b.unmappedf("service %q: %q", name, key)
+		}
+	}
+
+	service.image, _ = def["image"].(string)
+	service.workingDir, _ = def["working_dir"].(string)
+
+	switch build := def["build"].(type) {
+	case nil:
+	case string:
+		service.buildContext = build
+	case map[string]interface{}:
+		service.buildContext, _ = build["context"].(string)
+		if service.buildContext == "" {
+			service.buildContext = "."
+		}
+		service.dockerfile, _ = build["dockerfile"].(string)
+		for _, arg := range toKeyValues(b, name, "build.args", build["args"]) {
+			service.buildArgs = append(service.buildArgs, fmt.Sprintf("--build-arg=%s=%s", arg.Name, arg.Value))
+		}
+	default:
+		b.unmappedf("service %q: invalid build definition", name)
+	}
+	if service.buildContext != "" {
+		// build contexts are relative to the Compose file, and must be relative to the project directory in the Devfile
+		service.buildContext = relativeTo(dir, filepath.Join(composeDir, service.buildContext))
+		if service.dockerfile == "" {
+			service.dockerfile = "Dockerfile"
+		}
+		service.dockerfile = filepath.ToSlash(filepath.Join(service.buildContext, service.dockerfile))
+	}
+
+	for _, port := range toList(def["ports"]) {
+		endpoint, err := parseComposePort(port)
+		if err != nil {
+			b.unmappedf("service %q: %v", name, err)
+			continue
+		}
+		endpoint.Exposure = v1alpha2.PublicEndpointExposure
+		service.endpoints = appendEndpoint(service.endpoints, endpoint)
+	}
+	for _, port := range toList(def["expose"]) {
+		endpoint, err := parsePort(fmt.Sprint(port))
+		if err != nil {
+			b.unmappedf("service %q: %v", name, err)
+			continue
+		}
+		endpoint.Exposure = v1alpha2.InternalEndpointExposure
+		service.endpoints = appendEndpoint(service.endpoints, endpoint)
+	}
+
+	service.env = toKeyValues(b, name, "environment", def["environment"])
+	service.entrypoint = toCommand(def["entrypoint"])
+	service.command = toCommand(def["command"])
+
+	for _, volume := range toList(def["volumes"]) {
+		var source, target, typ string
+		switch v := volume.(type) {
+		case string:
+			parts := strings.Split(v, ":")
+			if len(parts) == 1 {
+				// anonymous volume
+				target = parts[0]
+			} else {
+				source, target = parts[0], parts[1]
+			}
+		case map[string]interface{}:
+			typ, _ = v["type"].(string)
+			source, _ = v["source"].(string)
+			target, _ = v["target"].(string)
+		}
+		if target == "" {
+			b.unmappedf("service %q: invalid volume %v", name, volume)
+			continue
+		}
+		if typ == "" {
+			typ = "volume"
+			if strings.HasPrefix(source, ".") || strings.HasPrefix(source, "/") || strings.HasPrefix(source, "~") {
+				typ = "bind"
+			}
+		}
+		switch typ {
+		case "volume":
+			volumeName := source
+			if volumeName == "" {
+				volumeName = name + "-" + target
+			}
+			service.mounts = append(service.mounts, v1alpha2.VolumeMount{
+				Name: componentName(volumeName),
+				Path: target,
+			})
+		case "bind":
+			if !filepath.IsAbs(source) && !strings.HasPrefix(source, "~") {
+				source = filepath.Join(composeDir, source)
+			}
+			if filepath.Clean(source) == filepath.Clean(dir) {
+				service.sourcesPath = target
+				continue
+			}
+			b.unmappedf("service %q: bind mount %q", name, fmt.Sprintf("%s:%s", source, target))
+		default:
+			b.unmappedf("service %q: volume of type %q", name, typ)
+		}
+	}
+	return service
+}
+
+// parseComposePort parses a port in the short syntax [[HOST:]PUBLISHED:]TARGET[/PROTOCOL], or in the long syntax
+func parseComposePort(port interface{}) (v1alpha2.Endpoint, error) {
+	switch p := port.(type) {
+	case float64:
+		return parsePort(strconv.Itoa(int(p)))
+	case string:
+		parts := strings.Split(p, ":")
+		target := parts[len(parts)-1]
+		if strings.Contains(target, "-") {
+			return v1alpha2.Endpoint{}, fmt.Errorf("port range %q", p)
+		}
+		return parsePort(target)
+	case map[string]interface{}:
+		target, ok := p["target"].(float64)
+		if !ok {
+			return v1alpha2.Endpoint{}, fmt.Errorf("invalid port %v", p)
+		}
+		s := strconv.Itoa(int(target))
+		if protocol, ok := p["protocol"].(string); ok {
+			s += "/" + protocol
+		}
+		return parsePort(s)
+	}
+	return v1alpha2.Endpoint{}, fmt.Errorf("invalid port %v", port)
+}
+
+// toKeyValues returns the variables defined either as a map or as a list of KEY=VALUE elements.
+// Variables without value, taking their value from the environment, are reported as unmapped.
+func toKeyValues(b *builder, service string, field string, value interface{}) []v1alpha2.EnvVar {
+	var result []v1alpha2.EnvVar
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if v[key] == nil {
+				b.unmappedf("service %q: %s %q without value", service, field, key)
+				continue
+			}
+			result = append(result, v1alpha2.EnvVar{Name: key, Value: fmt.Sprint(v[key])})
+		}
+	case []interface{}:
+		for _, item := range v {
+			key, val, found := strings.Cut(fmt.Sprint(item), "=")
+			if !found {
+				b.unmappedf("service %q: %s %q without value", service, field, key)
+				continue
+			}
+			result = append(result, v1alpha2.EnvVar{Name: key, Value: val})
+		}
+	}
+	return result
+}
+
+// toCommand returns a command defined either as a list or as a string
+func toCommand(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			result = append(result, fmt.Sprint(item))
+		}
+		return result
+	}
+	return nil
+}
+
+func toList(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
+
+// lastDockerfileStage returns the information about the last stage of the Dockerfile at path
+func lastDockerfileStage(fs filesystem.Filesystem, path string) (dockerfileStage, error) {
+	content, err := fs.ReadFile(path)
+	if err != nil {
+		return dockerfileStage{}, err
+	}
+	info, err := parseDockerfile(newBuilder(""), content)
+	if err != nil {
+		return dockerfileStage{}, err
+	}
+	if len(info.stages) == 0 {
+		return dockerfileStage{}, fmt.Errorf("no stage found in Dockerfile %q", path)
+	}
+	return info.stages[len(info.stages)-1], nil
+}
+
+// relativeTo returns path relative to base, using slashes as separator
+func relativeTo(base string, path string) string {
+	rel, err := filepath.Rel(base, path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,283 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/moby/buildkit/frontend/dockerfile/command"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// defaultSourceMapping is the directory in which sources are synchronized when the Dockerfile defines no WORKDIR
+	defaultSourceMapping = "/projects"
+)
+
+// dockerfileStage contains the information extracted from one stage of a Dockerfile
+type dockerfileStage struct {
+	baseImage  string
+	alias      string
+	workingDir string
+	env        []v1alpha2.EnvVar
+	run        []string
+	entrypoint []string
+	cmd        []string
+	// shellForm indicates that entrypoint/cmd have been defined with the shell form
+	shellForm bool
+	volumes   []string
+}
+
+// dockerfileInfo contains the information extracted from a Dockerfile
+type dockerfileInfo struct {
+	stages []dockerfileStage
+	ports  []v1alpha2.Endpoint
+}
+
+// importDockerfile adds to the builder:
+// - a container component based on the image of the first stage of the Dockerfile, exposing the ports of the Dockerfile,
+// - build and run exec commands based on the RUN and CMD/ENTRYPOINT instructions,
+// - an image component building the Dockerfile, and the manifests to deploy it.
+func importDockerfile(b *builder, dir string, path string, content []byte) error {
+	info, err := parseDockerfile(b, content)
+	if err != nil {
+		return err
+	}
+	if len(info.stages) == 0 {
+		return fmt.Errorf("no FROM instruction found in Dockerfile %q", path)
+	}
+
+	first := info.stages[0]
+	last := info.stages[len(info.stages)-1]
+	if len(info.stages) > 1 {
+		b.unmappedf("multi-stage Dockerfile: the Dev container is based on the image of the first stage (%s), the other stages are only used in Deploy mode", first.baseImage)
+	}
+
+	sourceMapping := first.workingDir
+	if sourceMapping == "" {
+		sourceMapping = defaultSourceMapping
+	}
+
+	containerName := componentName(b.name)
+	container := v1alpha2.Container{
+		Image: first.baseImage,
+		Env:   first.env,
+	}
+	for _, volume := range first.volumes {
+		volumeName := componentName(containerName + "-" + volume)
+		b.addVolume(volumeName)
+		container.VolumeMounts = append(container.VolumeMounts, v1alpha2.VolumeMount{
+			Name: volumeName,
+			Path: volume,
+		})
+	}
+	b.addContainer(containerName, container, info.ports, true, sourceMapping)
+
+	if len(first.run) > 0 {
+		b.addExecCommand("build", v1alpha2.BuildCommandGroupKind, containerName, strings.Join(first.run, " && "), sourceMapping)
+	}
+	if commandLine := last.commandLine(); commandLine != "" {
+		b.addExecCommand("run", v1alpha2.RunCommandGroupKind, containerName, commandLine, sourceMapping)
+	} else {
+		b.unmappedf("no CMD or ENTRYPOINT instruction found in Dockerfile, no run command has been defined")
+	}
+
+	imageName := containerName
+	b.addImage(containerName+"-image", imageName, relativeTo(dir, path), ".", nil)
+
+	deployContainer := corev1.Container{
+		Name:  containerName,
+		Image: imageName,
+	}
+	for _, env := range last.env {
+		deployContainer.Env = append(deployContainer.Env, corev1.EnvVar{Name: env.Name, Value: env.Value})
+	}
+	for _, port := range info.ports {
+		deployContainer.Ports = append(deployContainer.Ports, corev1.ContainerPort{
+			Name:          port.Name,
+			ContainerPort: int32(port.TargetPort),
+			Protocol:      corev1.Protocol(strings.ToUpper(string(port.Protocol))),
+		})
+	}
+	return b.addDeployment(containerName, deployContainer)
+}
+
+// parseDockerfile extracts the stages and exposed ports from the content of a Dockerfile
+func parseDockerfile(b *builder, content []byte) (dockerfileInfo, error) {
+	result, err := parser.Parse(bytes.NewReader(content))
+	if err != nil {
+		return dockerfileInfo{}, fmt.Errorf("unable to parse Dockerfile: %w", err)
+	}
+
+	var (
+		info  dockerfileInfo
+		stage *dockerfileStage
+	)
+	for _, node := range result.AST.Children {
+		args := nodeArgs(node)
+		instruction := strings.ToLower(node.Value)
+		if instruction == command.From {
+			if len(args) == 0 {
+				return dockerfileInfo{}, fmt.Errorf("line %d: FROM without image", node.StartLine)
+			}
+			newStage := dockerfileStage{
+				baseImage: args[0],
+			}
+			if len(args) == 3 && strings.EqualFold(args[1], "as") {
+				newStage.alias = args[2]
+			}
+			// a stage based on a previous stage inherits its configuration
+			for _, previous := range info.stages {
+				if previous.alias != "" && previous.alias == newStage.baseImage {
+					newStage = previous
+					newStage.alias = ""
+					if len(args) == 3 {
+						newStage.alias = args[2]
+					}
+					newStage.run = nil
+				}
+			}
+			info.stages = append(info.stages, newStage)
+			stage = &info.stages[len(info.stages)-1]
+			continue
+		}
+		if stage == nil {
+			if instruction != command.Arg {
+				b.unmappedf("line %d: instruction %q before FROM", node.StartLine, node.Original)
+			}
+			continue
+		}
+
+		switch instruction {
+		case command.Workdir:
+			if len(args) > 0 {
+				if filepath.IsAbs(args[0]) || stage.workingDir == "" {
+					stage.workingDir = args[0]
+				} else {
+					stage.workingDir = filepath.Join(stage.workingDir, args[0])
+				}
+			}
+		case command.Env:
+			for i := 0; i+1 < len(args); i += 2 {
+				stage.env = setEnv(stage.env, args[i], unquote(args[i+1]))
+			}
+		case command.Run:
+			if node.Attributes["json"] {
+				stage.run = append(stage.run, shellJoin(args))
+			} else {
+				stage.run = append(stage.run, strings.Join(args, " "))
+			}
+		case command.Cmd:
+			stage.cmd = args
+			stage.shellForm = !node.Attributes["json"]
+		case command.Entrypoint:
+			stage.entrypoint = args
+			stage.shellForm = !node.Attributes["json"]
+			// as in docker, setting ENTRYPOINT resets CMD
+			stage.cmd = nil
+		case command.Expose:
+			for _, arg := range args {
+				endpoint, err := parsePort(arg)
+				if err != nil {
+					b.unmappedf("line %d: %v", node.StartLine, err)
+					continue
+				}
+				info.ports = appendEndpoint(info.ports, endpoint)
+			}
+		case command.Volume:
+			stage.volumes = append(stage.volumes, args...)
+		case command.Copy, command.Add:
+			// sources are synchronized into the container in Dev mode, and copied by the image build in Deploy mode
+		default:
+			b.unmappedf("line %d: instruction %q", node.StartLine, node.Original)
+		}
+	}
+	return info, nil
+}
+
+// commandLine returns the command line to run the stage, based on its ENTRYPOINT and CMD instructions
+func (o dockerfileStage) commandLine() string {
+	parts := append(append([]string{}, o.entrypoint...), o.cmd...)
+	if len(parts) == 0 {
+		return ""
+	}
+	if o.shellForm {
+		return strings.Join(parts, " ")
+	}
+	return shellJoin(parts)
+}
+
+// nodeArgs returns the arguments of a Dockerfile instruction
+func nodeArgs(node *parser.Node) []string {
+	var args []string
+	for next := node.Next; next != nil; next = next.Next {
+		args = append(args, next.Value)
+	}
+	return args
+}
+
+// parsePort parses a port definition of the form PORT[/PROTOCOL]
+func parsePort(s string) (v1alpha2.Endpoint, error) {
+	portStr, protocol, _ := strings.Cut(s, "/")
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return v1alpha2.Endpoint{}, fmt.Errorf("invalid port %q", s)
+	}
+	endpoint := v1alpha2.Endpoint{
+		Name:       endpointName(port),
+		TargetPort: port,
+	}
+	switch strings.ToLower(protocol) {
+	case "", "tcp":
+	case "udp":
+		endpoint.Protocol = v1alpha2.UDPEndpointProtocol
+	default:
+		return v1alpha2.Endpoint{}, fmt.Errorf("unsupported protocol for port %q", s)
+	}
+	return endpoint, nil
+}
+
+// appendEndpoint appends endpoint to endpoints, if an endpoint with the same port is not already defined
+func appendEndpoint(endpoints []v1alpha2.Endpoint, endpoint v1alpha2.Endpoint) []v1alpha2.Endpoint {
+	for _, e := range endpoints {
+		if e.TargetPort == endpoint.TargetPort {
+			return endpoints
+		}
+	}
+	return append(endpoints, endpoint)
+}
+
+// setEnv sets the value of the variable name in env, replacing a previous value if any
+func setEnv(env []v1alpha2.EnvVar, name string, value string) []v1alpha2.EnvVar {
+	for i := range env {
+		if env[i].Name == name {
+			env[i].Value = value
+			return env
+		}
+	}
+	return append(env, v1alpha2.EnvVar{Name: name, Value: value})
+}
+
+// unquote removes the quotes surrounding s, if any
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// shellJoin joins the arguments into a command line, quoting the arguments when necessary
+func shellJoin(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"\\$`&|;<>()*?") {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		quoted = append(quoted, arg)
+	}
+	return strings.Join(quoted, " ")
+}
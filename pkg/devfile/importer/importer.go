@@ -0,0 +1,159 @@
+// Package importer generates a Devfile from existing descriptions of a project,
+// such as a Dockerfile, a Compose file or Kubernetes manifests.
+//
+// The generated Devfile contains:
+// - container components to run the project in Dev mode,
+// - image and kubernetes components, along with the apply commands to build and deploy them in Deploy mode.
+//
+// Elements of the sources that cannot be represented in a Devfile are not silently ignored,
+// but reported in the result, so the user can complete the Devfile manually.
+package importer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+)
+
+// Source is the type of description a Devfile can be generated from
+type Source string
+
+const (
+	SourceCompose    Source = "compose"
+	SourceDockerfile Source = "dockerfile"
+	SourceKubernetes Source = "k8s"
+)
+
+// Sources lists the supported sources
+var Sources = []Source{SourceCompose, SourceDockerfile, SourceKubernetes}
+
+// defaultPaths lists, for each source, the paths relative to the project directory
+// in which the source is searched when no path is given explicitly
+var defaultPaths = map[Source][]string{
+	SourceCompose:    {"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"},
+	SourceDockerfile: {"Dockerfile", "Containerfile"},
+	SourceKubernetes: {"k8s", "kubernetes", "manifests", "deploy"},
+}
+
+// Result is the result of the generation of a Devfile
+type Result struct {
+	// Data is the content of the generated Devfile
+	Data data.DevfileData
+	// Unmapped lists the elements of the sources which could not be represented in the Devfile
+	Unmapped []string
+}
+
+// ParseSource returns the source corresponding to the value s, or an error if the source is not supported
+func ParseSource(s string) (Source, error) {
+	for _, source := range Sources {
+		if string(source) == s {
+			return source, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported source %q, supported values are: %v", s, Sources)
+}
+
+// FindSourcePath returns the path of the description of the given source in dir.
+// If path is not empty, it is returned (made absolute relatively to dir).
+// Otherwise, the default locations for this source are searched in dir.
+func FindSourcePath(fs filesystem.Filesystem, source Source, dir string, path string) (string, error) {
+	if path != "" {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		if _, err := fs.Stat(path); err != nil {
+			return "", err
+		}
+		return path, nil
+	}
+	for _, candidate := range defaultPaths[source] {
+		candidatePath := filepath.Join(dir, candidate)
+		_, err := fs.Stat(candidatePath)
+		if err == nil {
+			return candidatePath, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("no %s source found in %q (searched %v)", source, dir, defaultPaths[source])
+}
+
+// Import generates a Devfile for the component name, from the source located at path.
+// dir is the directory of the project, in which the Devfile will be written;
+// relative paths referenced in the sources are considered relative to dir.
+func Import(fs filesystem.Filesystem, source Source, dir string, path string, name string) (Result, error) {
+	content, err := readSource(fs, source, path)
+	if err != nil {
+		return Result{}, err
+	}
+
+	b := newBuilder(name)
+	switch source {
+	case SourceCompose:
+		err = importCompose(b, fs, dir, filepath.Dir(path), content[0])
+	case SourceDockerfile:
+		err = importDockerfile(b, dir, path, content[0])
+	case SourceKubernetes:
+		err = importKubernetes(b, content)
+	default:
+		err = fmt.Errorf("unsupported source %q", source)
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	devfileData, err := b.build()
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		Data:     devfileData,
+		Unmapped: b.unmapped,
+	}, nil
+}
+
+// readSource returns the content of the source at path.
+// For Kubernetes manifests, path can be a directory, in which case the content of all the YAML files
+// in this directory are returned.
+func readSource(fs filesystem.Filesystem, source Source, path string) ([][]byte, error) {
+	info, err := fs.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		content, err := fs.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{content}, nil
+	}
+
+	if source != SourceKubernetes {
+		return nil, fmt.Errorf("%q is a directory, a file is expected for source %s", path, source)
+	}
+	entries, err := fs.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var result [][]byte
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		content, err := fs.ReadFile(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, content)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no YAML file found in directory %q", path)
+	}
+	return result, nil
+}
@@ -0,0 +1,300 @@
+package importer
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+)
+
+const projectDir = "/project"
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Source
+		files  map[string]string
+		// wantContainers are the images of the container components, by component name
+		wantContainers map[string]string
+		// wantEndpoints are the ports of the container components, by component name
+		wantEndpoints map[string][]int
+		// wantMountSources is the name of the container in which sources are synchronized
+		wantMountSources string
+		wantRunCommand   string
+		wantImages       []string
+		wantKubernetes   []string
+		wantUnmapped     []string
+		wantErr          bool
+	}{
+		{
+			name:   "Dockerfile",
+			source: SourceDockerfile,
+			files: map[string]string{
+				"Dockerfile": `FROM node:18
+WORKDIR /opt/app
+ENV NODE_ENV=development PORT=3000
+COPY package.json .
+RUN npm install
+EXPOSE 3000
+USER node
+CMD ["npm", "start"]
+`,
+			},
+			wantContainers:   map[string]string{"my-app": "node:18"},
+			wantEndpoints:    map[string][]int{"my-app": {3000}},
+			wantMountSources: "my-app",
+			wantRunCommand:   "npm start",
+			wantImages:       []string{"my-app-image"},
+			wantKubernetes:   []string{"my-app-deployment", "my-app-service"},
+			wantUnmapped:     []string{`line 7: instruction "USER node"`},
+		},
+		{
+			name:   "Dockerfile without FROM",
+			source: SourceDockerfile,
+			files: map[string]string{
+				"Dockerfile": "RUN echo hello\n",
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Compose file",
+			source: SourceCompose,
+			files: map[string]string{
+				"docker-compose.yaml": `services:
+  web:
+    build: .
+    ports:
+      - "8080:3000"
+    environment:
+      DB_HOST: db
+    volumes:
+      - .:/app
+    command: npm run dev
+    depends_on:
+      - db
+  db:
+    image: postgres:15
+    environment:
+      - POSTGRES_PASSWORD
+      - POSTGRES_USER=user
+    volumes:
+      - dbdata:/var/lib/postgresql/data
+    expose:
+      - "5432"
+volumes:
+  dbdata: {}
+`,
+			},
+			wantContainers:   map[string]string{"web": "web", "db": "postgres:15"},
+			wantEndpoints:    map[string][]int{"web": {3000}, "db": {5432}},
+			wantMountSources: "web",
+			wantRunCommand:   "npm run dev",
+			wantImages:       []string{"web-image"},
+			wantKubernetes:   []string{"db-deployment", "db-service", "web-deployment", "web-service"},
+			wantUnmapped: []string{
+				`service "db": environment "POSTGRES_PASSWORD" without value`,
+				`service "web": "depends_on"`,
+				`service "db": volumes are not deployed in Deploy mode`,
+			},
+		},
+		{
+			name:   "Compose file with a build context in a subdirectory",
+			source: SourceCompose,
+			files: map[string]string{
+				"docker-compose.yaml": `services:
+  api:
+    build: ./api
+    ports:
+      - "8080:8080"
+`,
+				"api/Dockerfile": `FROM golang:1.19
+WORKDIR /src
+CMD ["go", "run", "."]
+`,
+			},
+			wantContainers:   map[string]string{"api": "api"},
+			wantEndpoints:    map[string][]int{"api": {8080}},
+			wantMountSources: "api",
+			wantRunCommand:   "go run .",
+			wantImages:       []string{"api-image"},
+			wantKubernetes:   []string{"api-deployment", "api-service"},
+		},
+		{
+			name:   "Kubernetes manifests",
+			source: SourceKubernetes,
+			files: map[string]string{
+				"k8s/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+spec:
+  selector:
+    matchLabels:
+      app: api
+  template:
+    metadata:
+      labels:
+        app: api
+    spec:
+      containers:
+      - name: api
+        image: quay.io/example/api:latest
+        command: ["./api"]
+        args: ["--port", "8080"]
+        ports:
+        - containerPort: 8080
+        env:
+        - name: LOG_LEVEL
+          value: debug
+        - name: PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: api
+              key: password
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: api
+spec:
+  ports:
+  - port: 8080
+`,
+			},
+			wantContainers:   map[string]string{"my-app": "quay.io/example/api:latest"},
+			wantEndpoints:    map[string][]int{"my-app": {8080}},
+			wantMountSources: "my-app",
+			wantRunCommand:   "./api --port 8080",
+			wantKubernetes:   []string{"deployment-api", "service-api"},
+			wantUnmapped: []string{
+				`container "api": environment variable "PASSWORD" referencing a value from another resource`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			for name, content := range tt.files {
+				path := filepath.Join(projectDir, name)
+				if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			path, err := FindSourcePath(fs, tt.source, projectDir, "")
+			if err != nil {
+				t.Fatalf("unexpected error finding source: %v", err)
+			}
+
+			result, err := Import(fs, tt.source, projectDir, path, "my-app")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Import() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			containers, err := result.Data.GetDevfileContainerComponents(common.DevfileOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotContainers := map[string]string{}
+			gotEndpoints := map[string][]int{}
+			var gotMountSources string
+			for _, container := range containers {
+				gotContainers[container.Name] = container.Container.Image
+				for _, endpoint := range container.Container.Endpoints {
+					gotEndpoints[container.Name] = append(gotEndpoints[container.Name], endpoint.TargetPort)
+				}
+				if container.Container.MountSources != nil && *container.Container.MountSources {
+					gotMountSources = container.Name
+				}
+			}
+			if diff := cmp.Diff(tt.wantContainers, gotContainers); diff != "" {
+				t.Errorf("containers mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantEndpoints, gotEndpoints); diff != "" {
+				t.Errorf("endpoints mismatch (-want +got):\n%s", diff)
+			}
+			if gotMountSources != tt.wantMountSources {
+				t.Errorf("expected sources mounted in %q, got %q", tt.wantMountSources, gotMountSources)
+			}
+
+			runCommands, err := result.Data.GetCommands(common.DevfileOptions{
+				CommandOptions: common.CommandOptions{CommandGroupKind: v1alpha2.RunCommandGroupKind},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotRunCommand string
+			if len(runCommands) > 0 {
+				gotRunCommand = runCommands[0].Exec.CommandLine
+			}
+			if gotRunCommand != tt.wantRunCommand {
+				t.Errorf("expected run command %q, got %q", tt.wantRunCommand, gotRunCommand)
+			}
+
+			checkComponentNames(t, result, v1alpha2.ImageComponentType, tt.wantImages)
+			checkComponentNames(t, result, v1alpha2.KubernetesComponentType, tt.wantKubernetes)
+
+			deployCommands, err := result.Data.GetCommands(common.DevfileOptions{
+				CommandOptions: common.CommandOptions{CommandGroupKind: v1alpha2.DeployCommandGroupKind},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(deployCommands) != 1 || deployCommands[0].Composite == nil {
+				t.Errorf("expected a single composite deploy command, got %v", deployCommands)
+			}
+
+			for _, want := range tt.wantUnmapped {
+				found := false
+				for _, got := range result.Unmapped {
+					if got == want {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("expected %q to be reported as unmapped, got:\n%s", want, strings.Join(result.Unmapped, "\n"))
+				}
+			}
+		})
+	}
+}
+
+func checkComponentNames(t *testing.T, result Result, typ v1alpha2.ComponentType, want []string) {
+	components, err := result.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: typ},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, component := range components {
+		got = append(got, component.Name)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("%s components mismatch (-want +got):\n%s", typ, diff)
+	}
+}
+
+func TestParseSource(t *testing.T) {
+	for _, source := range Sources {
+		got, err := ParseSource(string(source))
+		if err != nil || got != source {
+			t.Errorf("ParseSource(%q) = %q, %v", source, got, err)
+		}
+	}
+	if _, err := ParseSource("helm"); err == nil {
+		t.Errorf("expected an error for an unsupported source")
+	}
+}
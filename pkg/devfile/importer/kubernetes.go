@@ -0,0 +1,179 @@
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// workloadKinds lists the kinds of resources from which a Dev container can be defined,
+// and the path of the pod template spec in these resources
+var workloadKinds = map[string][]string{
+	"Deployment":  {"spec", "template", "spec"},
+	"StatefulSet": {"spec", "template", "spec"},
+	"DaemonSet":   {"spec", "template", "spec"},
+	"Pod":         {"spec"},
+}
+
+// importKubernetes adds to the builder:
+// - a kubernetes component and an apply command for each resource defined in the manifests,
+// - a container component based on the first container of the first workload found in the manifests.
+func importKubernetes(b *builder, contents [][]byte) error {
+	var resources []unstructured.Unstructured
+	for _, content := range contents {
+		decoded, err := decodeManifests(content)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, decoded...)
+	}
+	if len(resources) == 0 {
+		return errors.New("no Kubernetes resource found in the manifests")
+	}
+
+	devContainerDefined := false
+	for _, resource := range resources {
+		name := componentName(fmt.Sprintf("%s-%s", resource.GetKind(), resource.GetName()))
+		manifest, err := toYAML(&resource)
+		if err != nil {
+			return err
+		}
+		b.addManifest(name, manifest)
+
+		if devContainerDefined {
+			continue
+		}
+		podSpecPath, isWorkload := workloadKinds[resource.GetKind()]
+		if !isWorkload {
+			continue
+		}
+		podSpecContent, found, err := unstructured.NestedMap(resource.Object, podSpecPath...)
+		if err != nil || !found {
+			b.unmappedf("%s %q: no pod spec found", resource.GetKind(), resource.GetName())
+			continue
+		}
+		var podSpec corev1.PodSpec
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecContent, &podSpec); err != nil {
+			b.unmappedf("%s %q: invalid pod spec: %v", resource.GetKind(), resource.GetName(), err)
+			continue
+		}
+		if len(podSpec.Containers) == 0 {
+			continue
+		}
+		importPodContainer(b, resource, podSpec)
+		devContainerDefined = true
+	}
+
+	if !devContainerDefined {
+		b.unmappedf("no workload found in the manifests, no container has been defined for Dev mode")
+	}
+	return nil
+}
+
+// importPodContainer adds a container component and a run command, based on the first container of the pod
+func importPodContainer(b *builder, resource unstructured.Unstructured, podSpec corev1.PodSpec) {
+	container := podSpec.Containers[0]
+	if len(podSpec.Containers) > 1 {
+		b.unmappedf("%s %q: only the container %q is used in Dev mode", resource.GetKind(), resource.GetName(), container.Name)
+	}
+	if len(podSpec.InitContainers) > 0 {
+		b.unmappedf("%s %q: init containers are not used in Dev mode", resource.GetKind(), resource.GetName())
+	}
+
+	devContainer := v1alpha2.Container{
+		Image:         container.Image,
+		MemoryLimit:   container.Resources.Limits.Memory().String(),
+		MemoryRequest: container.Resources.Requests.Memory().String(),
+		CpuLimit:      container.Resources.Limits.Cpu().String(),
+		CpuRequest:    container.Resources.Requests.Cpu().String(),
+	}
+	// Quantity.String() returns "0" for undefined quantities
+	for _, field := range []*string{&devContainer.MemoryLimit, &devContainer.MemoryRequest, &devContainer.CpuLimit, &devContainer.CpuRequest} {
+		if *field == "0" {
+			*field = ""
+		}
+	}
+
+	for _, env := range container.Env {
+		if env.ValueFrom != nil {
+			b.unmappedf("container %q: environment variable %q referencing a value from another resource", container.Name, env.Name)
+			continue
+		}
+		devContainer.Env = append(devContainer.Env, v1alpha2.EnvVar{Name: env.Name, Value: env.Value})
+	}
+	if len(container.EnvFrom) > 0 {
+		b.unmappedf("container %q: envFrom", container.Name)
+	}
+	if len(container.VolumeMounts) > 0 {
+		b.unmappedf("container %q: volume mounts", container.Name)
+	}
+
+	var endpoints []v1alpha2.Endpoint
+	for _, port := range container.Ports {
+		endpoint := v1alpha2.Endpoint{
+			Name:       endpointName(int(port.ContainerPort)),
+			TargetPort: int(port.ContainerPort),
+		}
+		if port.Protocol == corev1.ProtocolUDP {
+			endpoint.Protocol = v1alpha2.UDPEndpointProtocol
+		}
+		endpoints = appendEndpoint(endpoints, endpoint)
+	}
+
+	sourceMapping := container.WorkingDir
+	if sourceMapping == "" {
+		sourceMapping = defaultSourceMapping
+	}
+	containerName := componentName(b.name)
+	b.addContainer(containerName, devContainer, endpoints, true, sourceMapping)
+
+	commandLine := shellJoin(append(append([]string{}, container.Command...), container.Args...))
+	if commandLine == "" {
+		b.unmappedf("container %q: no command defined, no run command has been defined", container.Name)
+		return
+	}
+	b.addExecCommand("run", v1alpha2.RunCommandGroupKind, containerName, commandLine, sourceMapping)
+}
+
+// decodeManifests decodes the resources defined in a multi-documents YAML content
+func decodeManifests(content []byte) ([]unstructured.Unstructured, error) {
+	var result []unstructured.Unstructured
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(content), 4096)
+	for {
+		var obj map[string]interface{}
+		err := decoder.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse Kubernetes manifests: %w", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		u := unstructured.Unstructured{Object: obj}
+		if u.IsList() {
+			err = u.EachListItem(func(item runtime.Object) error {
+				result = append(result, *item.(*unstructured.Unstructured))
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if u.GetKind() == "" || strings.TrimSpace(u.GetName()) == "" {
+			return nil, fmt.Errorf("invalid Kubernetes resource, kind and name are required: %v", obj)
+		}
+		result = append(result, u)
+	}
+	return result, nil
+}
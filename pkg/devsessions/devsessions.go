@@ -0,0 +1,318 @@
+package devsessions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/state"
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+	"github\.com/danielpickens/astra/pkg/util"
+)
+
+const (
+	registryFileName = "projects.json"
+	// logFileName is the name of the file, in the .astra directory of a project, in which the output of the sessions started by StartSession is written
+	logFileName = "astra-dev.log"
+	// stopTimeout is the time given to a dev session to terminate after being interrupted, before it is killed
+	stopTimeout = 2 * time.Minute
+	// lockTimeout is the time given to acquire the lock of the registry file, before returning an error
+	lockTimeout = 10 * time.Second
+	// staleLockAge is the age after which a lock of the registry file is considered to be left by a terminated process
+	staleLockAge = time.Minute
+)
+
+var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
+
+// registry is the content of the registry file
+type registry struct {
+	Directories []string `json:"directories"`
+}
+
+type DevSessionsClient struct {
+	fs           filesystem.Filesystem
+	stateClient  state.Client
+	registryFile string
+}
+
+var _ Client = (*DevSessionsClient)(nil)
+
+func NewDevSessionsClient(fs filesystem.Filesystem, stateClient state.Client, registryFile string) *DevSessionsClient {
+	return &DevSessionsClient{
+		fs:           fs,
+		stateClient:  stateClient,
+		registryFile: registryFile,
+	}
+}
+
+// GetRegistryFile returns the path of the file containing the list of registered project directories
+func GetRegistryFile() (string, error) {
+	if len(customHomeDir) != 0 {
+		return filepath.Join(customHomeDir, ".astra", registryFileName), nil
+	}
+
+	currentUser, err := user.Current()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(currentUser.HomeDir, ".astra", registryFileName), nil
+}
+
+// RegisterDirectory adds the directory to the list of project directories,
+// and removes the state files of the terminated dev sessions of the registered directories
+func (o *DevSessionsClient) RegisterDirectory(directory string) error {
+	directory, err := filepath.Abs(directory)
+	if err != nil {
+		return err
+	}
+	unlock, err := o.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	content, err := o.read()
+	if err != nil {
+		return err
+	}
+	o.pruneSessions(content.Directories)
+	for _, dir := range content.Directories {
+		if dir == directory {
+			return nil
+		}
+	}
+	content.Directories = append(content.Directories, directory)
+	sort.Strings(content.Directories)
+	return o.write(content)
+}
+
+func (o *DevSessionsClient) UnregisterDirectory(directory string) error {
+	directory, err := filepath.Abs(directory)
+	if err != nil {
+		return err
+	}
+	unlock, err := o.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	content, err := o.read()
+	if err != nil {
+		return err
+	}
+	directories := make([]string, 0, len(content.Directories))
+	for _, dir := range content.Directories {
+		if dir != directory {
+			directories = append(directories, dir)
+		}
+	}
+	if len(directories) == len(content.Directories) {
+		return fmt.Errorf("directory %q is not registered", directory)
+	}
+	content.Directories = directories
+	return o.write(content)
+}
+
+func (o *DevSessionsClient) ListDirectories() ([]string, error) {
+	content, err := o.read()
+	if err != nil {
+		return nil, err
+	}
+	return content.Directories, nil
+}
+
+func (o *DevSessionsClient) ListSessions(ctx context.Context) ([]api.DevSession, error) {
+	directories, err := o.ListDirectories()
+	if err != nil {
+		return nil, err
+	}
+	result := []api.DevSession{}
+	for _, directory := range directories {
+		sessions, err := o.stateClient.GetDevSessions(ctx, directory)
+		if err != nil {
+			// a project directory not readable anymore must not prevent listing the other sessions
+			klog.V(2).Infof("unable to get the dev sessions running in %q: %v", directory, err)
+			continue
+		}
+		result = append(result, sessions...)
+	}
+	return result, nil
+}
+
+func (o *DevSessionsClient) GetSession(ctx context.Context, pid int) (api.DevSession, error) {
+	sessions, err := o.ListSessions(ctx)
+	if err != nil {
+		return api.DevSession{}, err
+	}
+	for _, session := range sessions {
+		if session.PID == pid {
+			return session, nil
+		}
+	}
+	return api.DevSession{}, NewErrSessionNotFound(pid)
+}
+
+func (o *DevSessionsClient) StartSession(ctx context.Context, directory string, args []string) (int, error) {
+	directory, err := filepath.Abs(directory)
+	if err != nil {
+		return 0, err
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the path of the astra executable: %w", err)
+	}
+
+	logDir := filepath.Join(directory, util.DotastraDirectory)
+	err = o.fs.MkdirAll(logDir, 0750)
+	if err != nil {
+		return 0, err
+	}
+	logFile, err := o.fs.OpenFile(filepath.Join(logDir, logFileName), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, err
+	}
+
+	// The session must survive the request that started it, it is not bound to ctx
+	cmd := exec.Command(executable, append([]string{"dev"}, args...)...) //nolint:gosec
+	cmd.Dir = directory
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	err = cmd.Start()
+	if err != nil {
+		_ = logFile.Close()
+		return 0, fmt.Errorf("unable to start the dev session in %q: %w", directory, err)
+	}
+	pid := cmd.Process.Pid
+	go func() {
+		// Release the resources of the process when it terminates
+		waitErr := cmd.Wait()
+		klog.V(2).Infof("dev session %d in %q terminated: %v", pid, directory, waitErr)
+		_ = logFile.Close()
+	}()
+
+	err = o.RegisterDirectory(directory)
+	if err != nil {
+		klog.V(1).Infof("unable to register the directory %q: %v", directory, err)
+	}
+	return pid, nil
+}
+
+func (o *DevSessionsClient) StopSession(ctx context.Context, pid int) error {
+	// Only the processes of known sessions can be stopped
+	_, err := o.GetSession(ctx, pid)
+	if err != nil {
+		return err
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	err = process.Signal(os.Interrupt)
+	if err != nil {
+		// Interrupt is not supported on all platforms
+		klog.V(2).Infof("unable to interrupt process %d, killing it: %v", pid, err)
+		return process.Kill()
+	}
+	go func() {
+		// Kill the session if it is not terminated after the cleanup of its resources
+		<-time.After(stopTimeout)
+		if _, err := o.GetSession(context.Background(), pid); err == nil {
+			klog.V(1).Infof("dev session %d not terminated after %s, killing it", pid, stopTimeout)
+			_ = process.Kill()
+		}
+	}()
+	return nil
+}
+
+func (o *DevSessionsClient) read() (registry, error) {
+	var content registry
+	jsonContent, err := o.fs.ReadFile(o.registryFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return registry{}, nil
+		}
+		return registry{}, err
+	}
+	err = json.Unmarshal(jsonContent, &content)
+	if err != nil {
+		return registry{}, fmt.Errorf("unable to parse %q: %w", o.registryFile, err)
+	}
+	return content, nil
+}
+
+// write replaces the content of the registry file.
+// The content is written in a temporary file first, so the registry file is never read partially written
+func (o *DevSessionsClient) write(content registry) error {
+	jsonContent, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = o.fs.MkdirAll(filepath.Dir(o.registryFile), 0750)
+	if err != nil {
+		return err
+	}
+	tmpFile := o.registryFile + ".tmp"
+	err = o.fs.WriteFile(tmpFile, jsonContent, 0600)
+	if err != nil {
+		return err
+	}
+	return o.fs.Rename(tmpFile, o.registryFile)
+}
+
+// lock acquires the lock of the registry file, shared by all the astra processes, and returns the function releasing it.
+// A lock older than staleLockAge is considered to be left by a terminated process, and is acquired anyway
+func (o *DevSessionsClient) lock() (unlock func(), err error) {
+	lockFile := o.registryFile + ".lock"
+	err = o.fs.MkdirAll(filepath.Dir(lockFile), 0750)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		var file filesystem.File
+		file, err = o.fs.OpenFile(lockFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_ = file.Close()
+			return func() {
+				if err := o.fs.Remove(lockFile); err != nil {
+					klog.V(2).Infof("unable to release the lock %q: %v", lockFile, err)
+				}
+			}, nil
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+		if info, statErr := o.fs.Stat(lockFile); statErr == nil && time.Since(info.ModTime()) > staleLockAge {
+			klog.V(2).Infof("removing the stale lock %q", lockFile)
+			_ = o.fs.Remove(lockFile)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("unable to lock %q: the file is locked by another astra process", o.registryFile)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// pruneSessions removes the state files of the terminated dev sessions of the directories.
+// The errors are only logged, as they must not prevent registering a directory
+func (o *DevSessionsClient) pruneSessions(directories []string) {
+	for _, directory := range directories {
+		err := o.stateClient.PruneDevSessions(context.Background(), directory)
+		if err != nil {
+			klog.V(2).Infof("unable to prune the dev sessions of %q: %v", directory, err)
+		}
+	}
+}
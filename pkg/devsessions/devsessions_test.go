@@ -0,0 +1,192 @@
+package devsessions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/state"
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+	"github\.com/danielpickens/astra/pkg/testingutil/system"
+)
+
+func TestDevSessionsClient_Directories(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	client := NewDevSessionsClient(fs, state.NewStateClient(fs, system.Fake{}), "/home/user/.astra/projects.json")
+
+	directories, err := client.ListDirectories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(directories) != 0 {
+		t.Errorf("expected no directory, got %v", directories)
+	}
+
+	for _, dir := range []string{"/projects/b", "/projects/a", "/projects/b"} {
+		if err = client.RegisterDirectory(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+	directories, err = client.ListDirectories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"/projects/a", "/projects/b"}, directories); diff != "" {
+		t.Errorf("ListDirectories() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err = client.UnregisterDirectory("/projects/a"); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.UnregisterDirectory("/projects/c"); err == nil {
+		t.Errorf("expected an error when unregistering a directory not registered")
+	}
+	directories, err = client.ListDirectories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"/projects/b"}, directories); diff != "" {
+		t.Errorf("ListDirectories() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDevSessionsClient_ListSessions(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	writeState := func(directory string, pid int, platform string) {
+		jsonContent, err := json.Marshal(state.Content{PID: pid, Platform: platform})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = fs.WriteFile(filepath.Join(directory, ".astra", fmt.Sprintf("devstate.%d.json", pid)), jsonContent, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeState("/projects/a", 1, "cluster")
+	writeState("/projects/b", 2, "podman")
+	// process 3 does not exist anymore
+	writeState("/projects/b", 3, "cluster")
+	// directory not registered
+	writeState("/projects/c", 4, "cluster")
+
+	stateClient := state.NewStateClient(fs, system.Fake{
+		PidTable: map[int]string{1: "astra", 2: "astra", 4: "astra"},
+	})
+	client := NewDevSessionsClient(fs, stateClient, "/home/user/.astra/projects.json")
+	for _, dir := range []string{"/projects/a", "/projects/b"} {
+		if err := client.RegisterDirectory(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sessions, err := client.ListSessions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []api.DevSession{
+		{PID: 1, Directory: "/projects/a", Platform: "cluster"},
+		{PID: 2, Directory: "/projects/b", Platform: "podman"},
+	}
+	if diff := cmp.Diff(want, sessions); diff != "" {
+		t.Errorf("ListSessions() mismatch (-want +got):\n%s", diff)
+	}
+
+	session, err := client.GetSession(context.Background(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[1], session); diff != "" {
+		t.Errorf("GetSession() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = client.GetSession(context.Background(), 4)
+	if !errors.As(err, &ErrSessionNotFound{}) {
+		t.Errorf("expected ErrSessionNotFound, got %v", err)
+	}
+}
+
+func TestDevSessionsClient_RegisterDirectory_prune(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	for _, pid := range []int{1, 2} {
+		jsonContent, err := json.Marshal(state.Content{PID: pid, Platform: "cluster"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = fs.WriteFile(filepath.Join("/projects/a", ".astra", fmt.Sprintf("devstate.%d.json", pid)), jsonContent, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// process 2 does not exist anymore
+	stateClient := state.NewStateClient(fs, system.Fake{
+		PidTable: map[int]string{1: "astra"},
+	})
+	client := NewDevSessionsClient(fs, stateClient, "/home/user/.astra/projects.json")
+	for _, dir := range []string{"/projects/a", "/projects/b"} {
+		if err := client.RegisterDirectory(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := fs.Stat("/projects/a/.astra/devstate.1.json"); err != nil {
+		t.Errorf("expected the state file of the running session to be kept: %v", err)
+	}
+	if _, err := fs.Stat("/projects/a/.astra/devstate.2.json"); err == nil {
+		t.Errorf("expected the state file of the terminated session to be removed")
+	}
+	if _, err := fs.Stat("/home/user/.astra/projects.json.lock"); err == nil {
+		t.Errorf("expected the lock of the registry file to be released")
+	}
+}
+
+func TestDevSessionsClient_lock(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	client := NewDevSessionsClient(fs, nil, "/home/user/.astra/projects.json")
+
+	unlock, err := client.lock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the lock is not acquired again before being released
+	acquired := make(chan struct{})
+	go func() {
+		unlock2, err := client.lock()
+		if err == nil {
+			unlock2()
+		}
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("the lock has been acquired twice")
+	case <-time.After(200 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lock has not been acquired after being released")
+	}
+
+	// a stale lock is acquired anyway
+	err = fs.WriteFile("/home/user/.astra/projects.json.lock", nil, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = fs.Chtimes("/home/user/.astra/projects.json.lock", time.Now().Add(-2*staleLockAge), time.Now().Add(-2*staleLockAge))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock, err = client.lock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+}
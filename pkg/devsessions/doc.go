@@ -0,0 +1,5 @@
+// Package devsessions gives access to the dev sessions running in the project directories registered by the user.
+// The list of project directories is stored in the file ~/.astra/projects.json; the directory of a project is registered
+// each time `astra dev` is run in it. The dev sessions running in a directory are discovered from the state files
+// of the directory (see package state).
+package devsessions
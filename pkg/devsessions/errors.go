@@ -0,0 +1,15 @@
+package devsessions
+
+import "fmt"
+
+type ErrSessionNotFound struct {
+	pid int
+}
+
+func NewErrSessionNotFound(pid int) ErrSessionNotFound {
+	return ErrSessionNotFound{pid: pid}
+}
+
+func (e ErrSessionNotFound) Error() string {
+	return fmt.Sprintf("no dev session running with PID %d", e.pid)
+}
@@ -0,0 +1,27 @@
+package devsessions
+
+import (
+	"context"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+type Client interface {
+	// RegisterDirectory adds the directory to the list of project directories in which dev sessions are searched,
+	// and removes the state files of the terminated dev sessions of the registered directories
+	RegisterDirectory(directory string) error
+	// UnregisterDirectory removes the directory from the list of project directories
+	UnregisterDirectory(directory string) error
+	// ListDirectories returns the list of registered project directories
+	ListDirectories() ([]string, error)
+	// ListSessions returns the dev sessions running in all the registered project directories
+	ListSessions(ctx context.Context) ([]api.DevSession, error)
+	// GetSession returns the dev session running with the given PID, or an ErrSessionNotFound error
+	GetSession(ctx context.Context, pid int) (api.DevSession, error)
+	// StartSession starts `astra dev` in the background in the given directory, with the given arguments,
+	// and returns the PID of the process. The output of the process is written in the file .astra/astra-dev.log
+	// of the directory, and the directory is registered.
+	StartSession(ctx context.Context, directory string, args []string) (int, error)
+	// StopSession stops the dev session running with the given PID, the same way as if Ctrl-c was pressed
+	StopSession(ctx context.Context, pid int) error
+}
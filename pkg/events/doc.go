@@ -0,0 +1,4 @@
+// package events provides a service to publish the events of a Dev session
+// (file synchronization, commands, pods, port forwarding, warnings and errors)
+// to the subscribers interested in them, like the API server
+package events
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+)
+
+// subscriberBufferSize is the number of events kept for a subscriber not reading them fast enough.
+// Further events are dropped for this subscriber, so a slow subscriber never blocks the Dev session
+const subscriberBufferSize = 100
+
+type EventsClient struct {
+	mu          sync.Mutex
+	subscribers map[chan Event]struct{}
+}
+
+func NewEventsClient() *EventsClient {
+	return &EventsClient{
+		subscribers: map[chan Event]struct{}{},
+	}
+}
+
+// Publish sends an event to all the current subscribers, without blocking.
+// Publishing on a nil client does nothing
+func (o *EventsClient) Publish(eventType Type, data interface{}) {
+	if o == nil {
+		return
+	}
+	event := Event{
+		Type: eventType,
+		Time: time.Now(),
+		Data: data,
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for subscriber := range o.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			klog.V(4).Infof("subscriber not ready, dropping event %s", eventType)
+		}
+	}
+}
+
+// PublishError publishes an Error event with the message of err
+func (o *EventsClient) PublishError(err error) {
+	o.Publish(Error, ErrorData{Message: err.Error()})
+}
+
+// Subscribe returns a channel on which the events published after the subscription are received.
+// The subscription is cancelled and the channel is closed when ctx is done.
+// Subscribing on a nil client returns a channel receiving no events
+func (o *EventsClient) Subscribe(ctx context.Context) <-chan Event {
+	ch := make(chan Event, subscriberBufferSize)
+	if o == nil {
+		return ch
+	}
+	o.mu.Lock()
+	o.subscribers[ch] = struct{}{}
+	o.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		o.mu.Lock()
+		delete(o.subscribers, ch)
+		close(ch)
+		o.mu.Unlock()
+	}()
+	return ch
+}
@@ -0,0 +1,145 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/client-go/util/exec"
+
+	"github\.com/danielpickens/astra/pkg/libdevfile"
+)
+
+func TestEventsClient(t *testing.T) {
+	client := NewEventsClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	ch1 := client.Subscribe(ctx)
+	ch2 := client.Subscribe(context.Background())
+
+	client.Publish(SyncStarted, SyncData{ChangedFiles: 2})
+
+	for _, ch := range []<-chan Event{ch1, ch2} {
+		ev := <-ch
+		if ev.Type != SyncStarted {
+			t.Errorf("expected event %s, got %s", SyncStarted, ev.Type)
+		}
+		if diff := cmp.Diff(SyncData{ChangedFiles: 2}, ev.Data); diff != "" {
+			t.Errorf("event data mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	cancel()
+	if _, ok := <-ch1; ok {
+		t.Errorf("expected the channel to be closed when the context is done")
+	}
+
+	// the events are dropped for a subscriber not reading them, and Publish does not block
+	for i := 0; i < subscriberBufferSize+10; i++ {
+		client.Publish(Warning, WarningData{Message: fmt.Sprint(i)})
+	}
+	if len(ch2) != subscriberBufferSize {
+		t.Errorf("expected %d events buffered, got %d", subscriberBufferSize, len(ch2))
+	}
+
+	var nilClient *EventsClient
+	nilClient.Publish(Error, ErrorData{Message: "an error"})
+	if nilClient.Subscribe(context.Background()) == nil {
+		t.Errorf("expected a channel for a nil client")
+	}
+}
+
+func TestNewCommandHandler(t *testing.T) {
+	command := v1alpha2.Command{
+		Id: "build",
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{
+				LabeledCommand: v1alpha2.LabeledCommand{
+					BaseCommand: v1alpha2.BaseCommand{
+						Group: &v1alpha2.CommandGroup{Kind: v1alpha2.BuildCommandGroupKind},
+					},
+				},
+				Component: "runtime",
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		execErr    error
+		terminate  bool
+		wantEvents []Event
+	}{
+		{
+			name:      "terminating command succeeding",
+			terminate: true,
+			wantEvents: []Event{
+				{Type: CommandStarted, Data: CommandData{Name: "build", Kind: "build", Component: "runtime", Terminating: true}},
+				{Type: CommandExited, Data: CommandData{Name: "build", Kind: "build", Component: "runtime", Terminating: true}},
+			},
+		},
+		{
+			name:      "terminating command failing with an exit code",
+			terminate: true,
+			execErr:   fmt.Errorf("unable to exec command: %w", exec.CodeExitError{Err: errors.New("command terminated with exit code 2"), Code: 2}),
+			wantEvents: []Event{
+				{Type: CommandStarted, Data: CommandData{Name: "build", Kind: "build", Component: "runtime", Terminating: true}},
+				{Type: CommandExited, Data: CommandData{Name: "build", Kind: "build", Component: "runtime", Terminating: true, ExitCode: 2,
+					Error: "unable to exec command: command terminated with exit code 2"}},
+			},
+		},
+		{
+			name:    "non terminating command failing to start",
+			execErr: errors.New("pod not found"),
+			wantEvents: []Event{
+				{Type: CommandStarted, Data: CommandData{Name: "build", Kind: "build", Component: "runtime"}},
+				{Type: CommandExited, Data: CommandData{Name: "build", Kind: "build", Component: "runtime", ExitCode: -1, Error: "pod not found"}},
+			},
+		},
+		{
+			name: "non terminating command started",
+			wantEvents: []Event{
+				{Type: CommandStarted, Data: CommandData{Name: "build", Kind: "build", Component: "runtime"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			handler := libdevfile.NewMockHandler(ctrl)
+			if tt.terminate {
+				handler.EXPECT().ExecuteTerminatingCommand(gomock.Any(), command).Return(tt.execErr)
+			} else {
+				handler.EXPECT().ExecuteNonTerminatingCommand(gomock.Any(), command).Return(tt.execErr)
+			}
+
+			client := NewEventsClient()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			ch := client.Subscribe(ctx)
+
+			h := NewCommandHandler(client, handler)
+			var err error
+			if tt.terminate {
+				err = h.ExecuteTerminatingCommand(ctx, command)
+			} else {
+				err = h.ExecuteNonTerminatingCommand(ctx, command)
+			}
+			if !errors.Is(err, tt.execErr) {
+				t.Errorf("expected error %v, got %v", tt.execErr, err)
+			}
+
+			var got []Event
+			for len(ch) > 0 {
+				got = append(got, <-ch)
+			}
+			if diff := cmp.Diff(tt.wantEvents, got, cmpopts.IgnoreFields(Event{}, "Time")); diff != "" {
+				t.Errorf("events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
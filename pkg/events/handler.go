@@ -0,0 +1,106 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	"github\.com/danielpickens/astra/pkg/libdevfile"
+)
+
+// commandHandler is a libdevfile.Handler publishing the start and the end of the commands executed by the handler it wraps
+type commandHandler struct {
+	libdevfile.Handler
+	client *EventsClient
+}
+
+// NewCommandHandler returns a handler executing the commands with handler,
+// and publishing CommandStarted and CommandExited events to the client
+func NewCommandHandler(client *EventsClient, handler libdevfile.Handler) libdevfile.Handler {
+	if client == nil {
+		return handler
+	}
+	return &commandHandler{
+		Handler: handler,
+		client:  client,
+	}
+}
+
+func (o *commandHandler) ExecuteNonTerminatingCommand(ctx context.Context, command v1alpha2.Command) error {
+	data := newCommandData(command, false)
+	o.client.Publish(CommandStarted, data)
+	err := o.Handler.ExecuteNonTerminatingCommand(ctx, command)
+	if err != nil {
+		// the command has not been started
+		o.client.Publish(CommandExited, withExitStatus(data, err))
+	}
+	return err
+}
+
+func (o *commandHandler) ExecuteTerminatingCommand(ctx context.Context, command v1alpha2.Command) error {
+	data := newCommandData(command, true)
+	o.client.Publish(CommandStarted, data)
+	err := o.Handler.ExecuteTerminatingCommand(ctx, command)
+	o.client.Publish(CommandExited, withExitStatus(data, err))
+	return err
+}
+
+// OutputsExist checks the outputs of the command with the wrapped handler, if it is able to check them
+func (o *commandHandler) OutputsExist(ctx context.Context, command v1alpha2.Command, outputs []string) (bool, error) {
+	checker, ok := o.Handler.(libdevfile.OutputsChecker)
+	if !ok {
+		return false, nil
+	}
+	return checker.OutputsExist(ctx, command, outputs)
+}
+
+// GetTargetId identifies the container of the command with the wrapped handler, if it is able to identify it
+func (o *commandHandler) GetTargetId(ctx context.Context, command v1alpha2.Command) (string, error) {
+	identifier, ok := o.Handler.(libdevfile.TargetIdentifier)
+	if !ok {
+		return "", errors.New("unable to identify the container of the command")
+	}
+	return identifier.GetTargetId(ctx, command)
+}
+
+func newCommandData(command v1alpha2.Command, terminating bool) CommandData {
+	data := CommandData{
+		Name:        command.Id,
+		Terminating: terminating,
+	}
+	if command.Exec != nil {
+		data.Component = command.Exec.Component
+		if command.Exec.Group != nil {
+			data.Kind = string(command.Exec.Group.Kind)
+		}
+	}
+	return data
+}
+
+func withExitStatus(data CommandData, err error) CommandData {
+	data.ExitCode = exitCode(err)
+	if err != nil {
+		data.Error = err.Error()
+	}
+	return data
+}
+
+// exitCode returns the exit code of a command from the error returned when executing it,
+// or -1 if the error does not contain an exit code
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	// returned by the Kubernetes exec client
+	var exitStatus interface{ ExitStatus() int }
+	if errors.As(err, &exitStatus) {
+		return exitStatus.ExitStatus()
+	}
+	// returned by os/exec, used with Podman
+	var exitCoder interface{ ExitCode() int }
+	if errors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+	return -1
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"time"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+// Type is the type of an event, also used as the name of the event sent by the API server
+type Type string
+
+const (
+	// StateChanged is published when the state of the Dev session changes, with data of type StateChangedData
+	StateChanged Type = "StateChanged"
+	// SyncStarted is published when the synchronization of local files starts, with data of type SyncData
+	SyncStarted Type = "SyncStarted"
+	// SyncCompleted is published when the synchronization of local files is complete, with data of type SyncData
+	SyncCompleted Type = "SyncCompleted"
+	// CommandStarted is published when the execution of a command starts, with data of type CommandData
+	CommandStarted Type = "CommandStarted"
+	// CommandExited is published when a command terminates, with data of type CommandData
+	CommandExited Type = "CommandExited"
+	// PodPhaseChanged is published when the phase of a pod of the component changes, with data of type PodPhaseData
+	PodPhaseChanged Type = "PodPhaseChanged"
+	// PortForwardsChanged is published when the ports forwarded change, with data of type PortForwardsData
+	PortForwardsChanged Type = "PortForwardsChanged"
+	// Warning is published when a warning event is emitted for the component, with data of type WarningData
+	Warning Type = "Warning"
+	// Error is published when an error occurs in the Dev session, with data of type ErrorData
+	Error Type = "Error"
+)
+
+// Event is an event of a Dev session
+type Event struct {
+	Type Type
+	// Time is the time at which the event has been published
+	Time time.Time
+	// Data contains the details of the event, its type depends on the type of the event
+	Data interface{}
+}
+
+type StateChangedData struct {
+	State string `json:"state"`
+}
+
+type SyncData struct {
+	// ChangedFiles is the number of files created or modified locally since the last synchronization;
+	// zero when all the files are synchronized
+	ChangedFiles int `json:"changedFiles"`
+	// DeletedFiles is the number of files deleted locally since the last synchronization
+	DeletedFiles int `json:"deletedFiles"`
+	// Forced indicates that the synchronization has been requested by the user
+	Forced bool `json:"forced,omitempty"`
+	// DurationMs is the duration of the synchronization in milliseconds, set for SyncCompleted events only
+	DurationMs int64 `json:"durationMs,omitempty"`
+}
+
+type CommandData struct {
+	Name string `json:"name"`
+	// Kind is the kind of the group of the command (build, run, debug, test, deploy), if any
+	Kind string `json:"kind,omitempty"`
+	// Component is the name of the container in which the command is executed, for exec commands
+	Component string `json:"component,omitempty"`
+	// Terminating is false for commands running until the end of the Dev session, like run and debug commands
+	Terminating bool `json:"terminating"`
+	// ExitCode is the exit code of the command, -1 if unknown, set for CommandExited events only
+	ExitCode int `json:"exitCode"`
+	// Error is the error returned by the command, set for CommandExited events only
+	Error string `json:"error,omitempty"`
+}
+
+type PodPhaseData struct {
+	Pod string `json:"pod"`
+	// Phase is the phase of the pod, `Terminating` when the pod is being deleted, and `Deleted` once deleted
+	Phase string `json:"phase"`
+}
+
+type PortForwardsData struct {
+	// Ports are all the ports currently forwarded
+	Ports []api.ForwardedPort `json:"ports"`
+}
+
+type WarningData struct {
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message"`
+	// Object is the object concerned by the warning, in the form `<kind>/<name>`
+	Object string `json:"object,omitempty"`
+}
+
+type ErrorData struct {
+	Message string `json:"message"`
+}
@@ -34,7 +34,7 @@ func (o ExecClient) ExecuteCommand(ctx context.Context, command []string, podNam
 		soutReader, soutWriter := io.Pipe()
 		serrReader, serrWriter := io.Pipe()
 
-		klog.V(2).Infof("Executing command %v for pod: %v in container: %v", command, podName, containerName)
+		klog.V(2).Infof("Executing command %v for pod: %v in container: %v", log.Redact(fmt.Sprint(command)), podName, containerName)
 
 		// Read stdout and stderr, store their output in cmdOutput, and also pass output to consoleOutput Writers (if non-nil)
 		stdoutCompleteChannel := startReaderGoroutine(os.Stdout, soutReader, directRun, &stdout, stdoutWriter)
@@ -52,9 +52,9 @@ func (o ExecClient) ExecuteCommand(ctx context.Context, command []string, podNam
 		if err != nil && !directRun {
 			// It is safe to read from stdout and stderr here, as the goroutines are guaranteed to have terminated at this point.
 			klog.V(2).Infof("ExecuteCommand returned an an err: %v. for command '%v'\nstdout: %v\nstderr: %v",
-				err, command, stdout, stderr)
+				err, log.Redact(fmt.Sprint(command)), stdout, stderr)
 
-			msg := fmt.Sprintf("unable to exec command %v", command)
+			msg := fmt.Sprintf("unable to exec command %v", log.Redact(fmt.Sprint(command)))
 			if len(stdout) != 0 {
 				msg += fmt.Sprintf("\n=== stdout===\n%s", strings.Join(stdout, "\n"))
 			}
@@ -3,11 +3,13 @@ package asker
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 
 	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/init/template"
 	"github\.com/danielpickens/astra/pkg/log"
 	"github\.com/danielpickens/astra/pkg/registry"
 )
@@ -194,6 +196,49 @@ func (o *Survey) AskAddPort() (string, error) {
 	return newPortAnswer, nil
 }
 
+// AskTemplateParameter asks for the value of a template parameter, depending on its type
+func (o *Survey) AskTemplateParameter(param template.Parameter) (string, error) {
+	message := fmt.Sprintf("Enter value for %q:", param.Name)
+	if param.Description != "" {
+		message = fmt.Sprintf("%s (%s):", param.Description, param.Name)
+	}
+
+	var question survey.Prompt
+	switch param.GetType() {
+	case template.BooleanParameter:
+		defaultValue, _ := strconv.ParseBool(param.DefaultValue())
+		var answer bool
+		err := survey.AskOne(&survey.Confirm{
+			Message: message,
+			Default: defaultValue,
+		}, &answer)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatBool(answer), nil
+	case template.EnumParameter:
+		selectQuestion := &survey.Select{
+			Message: message,
+			Options: param.Values,
+		}
+		if param.Default != nil {
+			selectQuestion.Default = param.DefaultValue()
+		}
+		question = selectQuestion
+	default:
+		question = &survey.Input{
+			Message: message,
+			Default: param.DefaultValue(),
+		}
+	}
+	var answer string
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return "", err
+	}
+	return answer, nil
+}
+
 func (o *Survey) AskContainerName(containers []string) (string, error) {
 	selectContainerQuestion := &survey.Select{
 		Message: "Select container for which you want to change configuration?",
@@ -4,6 +4,7 @@ package asker
 
 import (
 	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/init/template"
 	"github\.com/danielpickens/astra/pkg/registry"
 )
 
@@ -44,6 +45,9 @@ type Asker interface {
 
 	// AskAddPort asks the container name and port that user wants to add
 	AskAddPort() (string, error)
+
+	// AskTemplateParameter asks for the value of a template parameter, depending on its type
+	AskTemplateParameter(param template.Parameter) (string, error)
 }
 
 type ContainerConfiguration struct {
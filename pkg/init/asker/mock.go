@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	api "github\.com/danielpickens/astra/pkg/api"
+	template "github\.com/danielpickens/astra/pkg/init/template"
 	registry "github\.com/danielpickens/astra/pkg/registry"
 )
 
@@ -173,6 +174,21 @@ func (mr *MockAskerMockRecorder) AskStarterProject(projects interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskStarterProject", reflect.TypeOf((*MockAsker)(nil).AskStarterProject), projects)
 }
 
+// AskTemplateParameter mocks base method.
+func (m *MockAsker) AskTemplateParameter(param template.Parameter) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskTemplateParameter", param)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskTemplateParameter indicates an expected call of AskTemplateParameter.
+func (mr *MockAskerMockRecorder) AskTemplateParameter(param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskTemplateParameter", reflect.TypeOf((*MockAsker)(nil).AskTemplateParameter), param)
+}
+
 // AskType mocks base method.
 func (m *MockAsker) AskType(types registry.TypesWithDetails) (bool, api.DevfileStack, error) {
 	m.ctrl.T.Helper()
@@ -14,6 +14,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/alizer"
 	"github\.com/danielpickens/astra/pkg/api"
 	"github\.com/danielpickens/astra/pkg/init/asker"
+	"github\.com/danielpickens/astra/pkg/init/template"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -121,3 +122,7 @@ func (o *AlizerBackend) PersonalizeDevfileConfig(devfile parser.DevfileObj) (par
 func (o *AlizerBackend) HandleApplicationPorts(devfileobj parser.DevfileObj, ports []int, flags map[string]string) (parser.DevfileObj, error) {
 	return devfileobj, nil
 }
+
+func (o *AlizerBackend) SelectTemplateParameters(params []template.Parameter, flags map[string]string) (map[string]string, error) {
+	return askTemplateParameters(o.askerClient, params)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github\.com/danielpickens/astra/pkg/api"
 	"github\.com/danielpickens/astra/pkg/devfile/location"
+	"github\.com/danielpickens/astra/pkg/init/template"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -32,6 +33,7 @@ const (
 	FLAG_DEVFILE_VERSION  = "devfile-version"
 	FLAG_RUN_PORT         = "run-port"
 	FLAG_ARCHITECTURE     = "architecture"
+	FLAG_PARAM            = "param"
 )
 
 // FlagsBackend is a backend that will extract all needed information from flags passed to the command
@@ -116,6 +118,11 @@ Please use 'astra preference <add/remove> registry'' command to configure devfil
 		}
 	}
 
+	_, err = parseTemplateParamsFlag(flags)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -179,6 +186,27 @@ func (o FlagsBackend) HandleApplicationPorts(devfileobj parser.DevfileObj, _ []i
 	return d, nil
 }
 
+// SelectTemplateParameters returns the values passed with the --param flag, completed with the default values
+func (o FlagsBackend) SelectTemplateParameters(params []template.Parameter, flags map[string]string) (map[string]string, error) {
+	values, err := parseTemplateParamsFlag(flags)
+	if err != nil {
+		return nil, err
+	}
+	result, err := template.ResolveValues(params, values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for --%s: %w", FLAG_PARAM, err)
+	}
+	return result, nil
+}
+
+func parseTemplateParamsFlag(flags map[string]string) (map[string]string, error) {
+	values, err := parseStringArrayFlagValue(flags[FLAG_PARAM])
+	if err != nil {
+		return nil, err
+	}
+	return template.ParseValues(values)
+}
+
 func setPortsForFlag(devfileobj parser.DevfileObj, flags map[string]string, flagName string) (parser.DevfileObj, error) {
 	flagVal := flags[flagName]
 
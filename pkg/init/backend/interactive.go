@@ -17,6 +17,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/alizer"
 	"github\.com/danielpickens/astra/pkg/api"
 	"github\.com/danielpickens/astra/pkg/init/asker"
+	"github\.com/danielpickens/astra/pkg/init/template"
 	"github\.com/danielpickens/astra/pkg/log"
 	"github\.com/danielpickens/astra/pkg/registry"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
@@ -297,6 +298,30 @@ func (o *InteractiveBackend) HandleApplicationPorts(devfileobj parser.DevfileObj
 	return handleApplicationPorts(log.GetStdout(), devfileobj, ports)
 }
 
+func (o *InteractiveBackend) SelectTemplateParameters(params []template.Parameter, flags map[string]string) (map[string]string, error) {
+	return askTemplateParameters(o.askerClient, params)
+}
+
+// askTemplateParameters asks the value of each parameter, until the user enters a valid value
+func askTemplateParameters(askerClient asker.Asker, params []template.Parameter) (map[string]string, error) {
+	result := make(map[string]string, len(params))
+	for _, param := range params {
+		for {
+			value, err := askerClient.AskTemplateParameter(param)
+			if err != nil {
+				return nil, err
+			}
+			validErr := param.Validate(value)
+			if validErr == nil {
+				result[param.Name] = param.Normalize(value)
+				break
+			}
+			log.Error(validErr)
+		}
+	}
+	return result, nil
+}
+
 func PrintConfiguration(config asker.DevfileConfiguration) {
 
 	var keys []string
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -10,6 +11,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/alizer"
 	"github\.com/danielpickens/astra/pkg/api"
 	"github\.com/danielpickens/astra/pkg/init/asker"
+	"github\.com/danielpickens/astra/pkg/init/template"
 	"github\.com/danielpickens/astra/pkg/registry"
 	"github\.com/danielpickens/astra/pkg/testingutil"
 
@@ -650,3 +652,64 @@ func Test_getPortsAndEnvVar(t *testing.T) {
 		})
 	}
 }
+
+func TestInteractiveBackend_SelectTemplateParameters(t *testing.T) {
+	params := []template.Parameter{
+		{Name: "SERVICE_NAME", Pattern: "^[a-z-]+$", Required: true},
+		{Name: "METRICS", Type: template.BooleanParameter},
+	}
+	tests := []struct {
+		name    string
+		asker   func(ctrl *gomock.Controller) asker.Asker
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			asker: func(ctrl *gomock.Controller) asker.Asker {
+				client := asker.NewMockAsker(ctrl)
+				client.EXPECT().AskTemplateParameter(params[0]).Return("orders", nil)
+				client.EXPECT().AskTemplateParameter(params[1]).Return("true", nil)
+				return client
+			},
+			want: map[string]string{"SERVICE_NAME": "orders", "METRICS": "true"},
+		},
+		{
+			name: "ask again on invalid value",
+			asker: func(ctrl *gomock.Controller) asker.Asker {
+				client := asker.NewMockAsker(ctrl)
+				client.EXPECT().AskTemplateParameter(params[0]).Return("", nil)
+				client.EXPECT().AskTemplateParameter(params[0]).Return("Orders", nil)
+				client.EXPECT().AskTemplateParameter(params[0]).Return("orders", nil)
+				client.EXPECT().AskTemplateParameter(params[1]).Return("false", nil)
+				return client
+			},
+			want: map[string]string{"SERVICE_NAME": "orders", "METRICS": "false"},
+		},
+		{
+			name: "error asking",
+			asker: func(ctrl *gomock.Controller) asker.Asker {
+				client := asker.NewMockAsker(ctrl)
+				client.EXPECT().AskTemplateParameter(params[0]).Return("", errors.New("an error"))
+				return client
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			o := &InteractiveBackend{
+				askerClient: tt.asker(ctrl),
+			}
+			got, err := o.SelectTemplateParameters(params, map[string]string{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InteractiveBackend.SelectTemplateParameters() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("InteractiveBackend.SelectTemplateParameters() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 
 	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/init/template"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -34,4 +35,8 @@ type InitBackend interface {
 
 	// HandleApplicationPorts updates the ports in the Devfile accordingly.
 	HandleApplicationPorts(devfileobj parser.DevfileObj, ports []int, flags map[string]string) (parser.DevfileObj, error)
+
+	// SelectTemplateParameters returns the values of the template parameters, indexed by parameter name.
+	// Depending on the flags, the values may be set interactively or not.
+	SelectTemplateParameters(params []template.Parameter, flags map[string]string) (map[string]string, error)
 }
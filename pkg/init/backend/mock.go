@@ -12,6 +12,7 @@ import (
 	parser "github.com/devfile/library/v2/pkg/devfile/parser"
 	gomock "github.com/golang/mock/gomock"
 	api "github\.com/danielpickens/astra/pkg/api"
+	template "github\.com/danielpickens/astra/pkg/init/template"
 	filesystem "github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -113,6 +114,21 @@ func (mr *MockInitBackendMockRecorder) SelectStarterProject(devfile, flags inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectStarterProject", reflect.TypeOf((*MockInitBackend)(nil).SelectStarterProject), devfile, flags)
 }
 
+// SelectTemplateParameters mocks base method.
+func (m *MockInitBackend) SelectTemplateParameters(params []template.Parameter, flags map[string]string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectTemplateParameters", params, flags)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectTemplateParameters indicates an expected call of SelectTemplateParameters.
+func (mr *MockInitBackendMockRecorder) SelectTemplateParameters(params, flags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTemplateParameters", reflect.TypeOf((*MockInitBackend)(nil).SelectTemplateParameters), params, flags)
+}
+
 // Validate mocks base method.
 func (m *MockInitBackend) Validate(flags map[string]string, fs filesystem.Filesystem, dir string) error {
 	m.ctrl.T.Helper()
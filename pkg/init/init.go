@@ -13,13 +13,17 @@ import (
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	dfutil "github.com/devfile/library/v2/pkg/util"
+	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
 
 	"github\.com/danielpickens/astra/pkg/alizer"
 	"github\.com/danielpickens/astra/pkg/api"
 	"github\.com/danielpickens/astra/pkg/devfile"
+	"github\.com/danielpickens/astra/pkg/devfile/importer"
 	"github\.com/danielpickens/astra/pkg/devfile/location"
 	"github\.com/danielpickens/astra/pkg/init/asker"
 	"github\.com/danielpickens/astra/pkg/init/backend"
+	"github\.com/danielpickens/astra/pkg/init/template"
 	"github\.com/danielpickens/astra/pkg/log"
 	"github\.com/danielpickens/astra/pkg/preference"
 	"github\.com/danielpickens/astra/pkg/registry"
@@ -37,6 +41,7 @@ type InitClient struct {
 	fsys             filesystem.Filesystem
 	preferenceClient preference.Client
 	registryClient   registry.Client
+	alizerClient     alizer.Client
 }
 
 var _ Client = (*InitClient)(nil)
@@ -51,6 +56,7 @@ var _initFlags = []string{
 	backend.FLAG_DEVFILE_VERSION,
 	backend.FLAG_RUN_PORT,
 	backend.FLAG_ARCHITECTURE,
+	backend.FLAG_PARAM,
 }
 
 func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Client, registryClient registry.Client, alizerClient alizer.Client) *InitClient {
@@ -63,6 +69,7 @@ func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Clien
 		fsys:               fsys,
 		preferenceClient:   preferenceClient,
 		registryClient:     registryClient,
+		alizerClient:       alizerClient,
 	}
 }
 
@@ -84,33 +91,33 @@ outer:
 
 // Validate calls Validate method of the adequate backend
 func (o *InitClient) Validate(flags map[string]string, fs filesystem.Filesystem, dir string) error {
-	var backend backend.InitBackend
+	var initBackend backend.InitBackend
 	if len(flags) == 0 {
-		backend = o.interactiveBackend
+		initBackend = o.interactiveBackend
 	} else {
-		backend = o.flagsBackend
+		initBackend = o.flagsBackend
 	}
-	return backend.Validate(flags, fs, dir)
+	return initBackend.Validate(flags, fs, dir)
 }
 
 // SelectDevfile calls SelectDevfile methods of the adequate backend
 func (o *InitClient) SelectDevfile(ctx context.Context, flags map[string]string, fs filesystem.Filesystem, dir string) (*api.DetectionResult, error) {
-	var backend backend.InitBackend
+	var initBackend backend.InitBackend
 
 	empty, err := location.DirIsEmpty(fs, dir)
 	if err != nil {
 		return nil, err
 	}
 	if empty && len(flags) == 0 {
-		backend = o.interactiveBackend
+		initBackend = o.interactiveBackend
 	} else if len(flags) == 0 {
-		backend = o.alizerBackend
+		initBackend = o.alizerBackend
 	} else {
-		backend = o.flagsBackend
+		initBackend = o.flagsBackend
 	}
-	location, err := backend.SelectDevfile(ctx, flags, fs, dir)
+	location, err := initBackend.SelectDevfile(ctx, flags, fs, dir)
 	if err != nil || location == nil {
-		if backend == o.alizerBackend {
+		if initBackend == o.alizerBackend {
 			// Fallback to the Interactive Mode if Alizer could not determine the Devfile.
 			if err != nil {
 				if errors.Is(err, context.Canceled) || errors.Is(err, terminal.InterruptErr) {
@@ -233,16 +240,16 @@ func (o *InitClient) downloadFromRegistry(ctx context.Context, registryName stri
 
 // SelectStarterProject calls SelectStarterProject methods of the adequate backend
 func (o *InitClient) SelectStarterProject(devfile parser.DevfileObj, flags map[string]string, isEmptyDir bool) (*v1alpha2.StarterProject, error) {
-	var backend backend.InitBackend
+	var initBackend backend.InitBackend
 
 	if isEmptyDir && len(flags) == 0 {
-		backend = o.interactiveBackend
+		initBackend = o.interactiveBackend
 	} else if len(flags) == 0 {
-		backend = o.alizerBackend
+		initBackend = o.alizerBackend
 	} else {
-		backend = o.flagsBackend
+		initBackend = o.flagsBackend
 	}
-	return backend.SelectStarterProject(devfile, flags)
+	return initBackend.SelectStarterProject(devfile, flags)
 }
 
 func (o *InitClient) DownloadStarterProject(starter *v1alpha2.StarterProject, dest string) (containsDevfile bool, err error) {
@@ -258,18 +265,53 @@ func (o *InitClient) DownloadStarterProject(starter *v1alpha2.StarterProject, de
 
 // PersonalizeName calls PersonalizeName methods of the adequate backend
 func (o *InitClient) PersonalizeName(devfile parser.DevfileObj, flags map[string]string) (string, error) {
-	var backend backend.InitBackend
+	var initBackend backend.InitBackend
 
 	if len(flags) == 0 {
-		backend = o.interactiveBackend
+		initBackend = o.interactiveBackend
 	} else {
-		backend = o.flagsBackend
+		initBackend = o.flagsBackend
 	}
-	return backend.PersonalizeName(devfile, flags)
+	return initBackend.PersonalizeName(devfile, flags)
+}
+
+// ApplyTemplateParameters calls SelectTemplateParameters methods of the adequate backend,
+// and renders the values into the devfile and the files of dir
+func (o *InitClient) ApplyTemplateParameters(devfileobj parser.DevfileObj, starter *v1alpha2.StarterProject, flags map[string]string, dir string) (parser.DevfileObj, error) {
+	tmpl, err := template.GetTemplate(devfileobj, starter)
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
+	if len(tmpl.Parameters) == 0 {
+		if flags[backend.FLAG_PARAM] != "" {
+			return parser.DevfileObj{}, fmt.Errorf("--%s cannot be used, as the devfile does not declare any template parameter", backend.FLAG_PARAM)
+		}
+		return devfileobj, nil
+	}
+
+	var initBackend backend.InitBackend
+	if len(flags) == 0 {
+		initBackend = o.interactiveBackend
+	} else {
+		initBackend = o.flagsBackend
+	}
+	values, err := initBackend.SelectTemplateParameters(tmpl.Parameters, flags)
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
+
+	template.SetDevfileVariables(devfileobj, values)
+
+	rendered, err := template.RenderFiles(o.fsys, dir, tmpl.Files, values)
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
+	klog.V(2).Infof("template parameters rendered in %d file(s)", len(rendered))
+	return devfileobj, nil
 }
 
 func (o *InitClient) HandleApplicationPorts(devfileobj parser.DevfileObj, ports []int, flags map[string]string, fs filesystem.Filesystem, dir string) (parser.DevfileObj, error) {
-	var backend backend.InitBackend
+	var initBackend backend.InitBackend
 	onlyDevfile, err := location.DirContainsOnlyDevfile(fs, dir)
 	if err != nil {
 		return parser.DevfileObj{}, err
@@ -278,23 +320,23 @@ func (o *InitClient) HandleApplicationPorts(devfileobj parser.DevfileObj, ports
 	// Interactive mode since no flags are provided
 	if len(flags) == 0 && !onlyDevfile {
 		// Other files present in the directory; hence alizer is run
-		backend = o.interactiveBackend
+		initBackend = o.interactiveBackend
 	} else {
-		backend = o.flagsBackend
+		initBackend = o.flagsBackend
 	}
-	return backend.HandleApplicationPorts(devfileobj, ports, flags)
+	return initBackend.HandleApplicationPorts(devfileobj, ports, flags)
 }
 
 func (o *InitClient) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags map[string]string, fs filesystem.Filesystem, dir string) (parser.DevfileObj, error) {
-	var backend backend.InitBackend
+	var initBackend backend.InitBackend
 
 	// Interactive mode since no flags are provided
 	if len(flags) == 0 {
-		backend = o.interactiveBackend
+		initBackend = o.interactiveBackend
 	} else {
-		backend = o.flagsBackend
+		initBackend = o.flagsBackend
 	}
-	return backend.PersonalizeDevfileConfig(devfileobj)
+	return initBackend.PersonalizeDevfileConfig(devfileobj)
 }
 
 func (o *InitClient) SelectAndPersonalizeDevfile(ctx context.Context, flags map[string]string, contextDir string) (parser.DevfileObj, string, *api.DetectionResult, error) {
@@ -360,3 +402,90 @@ func (o *InitClient) InitDevfile(ctx context.Context, flags map[string]string, c
 
 	return err
 }
+
+// DetectComponents uses Alizer to detect the component roots in the dir tree, along with the devfile to use for each of them
+func (o *InitClient) DetectComponents(ctx context.Context, dir string) ([]api.DetectionResult, error) {
+	return o.alizerClient.DetectComponents(ctx, dir)
+}
+
+// InitComponent downloads the devfile described by devfileLocation into devfileLocation.Path,
+// sets the application ports and the name detected, and writes the result to disk
+func (o *InitClient) InitComponent(ctx context.Context, devfileLocation *api.DetectionResult) (parser.DevfileObj, string, error) {
+	if devfileLocation.Path == "" {
+		return parser.DevfileObj{}, "", errors.New("the directory of the component is not defined")
+	}
+
+	devfilePath, err := o.DownloadDevfile(ctx, devfileLocation, devfileLocation.Path)
+	if err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("unable to download devfile: %w", err)
+	}
+
+	devfileObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", false)
+	if err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("unable to parse devfile: %w", err)
+	}
+
+	devfileObj, err = o.interactiveBackend.HandleApplicationPorts(devfileObj, devfileLocation.ApplicationPorts, nil)
+	if err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("unable to set application ports in devfile: %w", err)
+	}
+
+	name := devfileLocation.Name
+	if name == "" {
+		name, err = o.alizerClient.DetectName(devfileLocation.Path)
+		if err != nil {
+			return parser.DevfileObj{}, "", fmt.Errorf("unable to detect the name of the component: %w", err)
+		}
+	}
+
+	// WARNING: SetMetadataName writes the Devfile to disk
+	if err = devfileObj.SetMetadataName(name); err != nil {
+		return parser.DevfileObj{}, "", err
+	}
+	return devfileObj, devfilePath, nil
+}
+
+// GenerateDevfile generates a Devfile from a Dockerfile, a Compose file or Kubernetes manifests, and writes it to dir
+func (o *InitClient) GenerateDevfile(ctx context.Context, source importer.Source, sourcePath string, name string, dir string) (parser.DevfileObj, string, []string, error) {
+	path, err := importer.FindSourcePath(o.fsys, source, dir, sourcePath)
+	if err != nil {
+		return parser.DevfileObj{}, "", nil, err
+	}
+
+	if name == "" {
+		name, err = o.alizerClient.DetectName(dir)
+		if err != nil {
+			return parser.DevfileObj{}, "", nil, fmt.Errorf("unable to detect the name of the component: %w", err)
+		}
+	}
+
+	spinner := log.Spinnerf("Generating devfile from %q", path)
+	defer spinner.End(false)
+	result, err := importer.Import(o.fsys, source, dir, path, name)
+	if err != nil {
+		return parser.DevfileObj{}, "", nil, err
+	}
+
+	content, err := yaml.Marshal(result.Data)
+	if err != nil {
+		return parser.DevfileObj{}, "", nil, err
+	}
+	// The devfile is validated before being written, so that an invalid devfile is never left in dir
+	_, err = devfile.ParseAndValidateFromData(content)
+	if err != nil {
+		return parser.DevfileObj{}, "", nil, fmt.Errorf("the generated devfile is not valid: %w", err)
+	}
+
+	devfilePath := filepath.Join(dir, "devfile.yaml")
+	err = o.fsys.WriteFile(devfilePath, content, 0644)
+	if err != nil {
+		return parser.DevfileObj{}, "", nil, err
+	}
+
+	devfileObj, err := devfile.ParseAndValidateFromFile(devfilePath, "", false)
+	if err != nil {
+		return parser.DevfileObj{}, "", nil, err
+	}
+	spinner.End(true)
+	return devfileObj, devfilePath, result.Unmapped, nil
+}
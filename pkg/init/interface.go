@@ -14,6 +14,7 @@ import (
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 
 	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/devfile/importer"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -55,6 +56,11 @@ type Client interface {
 	// Depending on the flags, it may return a name set interactively or not.
 	PersonalizeName(devfile parser.DevfileObj, flags map[string]string) (string, error)
 
+	// ApplyTemplateParameters selects the values of the template parameters declared in the devfile and in the starter project,
+	// depending on the flags, and renders them into the devfile (as variables) and into the files of dir declared by the template.
+	// The devfile is not written to disk.
+	ApplyTemplateParameters(devfileobj parser.DevfileObj, starter *v1alpha2.StarterProject, flags map[string]string, dir string) (parser.DevfileObj, error)
+
 	// PersonalizeDevfileConfig updates the env vars, and URL endpoints
 	PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags map[string]string, fs filesystem.Filesystem, dir string) (parser.DevfileObj, error)
 
@@ -64,4 +70,19 @@ type Client interface {
 
 	// HandleApplicationPorts updates the ports in the Devfile accordingly.
 	HandleApplicationPorts(devfileobj parser.DevfileObj, ports []int, flags map[string]string, fs filesystem.Filesystem, dir string) (parser.DevfileObj, error)
+
+	// DetectComponents walks the directory tree from dir and returns information about the devfile
+	// detected for each component root found in the tree (for example each service of a monorepo)
+	DetectComponents(ctx context.Context, dir string) ([]api.DetectionResult, error)
+
+	// InitComponent downloads, personalizes and writes the devfile described by devfileLocation
+	// into the devfileLocation.Path directory, without any interaction with the user.
+	// Returns the devfile object and its path
+	InitComponent(ctx context.Context, devfileLocation *api.DetectionResult) (parser.DevfileObj, string, error)
+
+	// GenerateDevfile generates a Devfile in dir from an existing description of the project
+	// (a Dockerfile, a Compose file or Kubernetes manifests) found at sourcePath, or at the default location for this source if empty.
+	// If name is empty, the name of the component is detected from the directory content.
+	// Returns the devfile object, its path, and the list of elements of the source which could not be represented in the Devfile
+	GenerateDevfile(ctx context.Context, source importer.Source, sourcePath string, name string, dir string) (parser.DevfileObj, string, []string, error)
 }
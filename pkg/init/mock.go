@@ -12,6 +12,7 @@ import (
 	parser "github.com/devfile/library/v2/pkg/devfile/parser"
 	gomock "github.com/golang/mock/gomock"
 	api "github\.com/danielpickens/astra/pkg/api"
+	importer "github\.com/danielpickens/astra/pkg/devfile/importer"
 	filesystem "github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
 
@@ -38,6 +39,36 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// ApplyTemplateParameters mocks base method.
+func (m *MockClient) ApplyTemplateParameters(devfileobj parser.DevfileObj, starter *v1alpha2.StarterProject, flags map[string]string, dir string) (parser.DevfileObj, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyTemplateParameters", devfileobj, starter, flags, dir)
+	ret0, _ := ret[0].(parser.DevfileObj)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyTemplateParameters indicates an expected call of ApplyTemplateParameters.
+func (mr *MockClientMockRecorder) ApplyTemplateParameters(devfileobj, starter, flags, dir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyTemplateParameters", reflect.TypeOf((*MockClient)(nil).ApplyTemplateParameters), devfileobj, starter, flags, dir)
+}
+
+// DetectComponents mocks base method.
+func (m *MockClient) DetectComponents(ctx context.Context, dir string) ([]api.DetectionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectComponents", ctx, dir)
+	ret0, _ := ret[0].([]api.DetectionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectComponents indicates an expected call of DetectComponents.
+func (mr *MockClientMockRecorder) DetectComponents(ctx, dir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectComponents", reflect.TypeOf((*MockClient)(nil).DetectComponents), ctx, dir)
+}
+
 // DownloadDevfile mocks base method.
 func (m *MockClient) DownloadDevfile(ctx context.Context, devfileLocation *api.DetectionResult, destDir string) (string, error) {
 	m.ctrl.T.Helper()
@@ -68,6 +99,23 @@ func (mr *MockClientMockRecorder) DownloadStarterProject(project, dest interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadStarterProject", reflect.TypeOf((*MockClient)(nil).DownloadStarterProject), project, dest)
 }
 
+// GenerateDevfile mocks base method.
+func (m *MockClient) GenerateDevfile(ctx context.Context, source importer.Source, sourcePath, name, dir string) (parser.DevfileObj, string, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDevfile", ctx, source, sourcePath, name, dir)
+	ret0, _ := ret[0].(parser.DevfileObj)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].([]string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GenerateDevfile indicates an expected call of GenerateDevfile.
+func (mr *MockClientMockRecorder) GenerateDevfile(ctx, source, sourcePath, name, dir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDevfile", reflect.TypeOf((*MockClient)(nil).GenerateDevfile), ctx, source, sourcePath, name, dir)
+}
+
 // GetFlags mocks base method.
 func (m *MockClient) GetFlags(flags map[string]string) map[string]string {
 	m.ctrl.T.Helper()
@@ -97,6 +145,22 @@ func (mr *MockClientMockRecorder) HandleApplicationPorts(devfileobj, ports, flag
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleApplicationPorts", reflect.TypeOf((*MockClient)(nil).HandleApplicationPorts), devfileobj, ports, flags, fs, dir)
 }
 
+// InitComponent mocks base method.
+func (m *MockClient) InitComponent(ctx context.Context, devfileLocation *api.DetectionResult) (parser.DevfileObj, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitComponent", ctx, devfileLocation)
+	ret0, _ := ret[0].(parser.DevfileObj)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InitComponent indicates an expected call of InitComponent.
+func (mr *MockClientMockRecorder) InitComponent(ctx, devfileLocation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitComponent", reflect.TypeOf((*MockClient)(nil).InitComponent), ctx, devfileLocation)
+}
+
 // InitDevfile mocks base method.
 func (m *MockClient) InitDevfile(ctx context.Context, flags map[string]string, contextDir string, preInitHandlerFunc func(bool), newDevfileHandlerFunc func(parser.DevfileObj) error) error {
 	m.ctrl.T.Helper()
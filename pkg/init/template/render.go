@@ -0,0 +1,116 @@
+package template
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+)
+
+// placeholderRegexp matches the placeholders {{NAME}} / {{ NAME }}, using the same syntax as the Devfile variables
+var placeholderRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_-]*)\s*\}\}`)
+
+// SetDevfileVariables sets the values as Devfile variables, so the placeholders referencing
+// the parameters in the Devfile are replaced by these values when the Devfile is parsed.
+// The Devfile is not written to disk.
+func SetDevfileVariables(devfileObj parser.DevfileObj, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+	spec := devfileObj.Data.GetDevfileWorkspaceSpec()
+	if spec.Variables == nil {
+		spec.Variables = map[string]string{}
+	}
+	for name, value := range values {
+		spec.Variables[name] = value
+	}
+	devfileObj.Data.SetDevfileWorkspaceSpec(*spec)
+}
+
+// RenderFiles replaces the placeholders referencing the parameters with their values, in the files of dir
+// matching one of the patterns. A pattern matches the path of a file relative to dir (using the filepath.Match syntax),
+// or its base name if the pattern does not contain any separator.
+// Placeholders not referencing a parameter are left unchanged.
+// Returns the list of files modified, relative to dir.
+func RenderFiles(fs filesystem.Filesystem, dir string, patterns []string, values map[string]string) ([]string, error) {
+	if len(patterns) == 0 || len(values) == 0 {
+		return nil, nil
+	}
+	var rendered []string
+	err := fs.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		match, err := matchesAny(patterns, filepath.ToSlash(rel))
+		if err != nil || !match {
+			return err
+		}
+		changed, err := renderFile(fs, path, info.Mode().Perm(), values)
+		if err != nil {
+			return fmt.Errorf("unable to render file %q: %w", rel, err)
+		}
+		if changed {
+			klog.V(4).Infof("template parameters rendered in file %q", rel)
+			rendered = append(rendered, rel)
+		}
+		return nil
+	})
+	return rendered, err
+}
+
+func matchesAny(patterns []string, rel string) (bool, error) {
+	for _, pattern := range patterns {
+		pattern = filepath.ToSlash(filepath.Clean(pattern))
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = filepath.Base(rel)
+		}
+		match, err := filepath.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid file pattern %q in template: %w", pattern, err)
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func renderFile(fs filesystem.Filesystem, path string, perm os.FileMode, values map[string]string) (bool, error) {
+	content, err := fs.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	result := Render(string(content), values)
+	if result == string(content) {
+		return false, nil
+	}
+	return true, fs.WriteFile(path, []byte(result), perm)
+}
+
+// Render replaces the placeholders referencing the parameters in s with their values
+func Render(s string, values map[string]string) string {
+	return placeholderRegexp.ReplaceAllStringFunc(s, func(placeholder string) string {
+		name := placeholderRegexp.FindStringSubmatch(placeholder)[1]
+		if value, ok := values[name]; ok {
+			return value
+		}
+		return placeholder
+	})
+}
@@ -0,0 +1,263 @@
+// Package template handles the parameters declared by Devfile stacks and starter projects
+// published as templates, and renders the values of these parameters
+// into the Devfile and the files of the starter project.
+//
+// A template is declared with the `astra.dev/template` attribute, either at the top-level of the Devfile
+// or in the attributes of a starter project:
+//
+//	attributes:
+//	  astra.dev/template:
+//	    files:
+//	    - README.md
+//	    - src/*.js
+//	    parameters:
+//	    - name: SERVICE_NAME
+//	      description: Name of the service
+//	      default: my-service
+//	      pattern: ^[a-z][a-z0-9-]*$
+//	    - name: DATABASE
+//	      type: enum
+//	      values: [postgresql, mysql]
+//	    - name: METRICS
+//	      type: boolean
+//	      default: false
+package template
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/klog"
+)
+
+// TemplateAttribute is the attribute of the Devfile or of a starter project declaring the template
+const TemplateAttribute = "astra.dev/template"
+
+type ParameterType string
+
+const (
+	StringParameter  ParameterType = "string"
+	EnumParameter    ParameterType = "enum"
+	BooleanParameter ParameterType = "boolean"
+)
+
+// Parameter is a typed parameter of a template
+type Parameter struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Type        ParameterType `json:"type,omitempty"`
+	// Default can be any scalar value, as YAML authors will write `default: false` for booleans
+	Default  interface{} `json:"default,omitempty"`
+	Values   []string    `json:"values,omitempty"`
+	Pattern  string      `json:"pattern,omitempty"`
+	Required bool        `json:"required,omitempty"`
+}
+
+// Template is the content of the TemplateAttribute
+type Template struct {
+	// Files is the list of patterns of the files to render, relative to the component directory
+	Files      []string    `json:"files,omitempty"`
+	Parameters []Parameter `json:"parameters,omitempty"`
+}
+
+var parameterNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// GetTemplate returns the template declared in the devfile and in the starter project, if not nil.
+// The parameters of the starter project are added to the ones of the Devfile, and override them if they have the same name.
+func GetTemplate(devfileObj parser.DevfileObj, starter *v1alpha2.StarterProject) (Template, error) {
+	var result Template
+
+	attrs, err := devfileObj.Data.GetAttributes()
+	if err != nil {
+		// top-level attributes are not supported by this schema version
+		klog.V(4).Infof("unable to get the attributes of the devfile: %v", err)
+	} else if attrs.Exists(TemplateAttribute) {
+		err = attrs.GetInto(TemplateAttribute, &result)
+		if err != nil {
+			return Template{}, fmt.Errorf("invalid %q attribute in devfile: %w", TemplateAttribute, err)
+		}
+	}
+
+	if starter != nil && starter.Attributes.Exists(TemplateAttribute) {
+		var starterTemplate Template
+		err = starter.Attributes.GetInto(TemplateAttribute, &starterTemplate)
+		if err != nil {
+			return Template{}, fmt.Errorf("invalid %q attribute in starter project %q: %w", TemplateAttribute, starter.Name, err)
+		}
+		result = merge(result, starterTemplate)
+	}
+
+	// The variables of the Devfile are already substituted when the Devfile is parsed,
+	// so a parameter with the same name as a variable could not be rendered into the Devfile
+	variables := devfileObj.Data.GetDevfileWorkspaceSpec().Variables
+	for _, param := range result.Parameters {
+		if err = param.check(); err != nil {
+			return Template{}, err
+		}
+		if _, found := variables[param.Name]; found {
+			return Template{}, fmt.Errorf("template parameter %q conflicts with the devfile variable of the same name", param.Name)
+		}
+	}
+	return result, nil
+}
+
+func merge(base, override Template) Template {
+	result := Template{
+		Files: append(base.Files, override.Files...),
+	}
+	overridden := map[string]Parameter{}
+	for _, param := range override.Parameters {
+		overridden[param.Name] = param
+	}
+	for _, param := range base.Parameters {
+		if _, ok := overridden[param.Name]; ok {
+			continue
+		}
+		result.Parameters = append(result.Parameters, param)
+	}
+	result.Parameters = append(result.Parameters, override.Parameters...)
+	return result
+}
+
+// check returns an error if the definition of the parameter is not valid
+func (o Parameter) check() error {
+	if !parameterNameRegexp.MatchString(o.Name) {
+		return fmt.Errorf("invalid name for template parameter %q", o.Name)
+	}
+	switch o.Type {
+	case "", StringParameter, BooleanParameter:
+	case EnumParameter:
+		if len(o.Values) == 0 {
+			return fmt.Errorf("no values defined for enum template parameter %q", o.Name)
+		}
+	default:
+		return fmt.Errorf("unknown type %q for template parameter %q", o.Type, o.Name)
+	}
+	if o.Pattern != "" {
+		if _, err := regexp.Compile(o.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern for template parameter %q: %w", o.Name, err)
+		}
+	}
+	if o.Default != nil {
+		if err := o.Validate(o.DefaultValue()); err != nil {
+			return fmt.Errorf("invalid default value: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetType returns the type of the parameter, string being the default type
+func (o Parameter) GetType() ParameterType {
+	if o.Type == "" {
+		return StringParameter
+	}
+	return o.Type
+}
+
+// DefaultValue returns the default value of the parameter as a string, or an empty string if no default is defined
+func (o Parameter) DefaultValue() string {
+	if o.Default == nil {
+		return ""
+	}
+	return fmt.Sprint(o.Default)
+}
+
+// Validate returns an error if value is not a valid value for the parameter
+func (o Parameter) Validate(value string) error {
+	if value == "" {
+		if o.Required {
+			return fmt.Errorf("a value is required for template parameter %q", o.Name)
+		}
+		return nil
+	}
+	switch o.GetType() {
+	case BooleanParameter:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("value %q is not a boolean for template parameter %q", value, o.Name)
+		}
+	case EnumParameter:
+		found := false
+		for _, v := range o.Values {
+			if v == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("value %q is not valid for template parameter %q. Possible values are: %v", value, o.Name, o.Values)
+		}
+	}
+	if o.Pattern != "" {
+		matched, err := regexp.MatchString(o.Pattern, value)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			return fmt.Errorf("value %q for template parameter %q does not match the pattern %q", value, o.Name, o.Pattern)
+		}
+	}
+	return nil
+}
+
+// Normalize returns the canonical form of a valid value for the parameter
+// (for example, "True" and "1" are returned as "true" for a boolean parameter)
+func (o Parameter) Normalize(value string) string {
+	if o.GetType() == BooleanParameter && value != "" {
+		b, err := strconv.ParseBool(value)
+		if err == nil {
+			return strconv.FormatBool(b)
+		}
+	}
+	return value
+}
+
+// ParseValues parses a list of values in the form NAME=VALUE, as passed with the --param flag
+func ParseValues(values []string) (map[string]string, error) {
+	result := make(map[string]string, len(values))
+	for _, value := range values {
+		name, val, found := strings.Cut(value, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("malformed template parameter %q, the expected format is NAME=VALUE", value)
+		}
+		result[name] = val
+	}
+	return result, nil
+}
+
+// ResolveValues checks values against the parameters, and returns the values for all parameters,
+// using the default value for the parameters without value.
+// An error is returned if a value is passed for an unknown parameter, if a value is not valid,
+// or if a required parameter has no value and no default.
+func ResolveValues(params []Parameter, values map[string]string) (map[string]string, error) {
+	known := make(map[string]struct{}, len(params))
+	result := make(map[string]string, len(params))
+	var errs []string
+	for _, param := range params {
+		known[param.Name] = struct{}{}
+		value, ok := values[param.Name]
+		if !ok {
+			value = param.DefaultValue()
+		}
+		if err := param.Validate(value); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		result[param.Name] = param.Normalize(value)
+	}
+	for name := range values {
+		if _, ok := known[name]; !ok {
+			errs = append(errs, fmt.Sprintf("unknown template parameter %q", name))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+	return result, nil
+}
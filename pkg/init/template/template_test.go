@@ -0,0 +1,271 @@
+package template
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+)
+
+func TestGetTemplate(t *testing.T) {
+	service := Parameter{
+		Name:    "SERVICE_NAME",
+		Default: "my-service",
+		Pattern: "^[a-z-]+$",
+	}
+	database := Parameter{
+		Name:   "DATABASE",
+		Type:   EnumParameter,
+		Values: []string{"postgresql", "mysql"},
+	}
+	metrics := Parameter{
+		Name:    "METRICS",
+		Type:    BooleanParameter,
+		Default: false,
+	}
+
+	type args struct {
+		devfileTemplate interface{}
+		variables       map[string]string
+		starter         *v1alpha2.StarterProject
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Template
+		wantErr bool
+	}{
+		{
+			name: "no template",
+		},
+		{
+			name: "template in devfile",
+			args: args{
+				devfileTemplate: Template{
+					Files:      []string{"README.md"},
+					Parameters: []Parameter{service, database},
+				},
+			},
+			want: Template{
+				Files:      []string{"README.md"},
+				Parameters: []Parameter{service, database},
+			},
+		},
+		{
+			name: "template in devfile and starter project",
+			args: args{
+				devfileTemplate: Template{
+					Files:      []string{"README.md"},
+					Parameters: []Parameter{service, database},
+				},
+				starter: &v1alpha2.StarterProject{
+					Name: "starter",
+					Attributes: attributes.Attributes{}.PutString("other", "value").Put(TemplateAttribute, Template{
+						Files:      []string{"*.go"},
+						Parameters: []Parameter{{Name: "SERVICE_NAME"}, metrics},
+					}, nil),
+				},
+			},
+			want: Template{
+				Files:      []string{"README.md", "*.go"},
+				Parameters: []Parameter{database, {Name: "SERVICE_NAME"}, metrics},
+			},
+		},
+		{
+			name: "unknown parameter type",
+			args: args{
+				devfileTemplate: Template{
+					Parameters: []Parameter{{Name: "A", Type: "int"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "enum without values",
+			args: args{
+				devfileTemplate: Template{
+					Parameters: []Parameter{{Name: "A", Type: EnumParameter}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid default value",
+			args: args{
+				devfileTemplate: Template{
+					Parameters: []Parameter{{Name: "A", Type: BooleanParameter, Default: "maybe"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid parameter name",
+			args: args{
+				devfileTemplate: Template{
+					Parameters: []Parameter{{Name: "A B"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "parameter conflicting with a devfile variable",
+			args: args{
+				devfileTemplate: Template{
+					Parameters: []Parameter{service},
+				},
+				variables: map[string]string{"SERVICE_NAME": "a-service"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, _ := data.NewDevfileData(string(data.APISchemaVersion220))
+			spec := devfileData.GetDevfileWorkspaceSpec()
+			if tt.args.devfileTemplate != nil {
+				spec.Attributes = attributes.Attributes{}.Put(TemplateAttribute, tt.args.devfileTemplate, nil)
+			}
+			spec.Variables = tt.args.variables
+			devfileData.SetDevfileWorkspaceSpec(*spec)
+			got, err := GetTemplate(parser.DevfileObj{Data: devfileData}, tt.args.starter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetTemplate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveValues(t *testing.T) {
+	params := []Parameter{
+		{Name: "SERVICE_NAME", Default: "my-service", Pattern: "^[a-z-]+$"},
+		{Name: "DATABASE", Type: EnumParameter, Values: []string{"postgresql", "mysql"}, Required: true},
+		{Name: "METRICS", Type: BooleanParameter, Default: false},
+	}
+	tests := []struct {
+		name    string
+		values  map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "default values",
+			values: map[string]string{"DATABASE": "mysql"},
+			want:   map[string]string{"SERVICE_NAME": "my-service", "DATABASE": "mysql", "METRICS": "false"},
+		},
+		{
+			name:   "all values",
+			values: map[string]string{"SERVICE_NAME": "orders", "DATABASE": "postgresql", "METRICS": "True"},
+			want:   map[string]string{"SERVICE_NAME": "orders", "DATABASE": "postgresql", "METRICS": "true"},
+		},
+		{
+			name:    "missing required value",
+			values:  map[string]string{},
+			wantErr: true,
+		},
+		{
+			name:    "value not matching pattern",
+			values:  map[string]string{"SERVICE_NAME": "Orders", "DATABASE": "mysql"},
+			wantErr: true,
+		},
+		{
+			name:    "value not in enum",
+			values:  map[string]string{"DATABASE": "oracle"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid boolean",
+			values:  map[string]string{"DATABASE": "mysql", "METRICS": "yes"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown parameter",
+			values:  map[string]string{"DATABASE": "mysql", "OTHER": "value"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveValues(params, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolveValues() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ResolveValues() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	got, err := ParseValues([]string{"A=1", "B=x=y", "C="})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"A": "1", "B": "x=y", "C": ""}, got); diff != "" {
+		t.Errorf("ParseValues() mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, value := range []string{"A", "=1"} {
+		if _, err = ParseValues([]string{value}); err == nil {
+			t.Errorf("ParseValues() expected an error for %q", value)
+		}
+	}
+}
+
+func TestRenderFiles(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	dir := "/tmp/component"
+	files := map[string]string{
+		"README.md":          "# {{SERVICE_NAME}}\nUses {{ DATABASE }}, see {{OTHER}}",
+		"src/main.js":        "const name = '{{SERVICE_NAME}}';",
+		"src/lib/util.js":    "// {{SERVICE_NAME}}",
+		"config/app.yaml":    "service: {{SERVICE_NAME}}",
+		"docs/unchanged.txt": "{{SERVICE_NAME}}",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	values := map[string]string{"SERVICE_NAME": "orders", "DATABASE": "mysql"}
+	rendered, err := RenderFiles(fs, dir, []string{"README.md", "*.js", "config/*.yaml"}, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rendered) != 4 {
+		t.Errorf("expected 4 files rendered, got %v", rendered)
+	}
+
+	want := map[string]string{
+		"README.md":          "# orders\nUses mysql, see {{OTHER}}",
+		"src/main.js":        "const name = 'orders';",
+		"src/lib/util.js":    "// orders",
+		"config/app.yaml":    "service: orders",
+		"docs/unchanged.txt": "{{SERVICE_NAME}}",
+	}
+	for name, content := range want {
+		got, err := fs.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != content {
+			t.Errorf("content of %q: want %q, got %q", name, content, string(got))
+		}
+	}
+}
@@ -82,6 +82,17 @@ func (c *Client) GetDeploymentFromSelector(selector string) ([]appsv1.Deployment
 	return deploymentList.Items, nil
 }
 
+// ListReplicaSets returns the ReplicaSets matching the selector in the current namespace
+func (c *Client) ListReplicaSets(selector string) ([]appsv1.ReplicaSet, error) {
+	replicaSetList, err := c.KubeClient.AppsV1().ReplicaSets(c.Namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list ReplicaSets: %w", err)
+	}
+	return replicaSetList.Items, nil
+}
+
 func resourceAsJson(resource interface{}) string {
 	data, _ := json.MarshalIndent(resource, " ", " ")
 	return string(data)
@@ -128,6 +139,24 @@ func (c *Client) ApplyDeployment(deploy appsv1.Deployment) (*appsv1.Deployment,
 	return deployment, nil
 }
 
+// PatchDeploymentAnnotations adds or updates the given annotations of the deployment, leaving its other annotations unchanged
+func (c *Client) PatchDeploymentAnnotations(name string, annotations map[string]string) error {
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("unable to marshal annotations: %w", err)
+	}
+	_, err = c.KubeClient.AppsV1().Deployments(c.Namespace).Patch(context.Background(), name, types.MergePatchType, data, metav1.PatchOptions{FieldManager: FieldManager})
+	if err != nil {
+		return fmt.Errorf("unable to patch annotations of Deployment %s: %w", name, err)
+	}
+	return nil
+}
+
 // removeDuplicateEnv removes duplicate environment variables from containers, due to a bug in Service Binding Operator:
 // https://github.com/daniel-pickens/service-binding-operator/issues/983
 func (c *Client) removeDuplicateEnv(deploymentName string) error {
@@ -2,7 +2,9 @@ package kclient
 
 import (
 	"context"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
@@ -34,3 +36,14 @@ func (c *Client) PodWarningEventWatcher(ctx context.Context) (result watch.Inter
 	}
 	return result, false, nil
 }
+
+// ListWarningEvents returns the Warning events of the current namespace
+func (c *Client) ListWarningEvents() ([]corev1.Event, error) {
+	eventList, err := c.GetClient().CoreV1().Events(c.GetCurrentNamespace()).List(context.Background(), metav1.ListOptions{
+		FieldSelector: "type=Warning",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list events: %w", err)
+	}
+	return eventList.Items, nil
+}
@@ -9,6 +9,8 @@ import (
 	projectv1 "github.com/openshift/api/project/v1"
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
@@ -51,9 +53,11 @@ type ClientInterface interface {
 	GetOneDeployment(componentName, appName string, isPartOfComponent bool) (*appsv1.Deployment, error)
 	GetOneDeploymentFromSelector(selector string) (*appsv1.Deployment, error)
 	GetDeploymentFromSelector(selector string) ([]appsv1.Deployment, error)
+	ListReplicaSets(selector string) ([]appsv1.ReplicaSet, error)
 	CreateDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error)
 	UpdateDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error)
 	ApplyDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error)
+	PatchDeploymentAnnotations(name string, annotations map[string]string) error
 	GetDeploymentAPIVersion() (schema.GroupVersionKind, error)
 	IsDeploymentExtensionsV1Beta1() (bool, error)
 	DeploymentWatcher(ctx context.Context, selector string) (watch.Interface, error)
@@ -67,6 +71,7 @@ type ClientInterface interface {
 
 	// events.go
 	PodWarningEventWatcher(ctx context.Context) (result watch.Interface, isForbidden bool, err error)
+	ListWarningEvents() ([]corev1.Event, error)
 
 	// kclient.go
 	GetClient() kubernetes.Interface
@@ -129,6 +134,10 @@ type ClientInterface interface {
 	IsProjectSupported() (bool, error)
 	ListProjectNames() ([]string, error)
 
+	// quotas.go
+	ListResourceQuotas() ([]corev1.ResourceQuota, error)
+	ListLimitRanges() ([]corev1.LimitRange, error)
+
 	// secrets.go
 	CreateTLSSecret(tlsCertificate []byte, tlsPrivKey []byte, objectMeta metav1.ObjectMeta) (*corev1.Secret, error)
 	GetSecret(name, namespace string) (*corev1.Secret, error)
@@ -149,6 +158,12 @@ type ClientInterface interface {
 
 	// user.go
 	RunLogout(stdout io.Writer) error
+	// GetCurrentUser returns the information about the user authenticated by the cluster, using a SelfSubjectReview
+	GetCurrentUser() (authenticationv1.UserInfo, error)
+	// GetSelfSubjectRules returns the rules the current user is allowed to perform in the namespace, using a SelfSubjectRulesReview
+	GetSelfSubjectRules(namespace string) (authorizationv1.SubjectRulesReviewStatus, error)
+	// GetSelfSubjectAccess returns if the current user is allowed to perform the action described by attributes, using a SelfSubjectAccessReview
+	GetSelfSubjectAccess(attributes authorizationv1.ResourceAttributes) (authorizationv1.SubjectAccessReviewStatus, error)
 
 	// volumes.go
 	CreatePVC(pvc corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error)
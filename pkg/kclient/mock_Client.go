@@ -18,6 +18,8 @@ import (
 	v1alpha10 "github.com/daniel-pickens/service-binding-operator/apis/binding/v1alpha1"
 	v1alpha3 "github.com/daniel-pickens/service-binding-operator/apis/spec/v1alpha3"
 	v10 "k8s.io/api/apps/v1"
+	v15 "k8s.io/api/authentication/v1"
+	v16 "k8s.io/api/authorization/v1"
 	v11 "k8s.io/api/batch/v1"
 	v12 "k8s.io/api/core/v1"
 	v13 "k8s.io/api/networking/v1"
@@ -520,6 +522,21 @@ func (mr *MockClientInterfaceMockRecorder) GetCurrentProjectName() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentProjectName", reflect.TypeOf((*MockClientInterface)(nil).GetCurrentProjectName))
 }
 
+// GetCurrentUser mocks base method.
+func (m *MockClientInterface) GetCurrentUser() (v15.UserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUser")
+	ret0, _ := ret[0].(v15.UserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUser indicates an expected call of GetCurrentUser.
+func (mr *MockClientInterfaceMockRecorder) GetCurrentUser() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*MockClientInterface)(nil).GetCurrentUser))
+}
+
 // GetCustomResourcesFromCSV mocks base method.
 func (m *MockClientInterface) GetCustomResourcesFromCSV(csv *v1alpha1.ClusterServiceVersion) *[]v1alpha1.CRDDescription {
 	m.ctrl.T.Helper()
@@ -953,6 +970,36 @@ func (mr *MockClientInterfaceMockRecorder) GetSecret(name, namespace interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockClientInterface)(nil).GetSecret), name, namespace)
 }
 
+// GetSelfSubjectAccess mocks base method.
+func (m *MockClientInterface) GetSelfSubjectAccess(attributes v16.ResourceAttributes) (v16.SubjectAccessReviewStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelfSubjectAccess", attributes)
+	ret0, _ := ret[0].(v16.SubjectAccessReviewStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSelfSubjectAccess indicates an expected call of GetSelfSubjectAccess.
+func (mr *MockClientInterfaceMockRecorder) GetSelfSubjectAccess(attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelfSubjectAccess", reflect.TypeOf((*MockClientInterface)(nil).GetSelfSubjectAccess), attributes)
+}
+
+// GetSelfSubjectRules mocks base method.
+func (m *MockClientInterface) GetSelfSubjectRules(namespace string) (v16.SubjectRulesReviewStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelfSubjectRules", namespace)
+	ret0, _ := ret[0].(v16.SubjectRulesReviewStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSelfSubjectRules indicates an expected call of GetSelfSubjectRules.
+func (mr *MockClientInterfaceMockRecorder) GetSelfSubjectRules(namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelfSubjectRules", reflect.TypeOf((*MockClientInterface)(nil).GetSelfSubjectRules), namespace)
+}
+
 // GetServerVersion mocks base method.
 func (m *MockClientInterface) GetServerVersion(timeout time.Duration) (*ServerInfo, error) {
 	m.ctrl.T.Helper()
@@ -1178,6 +1225,21 @@ func (mr *MockClientInterfaceMockRecorder) ListJobs(selector interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockClientInterface)(nil).ListJobs), selector)
 }
 
+// ListLimitRanges mocks base method.
+func (m *MockClientInterface) ListLimitRanges() ([]v12.LimitRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLimitRanges")
+	ret0, _ := ret[0].([]v12.LimitRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLimitRanges indicates an expected call of ListLimitRanges.
+func (mr *MockClientInterfaceMockRecorder) ListLimitRanges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLimitRanges", reflect.TypeOf((*MockClientInterface)(nil).ListLimitRanges))
+}
+
 // ListPVCNames mocks base method.
 func (m *MockClientInterface) ListPVCNames(selector string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1223,6 +1285,36 @@ func (mr *MockClientInterfaceMockRecorder) ListProjectNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectNames", reflect.TypeOf((*MockClientInterface)(nil).ListProjectNames))
 }
 
+// ListReplicaSets mocks base method.
+func (m *MockClientInterface) ListReplicaSets(selector string) ([]v10.ReplicaSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReplicaSets", selector)
+	ret0, _ := ret[0].([]v10.ReplicaSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReplicaSets indicates an expected call of ListReplicaSets.
+func (mr *MockClientInterfaceMockRecorder) ListReplicaSets(selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReplicaSets", reflect.TypeOf((*MockClientInterface)(nil).ListReplicaSets), selector)
+}
+
+// ListResourceQuotas mocks base method.
+func (m *MockClientInterface) ListResourceQuotas() ([]v12.ResourceQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceQuotas")
+	ret0, _ := ret[0].([]v12.ResourceQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceQuotas indicates an expected call of ListResourceQuotas.
+func (mr *MockClientInterfaceMockRecorder) ListResourceQuotas() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceQuotas", reflect.TypeOf((*MockClientInterface)(nil).ListResourceQuotas))
+}
+
 // ListSecrets mocks base method.
 func (m *MockClientInterface) ListSecrets(labelSelector string) ([]v12.Secret, error) {
 	m.ctrl.T.Helper()
@@ -1269,6 +1361,21 @@ func (mr *MockClientInterfaceMockRecorder) ListServices(selector interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockClientInterface)(nil).ListServices), selector)
 }
 
+// ListWarningEvents mocks base method.
+func (m *MockClientInterface) ListWarningEvents() ([]v12.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWarningEvents")
+	ret0, _ := ret[0].([]v12.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWarningEvents indicates an expected call of ListWarningEvents.
+func (mr *MockClientInterfaceMockRecorder) ListWarningEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWarningEvents", reflect.TypeOf((*MockClientInterface)(nil).ListWarningEvents))
+}
+
 // NewServiceBindingServiceObject mocks base method.
 func (m *MockClientInterface) NewServiceBindingServiceObject(serviceNs string, unstructuredService unstructured.Unstructured, bindingName string) (v1alpha10.Service, error) {
 	m.ctrl.T.Helper()
@@ -1284,6 +1391,20 @@ func (mr *MockClientInterfaceMockRecorder) NewServiceBindingServiceObject(servic
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewServiceBindingServiceObject", reflect.TypeOf((*MockClientInterface)(nil).NewServiceBindingServiceObject), serviceNs, unstructuredService, bindingName)
 }
 
+// PatchDeploymentAnnotations mocks base method.
+func (m *MockClientInterface) PatchDeploymentAnnotations(name string, annotations map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchDeploymentAnnotations", name, annotations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchDeploymentAnnotations indicates an expected call of PatchDeploymentAnnotations.
+func (mr *MockClientInterfaceMockRecorder) PatchDeploymentAnnotations(name interface{}, annotations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchDeploymentAnnotations", reflect.TypeOf((*MockClientInterface)(nil).PatchDeploymentAnnotations), name, annotations)
+}
+
 // PatchDynamicResource mocks base method.
 func (m *MockClientInterface) PatchDynamicResource(exampleCustomResource unstructured.Unstructured) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,27 @@
+package kclient
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListResourceQuotas lists the resource quotas of the current namespace
+func (c *Client) ListResourceQuotas() ([]corev1.ResourceQuota, error) {
+	list, err := c.KubeClient.CoreV1().ResourceQuotas(c.Namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get resource quota list: %w", err)
+	}
+	return list.Items, nil
+}
+
+// ListLimitRanges lists the limit ranges of the current namespace
+func (c *Client) ListLimitRanges() ([]corev1.LimitRange, error) {
+	list, err := c.KubeClient.CoreV1().LimitRanges(c.Namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get limit range list: %w", err)
+	}
+	return list.Items, nil
+}
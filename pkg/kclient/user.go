@@ -2,10 +2,15 @@ package kclient
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 
 	oauthv1client "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authenticationv1alpha1 "k8s.io/api/authentication/v1alpha1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog"
@@ -53,3 +58,65 @@ func (c *Client) RunLogout(stdout io.Writer) error {
 	_, err = io.WriteString(stdout, fmt.Sprintf("Logged %q out on %q\n", output.Name, conf.Host))
 	return err
 }
+
+// selfSubjectReviewVersions are the versions of the SelfSubjectReview API, from the most to the least recent one
+var selfSubjectReviewVersions = []string{"v1", "v1beta1", "v1alpha1"}
+
+func (c *Client) GetCurrentUser() (authenticationv1.UserInfo, error) {
+	var lastErr error
+	for _, version := range selfSubjectReviewVersions {
+		// the content of the SelfSubjectReview is the same for all the versions
+		body, err := json.Marshal(authenticationv1alpha1.SelfSubjectReview{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: authenticationv1.GroupName + "/" + version,
+				Kind:       "SelfSubjectReview",
+			},
+		})
+		if err != nil {
+			return authenticationv1.UserInfo{}, err
+		}
+		raw, err := c.KubeClient.AuthenticationV1().RESTClient().Post().
+			AbsPath("/apis", authenticationv1.GroupName, version, "selfsubjectreviews").
+			Body(body).
+			Do(context.Background()).
+			Raw()
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				klog.V(4).Infof("SelfSubjectReview %s not supported by the cluster", version)
+				lastErr = err
+				continue
+			}
+			return authenticationv1.UserInfo{}, err
+		}
+		var review authenticationv1alpha1.SelfSubjectReview
+		if err = json.Unmarshal(raw, &review); err != nil {
+			return authenticationv1.UserInfo{}, err
+		}
+		return review.Status.UserInfo, nil
+	}
+	return authenticationv1.UserInfo{}, lastErr
+}
+
+func (c *Client) GetSelfSubjectRules(namespace string) (authorizationv1.SubjectRulesReviewStatus, error) {
+	review, err := c.KubeClient.AuthorizationV1().SelfSubjectRulesReviews().Create(context.Background(), &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{
+			Namespace: namespace,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return authorizationv1.SubjectRulesReviewStatus{}, err
+	}
+	return review.Status, nil
+}
+
+func (c *Client) GetSelfSubjectAccess(attributes authorizationv1.ResourceAttributes) (authorizationv1.SubjectAccessReviewStatus, error) {
+	review, err := c.KubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(context.Background(), &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &attributes,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return authorizationv1.SubjectAccessReviewStatus{}, err
+	}
+	return review.Status, nil
+}
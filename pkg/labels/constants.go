@@ -28,6 +28,21 @@ const (
 	// astraProjectTypeAnnotation indicates the project type of the component
 	astraProjectTypeAnnotation = "astra.dev/project-type"
 
+	// astraDevfileNameAnnotation indicates the name of the Devfile used to deploy the component
+	astraDevfileNameAnnotation = "astra.dev/devfile-name"
+
+	// astraSessionHostAnnotation indicates the host on which the astra dev session deploying the component is running
+	astraSessionHostAnnotation = "astra.dev/session-host"
+
+	// astraSessionPIDAnnotation indicates the PID of the astra dev session deploying the component
+	astraSessionPIDAnnotation = "astra.dev/session-pid"
+
+	// astraSessionDirectoryAnnotation indicates the directory in which the astra dev session deploying the component is running
+	astraSessionDirectoryAnnotation = "astra.dev/session-directory"
+
+	// astraHeartbeatAnnotation indicates the last time the astra dev session deploying the component signaled it was running
+	astraHeartbeatAnnotation = "astra.dev/heartbeat"
+
 	appLabel = "app"
 
 	componentLabel = "component"
@@ -201,6 +201,22 @@ func SetProjectType(annotations map[string]string, value string) {
 	annotations[astraProjectTypeAnnotation] = value
 }
 
+// GetDevfileName returns the name of the Devfile used to deploy the component, or an empty string if unknown
+func GetDevfileName(labels map[string]string, annotations map[string]string) string {
+	if name, ok := annotations[astraDevfileNameAnnotation]; ok {
+		return name
+	}
+	return labels[astraDevfileNameAnnotation]
+}
+
+// SetDevfileName sets the name of the Devfile used to deploy the component, if not empty
+func SetDevfileName(annotations map[string]string, value string) {
+	if value == "" {
+		return
+	}
+	annotations[astraDevfileNameAnnotation] = value
+}
+
 func AddCommonAnnotations(annotations map[string]string) {
 	// Enable use of ImageStreams on OpenShift:
 	// https://github\.com/danielpickens/astra/issues/6376
@@ -0,0 +1,64 @@
+package labels
+
+import (
+	"strconv"
+	"time"
+
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+)
+
+// DevSession identifies the astra dev session which has deployed a component
+type DevSession struct {
+	// Host is the name of the host on which the session is running
+	Host string
+	// PID is the ID of the process of the session
+	PID int
+	// Directory is the directory in which the session is running
+	Directory string
+}
+
+// SetDevSession sets the information identifying the astra dev session in the annotations (or labels on Podman)
+func SetDevSession(annotations map[string]string, session DevSession) {
+	if session.Host != "" {
+		annotations[astraSessionHostAnnotation] = session.Host
+	}
+	if session.PID != 0 {
+		annotations[astraSessionPIDAnnotation] = strconv.Itoa(session.PID)
+	}
+	if session.Directory != "" {
+		annotations[astraSessionDirectoryAnnotation] = session.Directory
+	}
+}
+
+// GetDevSession returns the information identifying the astra dev session from the annotations (or labels on Podman).
+// The fields are empty if the component has been deployed by a version of astra not setting them
+func GetDevSession(annotations map[string]string) DevSession {
+	pid, _ := strconv.Atoi(annotations[astraSessionPIDAnnotation])
+	return DevSession{
+		Host:      annotations[astraSessionHostAnnotation],
+		PID:       pid,
+		Directory: annotations[astraSessionDirectoryAnnotation],
+	}
+}
+
+// SetHeartbeat sets the last time the astra dev session signaled it was running
+func SetHeartbeat(annotations map[string]string, t time.Time) {
+	annotations[astraHeartbeatAnnotation] = t.UTC().Format(time.RFC3339)
+}
+
+// GetHeartbeat returns the last time the astra dev session signaled it was running, or a zero time if unknown
+func GetHeartbeat(annotations map[string]string) time.Time {
+	t, err := time.Parse(time.RFC3339, annotations[astraHeartbeatAnnotation])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// GetDevModeSelector returns a selector matching the resources of all the components deployed by astra in Dev mode
+func GetDevModeSelector() string {
+	return k8slabels.Set{
+		kubernetesManagedByLabel: astraManager,
+		astraModeLabel:           ComponentDevMode,
+	}.String()
+}
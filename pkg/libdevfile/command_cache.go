@@ -0,0 +1,367 @@
+package libdevfile
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/util"
+)
+
+const (
+	// InputsAttribute is the attribute of an exec command listing the glob patterns of the local files read by the command,
+	// relative to the directory of the Devfile. A command declaring inputs is skipped when its inputs have not changed
+	// since its last successful execution
+	InputsAttribute = "dev.astra.inputs"
+	// OutputsAttribute is the attribute of an exec command listing the paths of the files written by the command in the container,
+	// relative to the working directory of the command. A command declaring outputs is not skipped if any of them is missing
+	OutputsAttribute = "dev.astra.outputs"
+)
+
+// commandCacheFile is the file, in the .astra directory, containing the hashes of the inputs
+// of the last successful execution of the commands
+const commandCacheFile = "command-cache.json"
+
+// OutputsChecker is implemented by the handlers able to check that the outputs of a command
+// exist where the command is executed
+type OutputsChecker interface {
+	OutputsExist(ctx context.Context, command v1alpha2.Command, outputs []string) (bool, error)
+}
+
+// TargetIdentifier is implemented by the handlers able to identify where a command is executed
+type TargetIdentifier interface {
+	// GetTargetId returns an identifier of the platform and of the container executing the command,
+	// changing when the pod or the container is recreated
+	GetTargetId(ctx context.Context, command v1alpha2.Command) (string, error)
+}
+
+// commandCache contains the hashes of the inputs of the last successful execution of the commands,
+// and of the container they were executed in, indexed by command Id
+type commandCache struct {
+	Commands map[string]string `json:"commands"`
+}
+
+// commandCacheMu serializes the accesses to the cache files, commands being possibly executed concurrently
+var commandCacheMu sync.Mutex
+
+// commandIO contains the inputs and outputs declared by a command
+type commandIO struct {
+	inputs  []string
+	outputs []string
+}
+
+// getCommandIO returns the inputs and outputs declared by the attributes of the command
+func getCommandIO(command v1alpha2.Command) (commandIO, error) {
+	var result commandIO
+	for key, into := range map[string]*[]string{
+		InputsAttribute:  &result.inputs,
+		OutputsAttribute: &result.outputs,
+	} {
+		if !command.Attributes.Exists(key) {
+			continue
+		}
+		if err := command.Attributes.GetInto(key, into); err != nil {
+			return commandIO{}, fmt.Errorf("invalid value for attribute %q of command %q, must be a list of strings: %w", key, command.Id, err)
+		}
+	}
+	return result, nil
+}
+
+// isDeclared returns true if the command declares inputs or outputs
+func (o commandIO) isDeclared() bool {
+	return len(o.inputs) != 0 || len(o.outputs) != 0
+}
+
+// executeCached executes the command with execute, unless the command declares inputs which have not changed
+// since its last successful execution in the same container, and its declared outputs still exist.
+// The command is always executed if the handler is not able to identify the container
+func executeCached(ctx context.Context, devfileObj parser.DevfileObj, command v1alpha2.Command, handler Handler, execute func() error) error {
+	declared, err := getCommandIO(command)
+	if err != nil {
+		return err
+	}
+	devfilePath := devfileObj.Ctx.GetAbsPath()
+	if len(declared.inputs) == 0 || devfilePath == "" {
+		return execute()
+	}
+	dir := filepath.Dir(devfilePath)
+
+	identifier, ok := handler.(TargetIdentifier)
+	if !ok {
+		klog.V(4).Infof("unable to identify the container of command %q, executing it", command.Id)
+		return execute()
+	}
+	target, err := identifier.GetTargetId(ctx, command)
+	if err != nil {
+		klog.V(4).Infof("unable to identify the container of command %q: %v", command.Id, err)
+		return execute()
+	}
+
+	hash, err := hashInputs(dir, target, command, declared.inputs)
+	if err != nil {
+		klog.V(2).Infof("unable to compute the hash of the inputs of command %q: %v", command.Id, err)
+		return execute()
+	}
+
+	if upToDate(ctx, dir, command, handler, hash, declared.outputs) {
+		log.Infof("Skipping command %q, its inputs have not changed since its last successful execution", command.Id)
+		return nil
+	}
+
+	err = execute()
+	if err != nil {
+		return err
+	}
+	if err = saveCommandHash(dir, command.Id, hash); err != nil {
+		klog.V(2).Infof("unable to save the hash of the inputs of command %q: %v", command.Id, err)
+	}
+	return nil
+}
+
+// upToDate returns true if hash is the hash of the inputs of the last successful execution of the command, and if its outputs exist
+func upToDate(ctx context.Context, dir string, command v1alpha2.Command, handler Handler, hash string, outputs []string) bool {
+	cache, err := readCommandCache(dir)
+	if err != nil {
+		klog.V(2).Infof("unable to read the cache of commands: %v", err)
+		return false
+	}
+	if cache.Commands[command.Id] != hash {
+		return false
+	}
+	if len(outputs) == 0 {
+		return true
+	}
+	checker, ok := handler.(OutputsChecker)
+	if !ok {
+		klog.V(4).Infof("unable to check the outputs of command %q, executing it", command.Id)
+		return false
+	}
+	exist, err := checker.OutputsExist(ctx, command, outputs)
+	if err != nil {
+		klog.V(4).Infof("unable to check the outputs of command %q: %v", command.Id, err)
+		return false
+	}
+	return exist
+}
+
+// hashInputs returns a hash of the target executing the command, of the definition of the command
+// and of the name and content of the files in dir matching the inputs glob patterns
+func hashInputs(dir string, target string, command v1alpha2.Command, inputs []string) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(target))
+	h.Write([]byte{0})
+	definition, err := json.Marshal(command.Exec)
+	if err != nil {
+		return "", err
+	}
+	h.Write(definition)
+
+	// only the directories which can contain files matching the patterns are walked
+	matched := map[string]struct{}{}
+	for _, root := range getWalkRoots(inputs) {
+		err = filepath.WalkDir(filepath.Join(dir, filepath.FromSlash(root)), func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			rel = filepath.ToSlash(rel)
+			if d.IsDir() {
+				if rel == util.DotastraDirectory || rel == ".git" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			match, err := dfutil.IsGlobExpMatch(rel, inputs)
+			if err != nil {
+				return err
+			}
+			if match {
+				matched[rel] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	files := make([]string, 0, len(matched))
+	for file := range matched {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		h.Write([]byte(file))
+		h.Write([]byte{0})
+		if err = hashFile(h, filepath.Join(dir, filepath.FromSlash(file))); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+func readCommandCache(dir string) (commandCache, error) {
+	commandCacheMu.Lock()
+	defer commandCacheMu.Unlock()
+	return readCommandCacheFile(dir)
+}
+
+func readCommandCacheFile(dir string) (commandCache, error) {
+	cache := commandCache{Commands: map[string]string{}}
+	content, err := os.ReadFile(filepath.Join(dir, util.DotastraDirectory, commandCacheFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return cache, nil
+		}
+		return cache, err
+	}
+	err = json.Unmarshal(content, &cache)
+	if cache.Commands == nil {
+		cache.Commands = map[string]string{}
+	}
+	return cache, err
+}
+
+// saveCommandHash saves hash as the hash of the inputs of the last successful execution of the command
+func saveCommandHash(dir string, commandId string, hash string) error {
+	commandCacheMu.Lock()
+	defer commandCacheMu.Unlock()
+	cache, err := readCommandCacheFile(dir)
+	if err != nil {
+		klog.V(4).Infof("overwriting the invalid cache of commands: %v", err)
+	}
+	cache.Commands[commandId] = hash
+	content, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	astraDir := filepath.Join(dir, util.DotastraDirectory)
+	if err = os.MkdirAll(astraDir, 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(astraDir, commandCacheFile), content, 0640)
+}
+
+// getWalkRoots returns the directories or files, relative to the Devfile directory, to walk to find the files matching the patterns
+func getWalkRoots(patterns []string) []string {
+	var roots []string
+	for _, pattern := range patterns {
+		root := staticPrefix(pattern)
+		if root == "" {
+			return []string{"."}
+		}
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+	// remove the roots contained in another root
+	result := make([]string, 0, len(roots))
+	for _, root := range roots {
+		if len(result) != 0 {
+			last := result[len(result)-1]
+			if root == last || strings.HasPrefix(root, last+"/") {
+				continue
+			}
+		}
+		result = append(result, root)
+	}
+	return result
+}
+
+// inContainer returns the inputs and outputs as absolute paths in the container of the command, for them to be compared:
+// the inputs, relative to the Devfile directory, are synchronized into the source mapping of the container,
+// and the outputs are relative to the working directory of the command, or to the source mapping if the command has none
+func (o commandIO) inContainer(sourceMapping string, workingDir string) commandIO {
+	if sourceMapping == "" {
+		sourceMapping = generator.DevfileSourceVolumeMount
+	}
+	workingDir = os.Expand(workingDir, func(name string) string {
+		if name == generator.EnvProjectsSrc || name == generator.EnvProjectsRoot {
+			return sourceMapping
+		}
+		return "${" + name + "}"
+	})
+	workingDir = resolvePath(sourceMapping, workingDir)
+	result := commandIO{
+		inputs:  make([]string, 0, len(o.inputs)),
+		outputs: make([]string, 0, len(o.outputs)),
+	}
+	for _, input := range o.inputs {
+		result.inputs = append(result.inputs, resolvePath(sourceMapping, input))
+	}
+	for _, output := range o.outputs {
+		result.outputs = append(result.outputs, resolvePath(workingDir, output))
+	}
+	return result
+}
+
+// resolvePath returns the path or glob pattern p, in the slash-separated form, resolved against the absolute directory base
+func resolvePath(base string, p string) string {
+	p = filepath.ToSlash(p)
+	if path.IsAbs(p) {
+		return path.Clean(p)
+	}
+	return path.Join(base, p)
+}
+
+// overlap returns true if any of the paths or glob patterns of first may designate the same files as any of second.
+// The paths must be expressed relative to the same directory, or be absolute
+func overlap(first, second []string) bool {
+	for _, a := range first {
+		for _, b := range second {
+			pa, pb := staticPrefix(a), staticPrefix(b)
+			if pa == "" || pb == "" || pa == pb || strings.HasPrefix(pa, pb+"/") || strings.HasPrefix(pb, pa+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// staticPrefix returns the directories of the path or glob pattern preceding the first pattern character
+func staticPrefix(pattern string) string {
+	pattern = filepath.ToSlash(pattern)
+	pattern = strings.TrimPrefix(pattern, "./")
+	if i := strings.IndexAny(pattern, "*?[{"); i >= 0 {
+		pattern = pattern[:i]
+		if j := strings.LastIndex(pattern, "/"); j >= 0 {
+			pattern = pattern[:j]
+		} else {
+			pattern = ""
+		}
+	}
+	return strings.TrimSuffix(pattern, "/")
+}
@@ -0,0 +1,285 @@
+package libdevfile
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	devfileCtx "github.com/devfile/library/v2/pkg/devfile/parser/context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func Test_getDependencies(t *testing.T) {
+	tests := []struct {
+		name string
+		ios  []commandIO
+		want [][]int
+	}{
+		{
+			name: "commands without declarations are executed in order",
+			ios:  []commandIO{{}, {}, {}},
+			want: [][]int{nil, {0}, {0, 1}},
+		},
+		{
+			name: "independent commands",
+			ios: []commandIO{
+				{inputs: []string{"package.json"}, outputs: []string{"node_modules"}},
+				{inputs: []string{"api/*.yaml"}, outputs: []string{"src/generated"}},
+			},
+			want: [][]int{nil, nil},
+		},
+		{
+			name: "command reading the outputs of a previous command",
+			ios: []commandIO{
+				{inputs: []string{"package.json"}, outputs: []string{"node_modules"}},
+				{inputs: []string{"api/*.yaml"}, outputs: []string{"src/generated"}},
+				{inputs: []string{"src/**/*.ts"}, outputs: []string{"dist"}},
+			},
+			want: [][]int{nil, nil, {1}},
+		},
+		{
+			name: "command without declarations depends on all the previous commands",
+			ios: []commandIO{
+				{inputs: []string{"package.json"}, outputs: []string{"node_modules"}},
+				{inputs: []string{"api/*.yaml"}, outputs: []string{"src/generated"}},
+				{},
+			},
+			want: [][]int{nil, nil, {0, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getDependencies(tt.ios)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getDependencies() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_executeGraph(t *testing.T) {
+	t.Run("nodes are executed after their dependencies", func(t *testing.T) {
+		var mu sync.Mutex
+		var executed []int
+		err := executeGraph([][]int{nil, nil, {0, 1}, {2}}, func(i int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			executed = append(executed, i)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("executeGraph() unexpected error: %v", err)
+		}
+		if len(executed) != 4 || executed[2] != 2 || executed[3] != 3 {
+			t.Errorf("executeGraph() unexpected order of execution: %v", executed)
+		}
+	})
+
+	t.Run("dependents of a failed node are not executed", func(t *testing.T) {
+		var mu sync.Mutex
+		executed := map[int]bool{}
+		err := executeGraph([][]int{nil, {0}, nil}, func(i int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			executed[i] = true
+			if i == 0 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		if err == nil {
+			t.Errorf("executeGraph() expected an error")
+		}
+		if executed[1] {
+			t.Errorf("executeGraph() executed a node depending on a failed node")
+		}
+	})
+}
+
+func Test_executeCached(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"name": "app"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{
+		Ctx: devfileCtx.NewDevfileCtx(filepath.Join(dir, "devfile.yaml")),
+	}
+	if err := devfileObj.Ctx.SetAbsPath(); err != nil {
+		t.Fatal(err)
+	}
+	command := v1alpha2.Command{
+		Id: "install",
+		Attributes: attributes.Attributes{}.FromMap(map[string]interface{}{
+			InputsAttribute: []string{"package.json"},
+		}, nil),
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{
+				CommandLine: "npm install",
+				Component:   "runtime",
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	handler := &identifiedHandler{Handler: NewMockHandler(ctrl), target: "cluster/pod-uid/container-1"}
+	executions := 0
+	execute := func() error {
+		executions++
+		return nil
+	}
+
+	for _, step := range []struct {
+		name           string
+		change         func()
+		wantExecutions int
+	}{
+		{name: "first execution", wantExecutions: 1},
+		{name: "inputs not changed", wantExecutions: 1},
+		{
+			name: "inputs changed",
+			change: func() {
+				_ = os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"name": "app", "version": "2"}`), 0600)
+			},
+			wantExecutions: 2,
+		},
+		{
+			name: "command changed",
+			change: func() {
+				command.Exec.CommandLine = "npm ci"
+			},
+			wantExecutions: 3,
+		},
+		{
+			name: "container recreated",
+			change: func() {
+				handler.target = "cluster/pod-uid/container-2"
+			},
+			wantExecutions: 4,
+		},
+		{
+			name: "pod recreated on another platform",
+			change: func() {
+				handler.target = "podman/pod-uid/container-2"
+			},
+			wantExecutions: 5,
+		},
+	} {
+		if step.change != nil {
+			step.change()
+		}
+		if err := executeCached(context.Background(), devfileObj, command, handler, execute); err != nil {
+			t.Fatalf("%s: executeCached() unexpected error: %v", step.name, err)
+		}
+		if executions != step.wantExecutions {
+			t.Errorf("%s: command executed %d times, want %d", step.name, executions, step.wantExecutions)
+		}
+	}
+}
+
+func Test_executeCached_unidentifiedContainer(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"name": "app"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{
+		Ctx: devfileCtx.NewDevfileCtx(filepath.Join(dir, "devfile.yaml")),
+	}
+	if err := devfileObj.Ctx.SetAbsPath(); err != nil {
+		t.Fatal(err)
+	}
+	command := v1alpha2.Command{
+		Id: "install",
+		Attributes: attributes.Attributes{}.FromMap(map[string]interface{}{
+			InputsAttribute: []string{"package.json"},
+		}, nil),
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{
+				CommandLine: "npm install",
+				Component:   "runtime",
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	handler := NewMockHandler(ctrl)
+	executions := 0
+	for i := 0; i < 2; i++ {
+		err := executeCached(context.Background(), devfileObj, command, handler, func() error {
+			executions++
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("executeCached() unexpected error: %v", err)
+		}
+	}
+	if executions != 2 {
+		t.Errorf("command executed %d times, want 2", executions)
+	}
+}
+
+func Test_commandIO_inContainer(t *testing.T) {
+	tests := []struct {
+		name          string
+		io            commandIO
+		sourceMapping string
+		workingDir    string
+		want          commandIO
+	}{
+		{
+			name: "default source mapping and no working directory",
+			io:   commandIO{inputs: []string{"package.json", "src/**/*.ts"}, outputs: []string{"dist"}},
+			want: commandIO{inputs: []string{"/projects/package.json", "/projects/src/**/*.ts"}, outputs: []string{"/projects/dist"}},
+		},
+		{
+			name:          "working directory relative to the project source",
+			io:            commandIO{inputs: []string{"./api/*.yaml"}, outputs: []string{"generated", "/tmp/cache"}},
+			sourceMapping: "/src",
+			workingDir:    "${PROJECT_SOURCE}/server",
+			want:          commandIO{inputs: []string{"/src/api/*.yaml"}, outputs: []string{"/src/server/generated", "/tmp/cache"}},
+		},
+		{
+			name:       "relative working directory",
+			io:         commandIO{outputs: []string{"../dist"}},
+			workingDir: "web",
+			want:       commandIO{inputs: []string{}, outputs: []string{"/projects/dist"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.io.inContainer(tt.sourceMapping, tt.workingDir)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(commandIO{}), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("commandIO.inContainer() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getDependencies_workingDir(t *testing.T) {
+	// the second command reads the files generated by the first one, executed in a sub-directory
+	ios := []commandIO{
+		commandIO{inputs: []string{"api/*.yaml"}, outputs: []string{"generated"}}.inContainer("", "${PROJECT_SOURCE}/web"),
+		commandIO{inputs: []string{"web/generated/**"}, outputs: []string{"dist"}}.inContainer("", ""),
+	}
+	want := [][]int{nil, {0}}
+	if diff := cmp.Diff(want, getDependencies(ios)); diff != "" {
+		t.Errorf("getDependencies() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// identifiedHandler is a handler executing the commands in the target container
+type identifiedHandler struct {
+	Handler
+	target string
+}
+
+func (o *identifiedHandler) GetTargetId(ctx context.Context, command v1alpha2.Command) (string, error) {
+	return o.target, nil
+}
@@ -39,24 +39,118 @@ func (o *compositeCommand) CheckValidity() error {
 	return nil
 }
 
-// Execute loops over each command and executes them serially
+// Execute executes the commands as a graph: a command is executed once the commands it depends on are executed,
+// and the independent commands are executed concurrently. See getDependencies
 func (o *compositeCommand) Execute(ctx context.Context, handler Handler, parentGroup *v1alpha2.CommandGroup) error {
 	allCommands, err := allCommandsMap(o.devfileObj)
 	if err != nil {
 		return err
 	}
+	if parentGroup == nil {
+		parentGroup = o.command.Composite.Group
+	}
+	cmds := make([]command, 0, len(o.command.Composite.Commands))
+	ios := make([]commandIO, 0, len(o.command.Composite.Commands))
 	for _, devfileCmd := range o.command.Composite.Commands {
-		cmd, err := newCommand(o.devfileObj, allCommands[strings.ToLower(devfileCmd)])
+		subCommand := allCommands[strings.ToLower(devfileCmd)]
+		cmd, err := newCommand(o.devfileObj, subCommand)
 		if err != nil {
 			return err
 		}
-		if parentGroup == nil {
-			parentGroup = o.command.Composite.Group
+		cmds = append(cmds, cmd)
+
+		// only exec commands can declare inputs and outputs
+		var cmdIO commandIO
+		if subCommand.Exec != nil {
+			cmdIO, err = getCommandIO(subCommand)
+			if err != nil {
+				return err
+			}
+			var sourceMapping string
+			comp, ok, err := FindComponentByName(o.devfileObj.Data, subCommand.Exec.Component)
+			if err != nil {
+				return err
+			}
+			if ok && comp.Container != nil {
+				sourceMapping = comp.Container.SourceMapping
+			}
+			cmdIO = cmdIO.inContainer(sourceMapping, subCommand.Exec.WorkingDir)
 		}
-		err = cmd.Execute(ctx, handler, parentGroup)
-		if err != nil {
-			return err
+		ios = append(ios, cmdIO)
+	}
+	return executeGraph(getDependencies(ios), func(i int) error {
+		return cmds[i].Execute(ctx, handler, parentGroup)
+	})
+}
+
+// getDependencies returns, for each command of a composite command, the indexes of the previous commands it depends on.
+// The inputs and outputs of the commands must be expressed in the same path space, see commandIO.inContainer.
+// A command depends on a previous one if any of them does not declare its inputs and outputs, keeping the order of the composite command,
+// or if the outputs of one can be the inputs or outputs of the other
+func getDependencies(ios []commandIO) [][]int {
+	dependencies := make([][]int, len(ios))
+	for j := range ios {
+		for i := 0; i < j; i++ {
+			if !ios[i].isDeclared() || !ios[j].isDeclared() ||
+				overlap(ios[i].outputs, ios[j].inputs) ||
+				overlap(ios[i].outputs, ios[j].outputs) ||
+				overlap(ios[i].inputs, ios[j].outputs) {
+				dependencies[j] = append(dependencies[j], i)
+			}
 		}
 	}
-	return nil
+	return dependencies
+}
+
+// executeGraph executes run for each node of the graph, once run has been executed successfully for the nodes it depends on.
+// After a failure, no other node is started, and the first error is returned once the running nodes are terminated
+func executeGraph(dependencies [][]int, run func(i int) error) error {
+	type result struct {
+		index int
+		err   error
+	}
+	pending := make([]int, len(dependencies))
+	dependents := make([][]int, len(dependencies))
+	for j, deps := range dependencies {
+		pending[j] = len(deps)
+		for _, i := range deps {
+			dependents[i] = append(dependents[i], j)
+		}
+	}
+
+	results := make(chan result, len(dependencies))
+	running := 0
+	start := func(i int) {
+		running++
+		go func() {
+			results <- result{index: i, err: run(i)}
+		}()
+	}
+	for i := range dependencies {
+		if pending[i] == 0 {
+			start(i)
+		}
+	}
+
+	var firstErr error
+	for running > 0 {
+		r := <-results
+		running--
+		if r.err != nil {
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			continue
+		}
+		if firstErr != nil {
+			continue
+		}
+		for _, j := range dependents[r.index] {
+			pending[j]--
+			if pending[j] == 0 {
+				start(j)
+			}
+		}
+	}
+	return firstErr
 }
@@ -28,9 +28,13 @@ func (o *execCommand) CheckValidity() error {
 	return nil
 }
 
+// Execute executes the command with the handler. A terminating command is skipped if its inputs have not changed
+// since its last successful execution (see InputsAttribute)
 func (o *execCommand) Execute(ctx context.Context, handler Handler, parentGroup *v1alpha2.CommandGroup) error {
 	if o.isTerminating(parentGroup) {
-		return handler.ExecuteTerminatingCommand(ctx, o.command)
+		return executeCached(ctx, o.devfileObj, o.command, handler, func() error {
+			return handler.ExecuteTerminatingCommand(ctx, o.command)
+		})
 	}
 	return handler.ExecuteNonTerminatingCommand(ctx, o.command)
 }
@@ -0,0 +1,80 @@
+package libdevfile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+)
+
+// CommandOverrides contains the changes to apply to an exec command of the Devfile before executing it
+type CommandOverrides struct {
+	// Args are appended to the command line of the command
+	Args []string
+	// Env contains the environment variables to add to the command, or to override
+	Env []v1alpha2.EnvVar
+	// WorkingDir replaces the working directory of the command, if not empty
+	WorkingDir string
+}
+
+// IsEmpty returns true if no change is defined
+func (o CommandOverrides) IsEmpty() bool {
+	return len(o.Args) == 0 && len(o.Env) == 0 && o.WorkingDir == ""
+}
+
+// OverrideCommand applies the overrides to the exec command commandName of the Devfile.
+// Only the in-memory Devfile is modified.
+func OverrideCommand(devfileObj parser.DevfileObj, commandName string, overrides CommandOverrides) error {
+	if overrides.IsEmpty() {
+		return nil
+	}
+	commands, err := devfileObj.Data.GetCommands(common.DevfileOptions{
+		FilterByName: commandName,
+	})
+	if err != nil {
+		return err
+	}
+	if len(commands) != 1 {
+		return NewNoCommandFoundError("", commandName)
+	}
+	command := commands[0]
+	if command.Exec == nil {
+		return fmt.Errorf("arguments, environment variables and working directory can be overridden only for exec commands, %q is not an exec command", commandName)
+	}
+
+	if len(overrides.Args) != 0 {
+		command.Exec.CommandLine = command.Exec.CommandLine + " " + shellJoin(overrides.Args)
+	}
+	for _, env := range overrides.Env {
+		command.Exec.Env = setEnvVar(command.Exec.Env, env)
+	}
+	if overrides.WorkingDir != "" {
+		command.Exec.WorkingDir = overrides.WorkingDir
+	}
+	return devfileObj.Data.UpdateCommand(command)
+}
+
+// setEnvVar replaces the value of the variable env in envs if it is already defined, or adds it otherwise
+func setEnvVar(envs []v1alpha2.EnvVar, env v1alpha2.EnvVar) []v1alpha2.EnvVar {
+	for i := range envs {
+		if envs[i].Name == env.Name {
+			envs[i].Value = env.Value
+			return envs
+		}
+	}
+	return append(envs, env)
+}
+
+// shellJoin joins the arguments into a command line, quoting the arguments when necessary
+func shellJoin(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"\\$`&|;<>()*?#~{}[]!") {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		quoted = append(quoted, arg)
+	}
+	return strings.Join(quoted, " ")
+}
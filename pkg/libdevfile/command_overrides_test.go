@@ -0,0 +1,114 @@
+package libdevfile
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOverrideCommand(t *testing.T) {
+	newDevfileObj := func(t *testing.T) parser.DevfileObj {
+		devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = devfileData.AddCommands([]v1alpha2.Command{
+			{
+				Id: "migrate",
+				CommandUnion: v1alpha2.CommandUnion{
+					Exec: &v1alpha2.ExecCommand{
+						CommandLine: "./manage.py migrate",
+						Component:   "runtime",
+						WorkingDir:  "/projects",
+						Env:         []v1alpha2.EnvVar{{Name: "LOG_LEVEL", Value: "info"}},
+					},
+				},
+			},
+			{
+				Id: "all",
+				CommandUnion: v1alpha2.CommandUnion{
+					Composite: &v1alpha2.CompositeCommand{Commands: []string{"migrate"}},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parser.DevfileObj{Data: devfileData}
+	}
+
+	tests := []struct {
+		name        string
+		commandName string
+		overrides   CommandOverrides
+		want        *v1alpha2.ExecCommand
+		wantErr     bool
+	}{
+		{
+			name:        "no overrides",
+			commandName: "migrate",
+			want: &v1alpha2.ExecCommand{
+				CommandLine: "./manage.py migrate",
+				Component:   "runtime",
+				WorkingDir:  "/projects",
+				Env:         []v1alpha2.EnvVar{{Name: "LOG_LEVEL", Value: "info"}},
+			},
+		},
+		{
+			name:        "args, env and working directory",
+			commandName: "migrate",
+			overrides: CommandOverrides{
+				Args: []string{"--database", "my db", "it's"},
+				Env: []v1alpha2.EnvVar{
+					{Name: "LOG_LEVEL", Value: "debug"},
+					{Name: "DRY_RUN", Value: "true"},
+				},
+				WorkingDir: "/projects/app",
+			},
+			want: &v1alpha2.ExecCommand{
+				CommandLine: `./manage.py migrate --database 'my db' 'it'\''s'`,
+				Component:   "runtime",
+				WorkingDir:  "/projects/app",
+				Env: []v1alpha2.EnvVar{
+					{Name: "LOG_LEVEL", Value: "debug"},
+					{Name: "DRY_RUN", Value: "true"},
+				},
+			},
+		},
+		{
+			name:        "composite command",
+			commandName: "all",
+			overrides:   CommandOverrides{Args: []string{"--verbose"}},
+			wantErr:     true,
+		},
+		{
+			name:        "unknown command",
+			commandName: "unknown",
+			overrides:   CommandOverrides{Args: []string{"--verbose"}},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileObj := newDevfileObj(t)
+			err := OverrideCommand(devfileObj, tt.commandName, tt.overrides)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OverrideCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			commands, err := devfileObj.Data.GetCommands(common.DevfileOptions{FilterByName: tt.commandName})
+			if err != nil || len(commands) != 1 {
+				t.Fatalf("unable to get command %q: %v", tt.commandName, err)
+			}
+			if diff := cmp.Diff(tt.want, commands[0].Exec); diff != "" {
+				t.Errorf("OverrideCommand() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
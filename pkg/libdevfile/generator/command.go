@@ -22,14 +22,6 @@ func GetCompositeCommand(params CompositeCommandParams) v1alpha2.Command {
 		Id: params.Id,
 		CommandUnion: v1alpha2.CommandUnion{
 			Composite: &v1alpha2.CompositeCommand{
-				LabeledCommand: v1alpha2.LabeledCommand{
-					BaseCommand: v1alpha2.BaseCommand{
-						Group: &v1alpha2.CommandGroup{
-							Kind:      params.Kind,
-							IsDefault: params.IsDefault,
-						},
-					},
-				},
 				Commands: params.Commands,
 				Parallel: params.Parallel,
 			},
@@ -41,6 +33,12 @@ func GetCompositeCommand(params CompositeCommandParams) v1alpha2.Command {
 	if params.Label != nil {
 		cmd.Composite.Label = *params.Label
 	}
+	if params.Kind != "" {
+		cmd.Composite.Group = &v1alpha2.CommandGroup{
+			Kind:      params.Kind,
+			IsDefault: params.IsDefault,
+		}
+	}
 	return cmd
 }
 
@@ -64,14 +62,6 @@ func GetExecCommand(params ExecCommandParams) v1alpha2.Command {
 		Id: params.Id,
 		CommandUnion: v1alpha2.CommandUnion{
 			Exec: &v1alpha2.ExecCommand{
-				LabeledCommand: v1alpha2.LabeledCommand{
-					BaseCommand: v1alpha2.BaseCommand{
-						Group: &v1alpha2.CommandGroup{
-							Kind:      params.Kind,
-							IsDefault: params.IsDefault,
-						},
-					},
-				},
 				CommandLine:      params.CommandLine,
 				Component:        params.Component,
 				WorkingDir:       params.WorkingDir,
@@ -84,7 +74,13 @@ func GetExecCommand(params ExecCommandParams) v1alpha2.Command {
 		cmd.Attributes = *params.Attributes
 	}
 	if params.Label != nil {
-		cmd.Composite.Label = *params.Label
+		cmd.Exec.Label = *params.Label
+	}
+	if params.Kind != "" {
+		cmd.Exec.Group = &v1alpha2.CommandGroup{
+			Kind:      params.Kind,
+			IsDefault: params.IsDefault,
+		}
 	}
 	return cmd
 }
@@ -105,14 +101,6 @@ func GetApplyCommand(params ApplyCommandParams) v1alpha2.Command {
 		Id: params.Id,
 		CommandUnion: v1alpha2.CommandUnion{
 			Apply: &v1alpha2.ApplyCommand{
-				LabeledCommand: v1alpha2.LabeledCommand{
-					BaseCommand: v1alpha2.BaseCommand{
-						Group: &v1alpha2.CommandGroup{
-							Kind:      params.Kind,
-							IsDefault: params.IsDefault,
-						},
-					},
-				},
 				Component: params.Component,
 			},
 		},
@@ -121,7 +109,13 @@ func GetApplyCommand(params ApplyCommandParams) v1alpha2.Command {
 		cmd.Attributes = *params.Attributes
 	}
 	if params.Label != nil {
-		cmd.Composite.Label = *params.Label
+		cmd.Apply.Label = *params.Label
+	}
+	if params.Kind != "" {
+		cmd.Apply.Group = &v1alpha2.CommandGroup{
+			Kind:      params.Kind,
+			IsDefault: params.IsDefault,
+		}
 	}
 	return cmd
 }
@@ -0,0 +1,64 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/klog"
+)
+
+// redactedValue replaces the values of the secrets in the outputs
+const redactedValue = "******"
+
+// minSecretLength is the minimal length of the values redacted from the outputs.
+// Shorter values, like "1" or "true", would redact unrelated parts of the outputs
+const minSecretLength = 6
+
+var (
+	secretsMu sync.RWMutex
+	secrets   []string
+)
+
+// AddSecret registers a value to be redacted from the messages displayed by this package
+// and from the machine readable outputs.
+// Values shorter than minSecretLength characters are not redacted
+func AddSecret(value string) {
+	if value == "" {
+		return
+	}
+	if len(value) < minSecretLength {
+		klog.Warningf("the value of a secret is shorter than %d characters, it is not redacted from the outputs", minSecretLength)
+		return
+	}
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+	secrets = append(secrets, value)
+	// the value is also redacted when encoded in a JSON string
+	if encoded, err := json.Marshal(value); err == nil {
+		if escaped := string(encoded[1 : len(encoded)-1]); escaped != value {
+			secrets = append(secrets, escaped)
+		}
+	}
+}
+
+// Redact returns s with the values of the registered secrets replaced
+func Redact(s string) string {
+	secretsMu.RLock()
+	defer secretsMu.RUnlock()
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, redactedValue)
+	}
+	return s
+}
+
+// sprintf is fmt.Sprintf, with the values of the registered secrets redacted
+func sprintf(format string, a ...interface{}) string {
+	return Redact(fmt.Sprintf(format, a...))
+}
+
+// sprintln is fmt.Sprintln, with the values of the registered secrets redacted
+func sprintln(a ...interface{}) string {
+	return Redact(fmt.Sprintln(a...))
+}
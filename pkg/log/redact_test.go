@@ -0,0 +1,38 @@
+package log
+
+import "testing"
+
+func TestRedact(t *testing.T) {
+	AddSecret("s3cr3t-t0ken")
+	AddSecret("pa\"ss")
+	AddSecret("true")
+
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "registered secret",
+			s:    "token: s3cr3t-t0ken",
+			want: "token: ******",
+		},
+		{
+			name: "secret encoded in a JSON string",
+			s:    `{"token": "s3cr3t-t0ken"}`,
+			want: `{"token": "******"}`,
+		},
+		{
+			name: "values shorter than the minimal length are not redacted",
+			s:    `enabled: true, password: pa"ss`,
+			want: `enabled: true, password: pa"ss`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Redact(tt.s); got != tt.want {
+				t.Errorf("Redact() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
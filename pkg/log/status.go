@@ -115,7 +115,7 @@ func (s *Status) Start(status string, debug bool) {
 
 	// set new status
 	isTerm := IsTerminal(s.writer)
-	s.status = status
+	s.status = Redact(status)
 
 	// If we are in debug mode, don't spin!
 	// In under no circumstances do we output if we're using -o json.. to
@@ -227,7 +227,7 @@ func (s *Status) EndWithStatus(status string, success bool) {
 	if status == "" {
 		return
 	}
-	s.status = status
+	s.status = Redact(status)
 	s.End(success)
 }
 
@@ -235,7 +235,7 @@ func (s *Status) EndWithStatus(status string, success bool) {
 // • <message>
 func Printf(format string, a ...interface{}) {
 	if !IsJSON() {
-		fmt.Fprintf(GetStdout(), "%s%s%s%s\n", prefixSpacing, getSpacingString(), suffixSpacing, fmt.Sprintf(format, a...))
+		fmt.Fprintf(GetStdout(), "%s%s%s%s\n", prefixSpacing, getSpacingString(), suffixSpacing, sprintf(format, a...))
 	}
 }
 
@@ -243,7 +243,7 @@ func Printf(format string, a ...interface{}) {
 // • <message>
 func Fprintf(w io.Writer, format string, a ...interface{}) {
 	if !IsJSON() {
-		fmt.Fprintf(w, "%s%s%s%s\n", prefixSpacing, getSpacingString(), suffixSpacing, fmt.Sprintf(format, a...))
+		fmt.Fprintf(w, "%s%s%s%s\n", prefixSpacing, getSpacingString(), suffixSpacing, sprintf(format, a...))
 	}
 }
 
@@ -266,7 +266,7 @@ func Fprintln(w io.Writer) {
 func Success(a ...interface{}) {
 	if !IsJSON() {
 		green := color.New(color.FgGreen).SprintFunc()
-		fmt.Fprintf(GetStdout(), "%s%s%s%s", prefixSpacing, green(getSuccessString()), suffixSpacing, fmt.Sprintln(a...))
+		fmt.Fprintf(GetStdout(), "%s%s%s%s", prefixSpacing, green(getSuccessString()), suffixSpacing, sprintln(a...))
 	}
 }
 
@@ -276,7 +276,7 @@ func Success(a ...interface{}) {
 func Successf(format string, a ...interface{}) {
 	if !IsJSON() {
 		green := color.New(color.FgGreen).SprintFunc()
-		fmt.Fprintf(GetStdout(), "%s%s%s%s\n", prefixSpacing, green(getSuccessString()), suffixSpacing, fmt.Sprintf(format, a...))
+		fmt.Fprintf(GetStdout(), "%s%s%s%s\n", prefixSpacing, green(getSuccessString()), suffixSpacing, sprintf(format, a...))
 	}
 }
 
@@ -309,7 +309,7 @@ func Warningf(format string, a ...interface{}) {
 func Fwarningf(w io.Writer, format string, a ...interface{}) {
 	if !IsJSON() {
 		yellow := color.New(color.FgYellow).SprintFunc()
-		fullMessage := fmt.Sprintf("%s%s%s", getWarningString(), suffixSpacing, fmt.Sprintf(format, a...))
+		fullMessage := fmt.Sprintf("%s%s%s", getWarningString(), suffixSpacing, sprintf(format, a...))
 		fmt.Fprintln(w, yellow(wrapWarningMessage(fullMessage)))
 	}
 }
@@ -337,7 +337,7 @@ func wrapWarningMessage(fullMessage string) string {
 func Fsuccess(out io.Writer, a ...interface{}) {
 	if !IsJSON() {
 		green := color.New(color.FgGreen).SprintFunc()
-		fmt.Fprintf(out, "%s%s%s%s", prefixSpacing, green(getSuccessString()), suffixSpacing, fmt.Sprintln(a...))
+		fmt.Fprintf(out, "%s%s%s%s", prefixSpacing, green(getSuccessString()), suffixSpacing, sprintln(a...))
 	}
 }
 
@@ -393,9 +393,9 @@ func Sectionf(format string, a ...interface{}) {
 	if !IsJSON() {
 		blue := color.New(color.FgBlue).Add(color.Underline).SprintFunc()
 		if runtime.GOOS == "windows" {
-			fmt.Fprintf(GetStdout(), "\n- %s\n", blue(fmt.Sprintf(format, a...)))
+			fmt.Fprintf(GetStdout(), "\n- %s\n", blue(sprintf(format, a...)))
 		} else {
-			fmt.Fprintf(GetStdout(), "\n↪ %s\n", blue(fmt.Sprintf(format, a...)))
+			fmt.Fprintf(GetStdout(), "\n↪ %s\n", blue(sprintf(format, a...)))
 		}
 	}
 }
@@ -406,9 +406,9 @@ func Section(a ...interface{}) {
 	if !IsJSON() {
 		blue := color.New(color.FgBlue).Add(color.Underline).SprintFunc()
 		if runtime.GOOS == "windows" {
-			fmt.Fprintf(GetStdout(), "\n- %s", blue(fmt.Sprintln(a...)))
+			fmt.Fprintf(GetStdout(), "\n- %s", blue(sprintln(a...)))
 		} else {
-			fmt.Fprintf(GetStdout(), "\n↪ %s", blue(fmt.Sprintln(a...)))
+			fmt.Fprintf(GetStdout(), "\n↪ %s", blue(sprintln(a...)))
 		}
 	}
 }
@@ -429,7 +429,7 @@ func Deprecate(what, nextAction string) {
 func Errorf(format string, a ...interface{}) {
 	if !IsJSON() {
 		red := color.New(color.FgRed).SprintFunc()
-		fmt.Fprintf(GetStderr(), " %s%s%s\n", red(getErrString()), suffixSpacing, fmt.Sprintf(format, a...))
+		fmt.Fprintf(GetStderr(), " %s%s%s\n", red(getErrString()), suffixSpacing, sprintf(format, a...))
 	}
 }
 
@@ -438,7 +438,7 @@ func Errorf(format string, a ...interface{}) {
 func Ferrorf(w io.Writer, format string, a ...interface{}) {
 	if !IsJSON() {
 		red := color.New(color.FgRed).SprintFunc()
-		fmt.Fprintf(w, " %s%s%s\n", red(getErrString()), suffixSpacing, fmt.Sprintf(format, a...))
+		fmt.Fprintf(w, " %s%s%s\n", red(getErrString()), suffixSpacing, sprintf(format, a...))
 	}
 }
 
@@ -447,7 +447,7 @@ func Ferrorf(w io.Writer, format string, a ...interface{}) {
 func Error(a ...interface{}) {
 	if !IsJSON() {
 		red := color.New(color.FgRed).SprintFunc()
-		fmt.Fprintf(GetStderr(), "%s%s%s%s", prefixSpacing, red(getErrString()), suffixSpacing, fmt.Sprintln(a...))
+		fmt.Fprintf(GetStderr(), "%s%s%s%s", prefixSpacing, red(getErrString()), suffixSpacing, sprintln(a...))
 	}
 }
 
@@ -456,7 +456,7 @@ func Error(a ...interface{}) {
 func Ferror(w io.Writer, a ...interface{}) {
 	if !IsJSON() {
 		red := color.New(color.FgRed).SprintFunc()
-		fmt.Fprintf(w, "%s%s%s%s", prefixSpacing, red(getErrString()), suffixSpacing, fmt.Sprintln(a...))
+		fmt.Fprintf(w, "%s%s%s%s", prefixSpacing, red(getErrString()), suffixSpacing, sprintln(a...))
 	}
 }
 
@@ -466,7 +466,7 @@ func Ferror(w io.Writer, a ...interface{}) {
 func Info(a ...interface{}) {
 	if !IsJSON() {
 		bold := color.New(color.Bold).SprintFunc()
-		fmt.Fprintf(GetStdout(), "%s", bold(fmt.Sprintln(a...)))
+		fmt.Fprintf(GetStdout(), "%s", bold(sprintln(a...)))
 	}
 }
 
@@ -476,7 +476,7 @@ func Info(a ...interface{}) {
 func Infof(format string, a ...interface{}) {
 	if !IsJSON() {
 		bold := color.New(color.Bold).SprintFunc()
-		fmt.Fprintf(GetStdout(), "%s\n", bold(fmt.Sprintf(format, a...)))
+		fmt.Fprintf(GetStdout(), "%s\n", bold(sprintf(format, a...)))
 	}
 }
 
@@ -491,9 +491,9 @@ func Finfof(w io.Writer, format string, a ...interface{}) {
 		bold := color.New(color.Bold).SprintFunc()
 
 		if runtime.GOOS == "windows" {
-			fmt.Fprintf(w, "%s\n", fmt.Sprintf(format, a...))
+			fmt.Fprintf(w, "%s\n", sprintf(format, a...))
 		} else {
-			fmt.Fprintf(w, "%s\n", bold(fmt.Sprintf(format, a...)))
+			fmt.Fprintf(w, "%s\n", bold(sprintf(format, a...)))
 		}
 
 	}
@@ -525,7 +525,7 @@ func SboldColor(c color.Attribute, s string) string {
 func Describef(title string, format string, a ...interface{}) {
 	if !IsJSON() {
 		bold := color.New(color.Bold).SprintFunc()
-		fmt.Fprintf(GetStdout(), "%s%s\n", bold(title), fmt.Sprintf(format, a...))
+		fmt.Fprintf(GetStdout(), "%s%s\n", bold(title), sprintf(format, a...))
 	}
 }
 
@@ -542,7 +542,7 @@ func Spinner(status string) *Status {
 // for situations where spinning isn't viable (debug)
 func Spinnerf(format string, a ...interface{}) *Status {
 	s := NewStatus(GetStdout())
-	s.Start(fmt.Sprintf(format, a...), IsDebug())
+	s.Start(sprintf(format, a...), IsDebug())
 	return s
 }
 
@@ -552,7 +552,7 @@ func Spinnerf(format string, a ...interface{}) *Status {
 // for situations where spinning isn't viable (debug)
 func Fspinnerf(w io.Writer, format string, a ...interface{}) *Status {
 	s := NewStatus(w)
-	s.Start(fmt.Sprintf(format, a...), IsDebug())
+	s.Start(sprintf(format, a...), IsDebug())
 	return s
 }
 
@@ -572,13 +572,15 @@ func ExplicitSpinner(status string, preventSpinning bool) *Status {
 	return s
 }
 
-// IsJSON returns true if we are in machine output mode..
-// under NO circumstances should we output any logging.. as we are only outputting json
+// IsJSON returns true if we are in machine output mode (json, yaml, jsonpath or go-template)..
+// under NO circumstances should we output any logging.. as we are only outputting machine readable data
 func IsJSON() bool {
 
 	flag := pflag.Lookup("o")
 	if flag != nil && flag.Changed {
-		return strings.Contains(pflag.Lookup("o").Value.String(), "json")
+		// all the formats are machine readable, except the human readable "wide" format
+		value := flag.Value.String()
+		return value != "" && value != "wide"
 	}
 
 	return false
@@ -0,0 +1,128 @@
+package machineoutput
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+
+	"github\.com/danielpickens/astra/pkg/log"
+)
+
+// Names of the output formats accepted by the -o flag
+const (
+	FormatJSON       = "json"
+	FormatYAML       = "yaml"
+	FormatJSONPath   = "jsonpath"
+	FormatGoTemplate = "go-template"
+	// FormatWide is a human readable format, displaying more information than the default one
+	FormatWide = "wide"
+)
+
+// SupportedFormats is the description of the formats accepted by the -o flag, to be displayed to the user
+const SupportedFormats = "json, yaml, jsonpath=<template>, go-template=<template>, wide"
+
+// OutputFormat is an output format requested with the -o flag
+type OutputFormat struct {
+	// Name is the name of the format, one of the Format* constants
+	Name string
+	// Template is the template to use for the jsonpath and go-template formats
+	Template string
+}
+
+// ParseOutputFormat parses the value of the -o flag.
+// The jsonpath and go-template formats expect a template, passed as jsonpath=<template> or go-template=<template>
+func ParseOutputFormat(value string) (OutputFormat, error) {
+	name, tmpl, hasTemplate := strings.Cut(value, "=")
+	switch name {
+	case FormatJSON, FormatYAML, FormatWide:
+		if hasTemplate {
+			return OutputFormat{}, fmt.Errorf("the %s output format does not accept a template", name)
+		}
+	case FormatJSONPath, FormatGoTemplate:
+		if tmpl == "" {
+			return OutputFormat{}, fmt.Errorf("the %s output format requires a template, as %s=<template>", name, name)
+		}
+	default:
+		return OutputFormat{}, fmt.Errorf("unknown output format %q", value)
+	}
+	return OutputFormat{Name: name, Template: tmpl}, nil
+}
+
+// IsMachineReadable returns true if the format is intended to be parsed by a program, false for human readable formats
+func (o OutputFormat) IsMachineReadable() bool {
+	return o.Name != "" && o.Name != FormatWide
+}
+
+// Render writes machineOutput to w in the format o.
+// The jsonpath and go-template templates are applied on the JSON representation of machineOutput,
+// so the fields are referenced by their names in the JSON output
+func (o OutputFormat) Render(w io.Writer, machineOutput interface{}) error {
+	switch o.Name {
+	case FormatJSON:
+		printableOutput, err := marshalJSONIndented(machineOutput)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", log.Redact(string(printableOutput)))
+		return err
+
+	case FormatYAML:
+		printableOutput, err := yaml.Marshal(machineOutput)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, log.Redact(string(printableOutput)))
+		return err
+
+	case FormatJSONPath:
+		data, err := toJSONData(machineOutput)
+		if err != nil {
+			return err
+		}
+		jp := jsonpath.New("output")
+		if err = jp.Parse(o.Template); err != nil {
+			return fmt.Errorf("error parsing jsonpath %q: %w", o.Template, err)
+		}
+		var buf bytes.Buffer
+		if err = jp.Execute(&buf, data); err != nil {
+			return fmt.Errorf("error executing jsonpath %q: %w", o.Template, err)
+		}
+		_, err = fmt.Fprintln(w, log.Redact(buf.String()))
+		return err
+
+	case FormatGoTemplate:
+		data, err := toJSONData(machineOutput)
+		if err != nil {
+			return err
+		}
+		tmpl, err := template.New("output").Option("missingkey=error").Parse(o.Template)
+		if err != nil {
+			return fmt.Errorf("error parsing template %q: %w", o.Template, err)
+		}
+		var buf bytes.Buffer
+		if err = tmpl.Execute(&buf, data); err != nil {
+			return fmt.Errorf("error executing template %q: %w", o.Template, err)
+		}
+		_, err = fmt.Fprintln(w, log.Redact(buf.String()))
+		return err
+	}
+	return errors.New("the output format is not a machine readable format")
+}
+
+// toJSONData returns the generic representation of the JSON encoding of obj
+func toJSONData(obj interface{}) (interface{}, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	err = json.Unmarshal(b, &data)
+	return data, err
+}
@@ -0,0 +1,130 @@
+package machineoutput
+
+import (
+	"bytes"
+	"testing"
+)
+
+type testItem struct {
+	Name    string   `json:"name"`
+	Ports   []int    `json:"ports,omitempty"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    OutputFormat
+		wantErr bool
+	}{
+		{value: "json", want: OutputFormat{Name: FormatJSON}},
+		{value: "yaml", want: OutputFormat{Name: FormatYAML}},
+		{value: "wide", want: OutputFormat{Name: FormatWide}},
+		{value: "jsonpath={.name}", want: OutputFormat{Name: FormatJSONPath, Template: "{.name}"}},
+		{value: "go-template={{.name}}={{.ports}}", want: OutputFormat{Name: FormatGoTemplate, Template: "{{.name}}={{.ports}}"}},
+		{value: "jsonpath", wantErr: true},
+		{value: "go-template=", wantErr: true},
+		{value: "json={.name}", wantErr: true},
+		{value: "xml", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseOutputFormat(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseOutputFormat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseOutputFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutputFormat_Render(t *testing.T) {
+	items := []testItem{
+		{Name: "frontend", Ports: []int{3000, 8080}},
+		{Name: "backend", Aliases: []string{"api"}},
+	}
+	tests := []struct {
+		name    string
+		format  OutputFormat
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "json",
+			format: OutputFormat{Name: FormatJSON},
+			want: `[
+	{
+		"name": "frontend",
+		"ports": [
+			3000,
+			8080
+		]
+	},
+	{
+		"name": "backend",
+		"aliases": [
+			"api"
+		]
+	}
+]
+`,
+		},
+		{
+			name:   "yaml",
+			format: OutputFormat{Name: FormatYAML},
+			want: `- name: frontend
+  ports:
+  - 3000
+  - 8080
+- aliases:
+  - api
+  name: backend
+`,
+		},
+		{
+			name:   "jsonpath",
+			format: OutputFormat{Name: FormatJSONPath, Template: "{range [*]}{.name}{\"\\n\"}{end}"},
+			want:   "frontend\nbackend\n\n",
+		},
+		{
+			name:   "go-template",
+			format: OutputFormat{Name: FormatGoTemplate, Template: "{{range .}}{{.name}} {{end}}"},
+			want:   "frontend backend \n",
+		},
+		{
+			name:    "invalid jsonpath",
+			format:  OutputFormat{Name: FormatJSONPath, Template: "{.name"},
+			wantErr: true,
+		},
+		{
+			name:    "go-template with missing key",
+			format:  OutputFormat{Name: FormatGoTemplate, Template: "{{range .}}{{.unknown}}{{end}}"},
+			wantErr: true,
+		},
+		{
+			name:    "wide is not machine readable",
+			format:  OutputFormat{Name: FormatWide},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := tt.format.Render(&buf, items)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Render() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
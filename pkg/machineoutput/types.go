@@ -31,27 +31,16 @@ func OutputSuccessUnindented(machineOutput interface{}) {
 	if err != nil {
 		fmt.Fprintf(log.GetStderr(), "Unable to unmarshal JSON: %s\n", err.Error())
 	} else {
-		fmt.Fprintf(log.GetStdout(), "%s\n", string(printableOutput))
+		fmt.Fprintf(log.GetStdout(), "%s\n", log.Redact(string(printableOutput)))
 	}
 }
 
-// OutputSuccess outputs a "successful" machine-readable output format in json
-func OutputSuccess(stdout, stderr io.Writer, machineOutput interface{}) {
-	printableOutput, err := marshalJSONIndented(machineOutput)
-
+// OutputSuccessWithFormat outputs a "successful" machine-readable output in the given format
+func OutputSuccessWithFormat(stdout io.Writer, format OutputFormat, machineOutput interface{}) error {
 	if stdout == nil {
 		stdout = log.GetStdout()
 	}
-	if stderr == nil {
-		stderr = log.GetStderr()
-	}
-
-	// If we error out... there's no way to output it (since we disable logging when using -o json)
-	if err != nil {
-		fmt.Fprintf(stderr, "Unable to unmarshal JSON: %s\n", err.Error())
-	} else {
-		fmt.Fprintf(stdout, "%s\n", string(printableOutput))
-	}
+	return format.Render(stdout, machineOutput)
 }
 
 // OutputError outputs a "successful" machine-readable output format in json
@@ -62,7 +51,7 @@ func OutputError(machineOutput interface{}) {
 	if err != nil {
 		fmt.Fprintf(log.GetStderr(), "Unable to unmarshal JSON: %s\n", err.Error())
 	} else {
-		fmt.Fprintf(log.GetStderr(), "%s\n", string(printableOutput))
+		fmt.Fprintf(log.GetStderr(), "%s\n", log.Redact(string(printableOutput)))
 	}
 }
 
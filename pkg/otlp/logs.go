@@ -0,0 +1,33 @@
+package otlp
+
+// Severity numbers of the log records
+const (
+	SeverityInfo  = 9
+	SeverityError = 17
+)
+
+// Logs is the body of a request to the logs endpoint
+type Logs struct {
+	ResourceLogs []ResourceLogs `json:"resourceLogs"`
+}
+
+// ResourceLogs are the logs produced by a resource
+type ResourceLogs struct {
+	Resource  Resource    `json:"resource"`
+	ScopeLogs []ScopeLogs `json:"scopeLogs"`
+}
+
+// ScopeLogs are the logs produced by an instrumentation scope
+type ScopeLogs struct {
+	Scope      Scope       `json:"scope"`
+	LogRecords []LogRecord `json:"logRecords"`
+}
+
+// LogRecord is a log record
+type LogRecord struct {
+	TimeUnixNano   string     `json:"timeUnixNano"`
+	SeverityNumber int        `json:"severityNumber"`
+	SeverityText   string     `json:"severityText"`
+	Body           AnyValue   `json:"body"`
+	Attributes     []KeyValue `json:"attributes"`
+}
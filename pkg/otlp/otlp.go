@@ -0,0 +1,156 @@
+// Package otlp sends data to an OpenTelemetry collector, using OTLP over HTTP with the JSON encoding.
+// It defines the subset of the OTLP JSON encoding used by astra,
+// see https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Paths of the OTLP/HTTP endpoints, relative to the collector endpoint
+const (
+	LogsPath   = "/v1/logs"
+	TracesPath = "/v1/traces"
+)
+
+// ServiceName is the name of the service sending the data
+const ServiceName = "astra"
+
+// Client sends data to an OpenTelemetry collector
+type Client struct {
+	endpoint   string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// NewClient returns a client sending the data to the collector at endpoint (for example http://collector:4318).
+// headers are additional HTTP headers in the form key=value, for example to authenticate to the collector.
+func NewClient(endpoint string, headers []string) (*Client, error) {
+	result := &Client{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		headers:  make(map[string]string, len(headers)),
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+	for _, header := range headers {
+		key, value, found := strings.Cut(header, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid OTLP header %q, must be in the form key=value", header)
+		}
+		result.headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return result, nil
+}
+
+// Send sends the data, encoded in JSON, to the path of the collector
+func (o *Client) Send(ctx context.Context, path string, data interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range o.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("the OTLP collector returned %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// Resource describes the entity producing the data
+type Resource struct {
+	Attributes []KeyValue `json:"attributes"`
+}
+
+// NewResource returns the resource describing this version of astra
+func NewResource(version string) Resource {
+	return Resource{
+		Attributes: []KeyValue{
+			String("service.name", ServiceName),
+			String("service.version", version),
+			String("os.type", runtime.GOOS),
+		},
+	}
+}
+
+// Scope describes the instrumentation producing the data
+type Scope struct {
+	Name string `json:"name"`
+}
+
+// KeyValue is an attribute
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue is the value of an attribute. A single field must be set.
+type AnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+// String returns a string attribute
+func String(key, value string) KeyValue {
+	return KeyValue{Key: key, Value: AnyValue{StringValue: &value}}
+}
+
+// Bool returns a boolean attribute
+func Bool(key string, value bool) KeyValue {
+	return KeyValue{Key: key, Value: AnyValue{BoolValue: &value}}
+}
+
+// Int returns an integer attribute
+func Int(key string, value int64) KeyValue {
+	s := strconv.FormatInt(value, 10)
+	return KeyValue{Key: key, Value: AnyValue{IntValue: &s}}
+}
+
+// Any returns an attribute for a value of any type, as decoded from JSON for example
+func Any(key string, value interface{}) KeyValue {
+	switch v := value.(type) {
+	case string:
+		return String(key, v)
+	case bool:
+		return Bool(key, v)
+	case float64:
+		if v == float64(int64(v)) {
+			return Int(key, int64(v))
+		}
+		return KeyValue{Key: key, Value: AnyValue{DoubleValue: &v}}
+	case int:
+		return Int(key, int64(v))
+	case int64:
+		return Int(key, v)
+	default:
+		return String(key, fmt.Sprint(v))
+	}
+}
+
+// UnixNano returns the timestamp in the format of the OTLP JSON encoding
+func UnixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os/exec"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog"
 
 	"github\.com/danielpickens/astra/pkg/api"
@@ -18,6 +20,7 @@ import (
 type ListPodsReport struct {
 	Name       string
 	Labels     map[string]string
+	Created    string
 	Containers []ListPodsContainer `json:"Containers,omitempty"`
 }
 
@@ -25,8 +28,24 @@ type ListPodsContainer struct {
 	Names string `json:"Names,omitempty"`
 }
 
-func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
-	cmd := exec.Command(o.podmanCmd, append(o.containerRunGlobalExtraArgs, "pod", "ps", "--format", "json", "--filter", "status=running")...)
+func (o *PodmanCli) ListAllComponents(selector string) ([]api.ComponentAbstract, error) {
+	return o.listComponents(selector, "--filter", "status=running")
+}
+
+func (o *PodmanCli) ListAllComponentsIncludingStopped(selector string) ([]api.ComponentAbstract, error) {
+	return o.listComponents(selector)
+}
+
+// listComponents returns the components whose pods match the label selector if not empty,
+// and are returned by `podman pod ps` with the given filters
+func (o *PodmanCli) listComponents(selector string, filters ...string) ([]api.ComponentAbstract, error) {
+	labelSelector, err := k8slabels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append([]string{"pod", "ps", "--format", "json"}, filters...)
+	cmd := exec.Command(o.podmanCmd, append(o.containerRunGlobalExtraArgs, args...)...)
 	klog.V(3).Infof("executing %v", cmd.Args)
 	out, err := cmd.Output()
 	if err != nil {
@@ -54,6 +73,9 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 	for _, pod := range list {
 
 		labels := pod.Labels
+		if !labelSelector.Matches(k8slabels.Set(labels)) {
+			continue
+		}
 
 		// Figure out the correct name to use
 		// if there is no instance label (app.kubernetes.io/instance),
@@ -79,8 +101,14 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 			Type:             componentType,
 			ManagedByVersion: managedByVersion,
 			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
-			RunningOn: commonflags.PlatformPodman,
-			Platform:  commonflags.PlatformPodman,
+			RunningOn:   commonflags.PlatformPodman,
+			Platform:    commonflags.PlatformPodman,
+			DevfileName: astralabels.GetDevfileName(labels, nil),
+		}
+		// The pods are not modified once created
+		if created, err := time.Parse(time.RFC3339Nano, pod.Created); err == nil {
+			component.Created = &created
+			component.LastModified = &created
 		}
 		mode := astralabels.GetMode(labels)
 		if mode != "" {
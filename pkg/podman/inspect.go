@@ -21,6 +21,19 @@ type PodInspectData struct {
 	// Labels is a set of key-value labels that have been applied to the
 	// pod.
 	Labels map[string]string `json:"Labels,omitempty"`
+	// Containers gives a brief summary of all containers in the pod and
+	// their current status.
+	Containers []InspectPodContainerInfo `json:"Containers,omitempty"`
+}
+
+// InspectPodContainerInfo contains information on a container in a pod.
+type InspectPodContainerInfo struct {
+	// ID is the ID of the container.
+	ID string `json:"Id"`
+	// Name is the name of the container.
+	Name string
+	// State is the current status of the container.
+	State string `json:"State"`
 }
 
 func (o *PodmanCli) PodInspect(podname string) (PodInspectData, error) {
@@ -27,6 +27,9 @@ type Client interface {
 	// PodLs lists the names of existing pods
 	PodLs() (map[string]bool, error)
 
+	// PodInspect returns the state of the pod with given podname, and of its containers
+	PodInspect(podname string) (PodInspectData, error)
+
 	// VolumeLs lists the names of existing volumes
 	VolumeLs() (map[string]bool, error)
 
@@ -36,7 +39,11 @@ type Client interface {
 	// CleanupPodResources stops and removes a pod and its associated resources (volumes)
 	CleanupPodResources(pod *corev1.Pod, cleanVolumes bool) error
 
-	ListAllComponents() ([]api.ComponentAbstract, error)
+	// ListAllComponents returns the components running on Podman, whose pods match the label selector if not empty
+	ListAllComponents(selector string) ([]api.ComponentAbstract, error)
+
+	// ListAllComponentsIncludingStopped returns the components running or stopped on Podman, whose pods match the label selector if not empty
+	ListAllComponentsIncludingStopped(selector string) ([]api.ComponentAbstract, error)
 
 	Version(ctx context.Context) (SystemVersionReport, error)
 
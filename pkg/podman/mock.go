@@ -188,18 +188,33 @@ func (mr *MockClientMockRecorder) KubeGenerate(name interface{}) *gomock.Call {
 }
 
 // ListAllComponents mocks base method.
-func (m *MockClient) ListAllComponents() ([]api.ComponentAbstract, error) {
+func (m *MockClient) ListAllComponents(selector string) ([]api.ComponentAbstract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAllComponents")
+	ret := m.ctrl.Call(m, "ListAllComponents", selector)
 	ret0, _ := ret[0].([]api.ComponentAbstract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAllComponents indicates an expected call of ListAllComponents.
-func (mr *MockClientMockRecorder) ListAllComponents() *gomock.Call {
+func (mr *MockClientMockRecorder) ListAllComponents(selector interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllComponents", reflect.TypeOf((*MockClient)(nil).ListAllComponents))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllComponents", reflect.TypeOf((*MockClient)(nil).ListAllComponents), selector)
+}
+
+// ListAllComponentsIncludingStopped mocks base method.
+func (m *MockClient) ListAllComponentsIncludingStopped(selector string) ([]api.ComponentAbstract, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllComponentsIncludingStopped", selector)
+	ret0, _ := ret[0].([]api.ComponentAbstract)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllComponentsIncludingStopped indicates an expected call of ListAllComponentsIncludingStopped.
+func (mr *MockClientMockRecorder) ListAllComponentsIncludingStopped(selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllComponentsIncludingStopped", reflect.TypeOf((*MockClient)(nil).ListAllComponentsIncludingStopped), selector)
 }
 
 // PlayKube mocks base method.
@@ -216,6 +231,21 @@ func (mr *MockClientMockRecorder) PlayKube(pod interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayKube", reflect.TypeOf((*MockClient)(nil).PlayKube), pod)
 }
 
+// PodInspect mocks base method.
+func (m *MockClient) PodInspect(podname string) (PodInspectData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodInspect", podname)
+	ret0, _ := ret[0].(PodInspectData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PodInspect indicates an expected call of PodInspect.
+func (mr *MockClientMockRecorder) PodInspect(podname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodInspect", reflect.TypeOf((*MockClient)(nil).PodInspect), podname)
+}
+
 // PodLs mocks base method.
 func (m *MockClient) PodLs() (map[string]bool, error) {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog"
 
@@ -118,6 +119,17 @@ func (o *PodmanCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, err
 			Name: strings.TrimPrefix(container.Names, podReport.Name+"-"),
 		})
 	}
+	// The IDs of the pod and of its containers change when they are recreated
+	pod.SetUID(types.UID(inspect.ID))
+	for _, container := range inspect.Containers {
+		if !strings.HasPrefix(container.Name, podReport.Name+"-") {
+			continue
+		}
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:        strings.TrimPrefix(container.Name, podReport.Name+"-"),
+			ContainerID: container.ID,
+		})
+	}
 	return &pod, nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"k8s.io/klog"
 
 	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/events"
 	"github\.com/danielpickens/astra/pkg/kclient"
 	"github\.com/danielpickens/astra/pkg/libdevfile"
 	"github\.com/danielpickens/astra/pkg/log"
@@ -29,6 +30,7 @@ var _ portForward.Client = (*PFClient)(nil)
 type PFClient struct {
 	kubernetesClient kclient.ClientInterface
 	stateClient      state.Client
+	eventsClient     *events.EventsClient
 
 	appliedEndpoints map[string][]v1alpha2.Endpoint
 
@@ -43,10 +45,11 @@ type PFClient struct {
 	isRunning bool
 }
 
-func NewPFClient(kubernetesClient kclient.ClientInterface, stateClient state.Client) *PFClient {
+func NewPFClient(kubernetesClient kclient.ClientInterface, stateClient state.Client, eventsClient *events.EventsClient) *PFClient {
 	return &PFClient{
 		kubernetesClient: kubernetesClient,
 		stateClient:      stateClient,
+		eventsClient:     eventsClient,
 	}
 }
 
@@ -115,7 +118,9 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 
 			go func() {
 				portsBuf.Wait()
-				err = o.stateClient.SetForwardedPorts(ctx, portsBuf.GetForwardedPorts())
+				fwPorts := portsBuf.GetForwardedPorts()
+				o.eventsClient.Publish(events.PortForwardsChanged, events.PortForwardsData{Ports: fwPorts})
+				err = o.stateClient.SetForwardedPorts(ctx, fwPorts)
 				if err != nil {
 					err = fmt.Errorf("unable to save forwarded ports to state file: %v", err)
 				}
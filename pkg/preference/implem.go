@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/config"
 	envcontext "github\.com/danielpickens/astra/pkg/config/context"
 	"github\.com/danielpickens/astra/pkg/log"
 	"github\.com/danielpickens/astra/pkg/astra/cli/ui"
@@ -72,6 +73,12 @@ type Preference struct {
 type preferenceInfo struct {
 	Filename   string `yaml:"FileName,omitempty"`
 	Preference `yaml:",omitempty"`
+
+	// Project holds the configuration of the project, overriding some preferences
+	Project config.ProjectConfiguration `yaml:"-"`
+
+	// ConsentTelemetryEnv holds the value of the ConsentTelemetry preference overridden by environment variables, if any
+	ConsentTelemetryEnv *bool `yaml:"-"`
 }
 
 var _ Client = (*preferenceInfo)(nil)
@@ -93,6 +100,24 @@ func getPreferenceFile(ctx context.Context) (string, error) {
 	return filepath.Join(currentUser.HomeDir, ".astra", configFileName), nil
 }
 
+// getConsentTelemetryFromEnv returns the value of the ConsentTelemetry preference overridden by environment variables, if any,
+// with the same precedence as the one used to determine if telemetry is enabled
+func getConsentTelemetryFromEnv(envConfig config.Configuration) *bool {
+	//lint:ignore SA1019 We deprecated this env var, but until it is removed, we still need to support it
+	if kpointer.BoolDeref(envConfig.astraDisableTelemetry, false) {
+		return kpointer.Bool(false)
+	}
+	if envConfig.astraTrackingConsent != nil {
+		switch *envConfig.astraTrackingConsent {
+		case "yes":
+			return kpointer.Bool(true)
+		case "no":
+			return kpointer.Bool(false)
+		}
+	}
+	return nil
+}
+
 func NewClient(ctx context.Context) (Client, error) {
 	return newPreferenceInfo(ctx)
 }
@@ -119,6 +144,9 @@ func newPreferenceInfo(ctx context.Context) (*preferenceInfo, error) {
 	c := preferenceInfo{
 		Preference: newPreference(),
 		Filename:   preferenceFile,
+		Project:    envcontext.GetProjectConfig(ctx),
+
+		ConsentTelemetryEnv: getConsentTelemetryFromEnv(envcontext.GetEnvConfig(ctx)),
 	}
 
 	// Default devfile registry
@@ -374,8 +402,11 @@ func (c *preferenceInfo) GetTimeout() time.Duration {
 	return kpointer.DurationDeref(c.astraSettings.Timeout, DefaultTimeout)
 }
 
-// GetPushTimeout gets the value set by PushTimeout
+// GetPushTimeout gets the value set by PushTimeout in the project configuration or in the preferences
 func (c *preferenceInfo) GetPushTimeout() time.Duration {
+	if c.Project.PushTimeout != nil {
+		return c.Project.PushTimeout.Duration
+	}
 	// default timeout value is 240s
 	return kpointer.DurationDeref(c.astraSettings.PushTimeout, DefaultPushTimeout)
 }
@@ -385,9 +416,12 @@ func (c *preferenceInfo) GetRegistryCacheTime() time.Duration {
 	return kpointer.DurationDeref(c.astraSettings.RegistryCacheTime, DefaultRegistryCacheTime)
 }
 
-// GetImageRegistry returns the value of ImageRegistry from the preferences
+// GetImageRegistry returns the value of ImageRegistry from the project configuration or from the preferences
 // and, if absent, then returns default empty string.
 func (c *preferenceInfo) GetImageRegistry() string {
+	if c.Project.ImageRegistry != nil {
+		return *c.Project.ImageRegistry
+	}
 	return kpointer.StringDeref(c.astraSettings.ImageRegistry, "")
 }
 
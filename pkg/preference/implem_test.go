@@ -14,6 +14,7 @@ import (
 	envcontext "github\.com/danielpickens/astra/pkg/config/context"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kpointer "k8s.io/utils/pointer"
 )
 
 func TestNew(t *testing.T) {
@@ -85,6 +86,7 @@ func TestGetPushTimeout(t *testing.T) {
 	tests := []struct {
 		name           string
 		existingConfig Preference
+		projectConfig  config.ProjectConfiguration
 		want           time.Duration
 	}{
 		{
@@ -101,11 +103,24 @@ func TestGetPushTimeout(t *testing.T) {
 			},
 			want: 5,
 		},
+		{
+			name: "Validating value 10 from project configuration overriding configuration",
+			existingConfig: Preference{
+				astraSettings: astraSettings{
+					PushTimeout: &nonzeroValue,
+				},
+			},
+			projectConfig: config.ProjectConfiguration{
+				PushTimeout: &metav1.Duration{Duration: 10 * time.Second},
+			},
+			want: 10,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
 			ctx = envcontext.WithEnvConfig(ctx, config.Configuration{})
+			ctx = envcontext.WithProjectConfig(ctx, tt.projectConfig)
 			cfg, err := newPreferenceInfo(ctx)
 			if err != nil {
 				t.Error(err)
@@ -120,6 +135,56 @@ func TestGetPushTimeout(t *testing.T) {
 	}
 }
 
+func TestNewPreferenceList_Origin(t *testing.T) {
+	userTimeout := 5 * time.Second
+	userRegistry := "quay.io/user"
+	trackingConsent := "no"
+	ctx := context.Background()
+	ctx = envcontext.WithEnvConfig(ctx, config.Configuration{
+		astraTrackingConsent: &trackingConsent,
+	})
+	ctx = envcontext.WithProjectConfig(ctx, config.ProjectConfiguration{
+		ImageRegistry: kpointer.String("quay.io/team"),
+	})
+	cfg, err := newPreferenceInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Preference = Preference{
+		astraSettings: astraSettings{
+			Timeout:          &userTimeout,
+			ImageRegistry:    &userRegistry,
+			ConsentTelemetry: kpointer.Bool(true),
+		},
+	}
+
+	want := map[string]string{
+		UpdateNotificationSetting: OriginDefault,
+		TimeoutSetting:            OriginUser,
+		PushTimeoutSetting:        OriginDefault,
+		RegistryCacheTimeSetting:  OriginDefault,
+		ConsentTelemetrySetting:   OriginEnv,
+		EphemeralSetting:          OriginDefault,
+		ImageRegistrySetting:      OriginProject,
+	}
+	got := map[string]string{}
+	for _, item := range cfg.NewPreferenceList().Items {
+		got[item.Name] = item.Origin
+		if item.Name == ImageRegistrySetting && *item.Value.(*string) != "quay.io/team" {
+			t.Errorf("expected the value of %s to be read from the project configuration, got %q", item.Name, *item.Value.(*string))
+		}
+		if item.Name == ConsentTelemetrySetting && *item.Value.(*bool) {
+			t.Errorf("expected the value of %s to be read from the environment, got %v", item.Name, *item.Value.(*bool))
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewPreferenceList() origins mismatch (-want +got):\n%s", diff)
+	}
+	if cfg.GetImageRegistry() != "quay.io/team" {
+		t.Errorf("GetImageRegistry() = %q, expected the value from the project configuration", cfg.GetImageRegistry())
+	}
+}
+
 func TestGetTimeout(t *testing.T) {
 	zeroValue := 0 * time.Second
 	nonzeroValue := 5 * time.Second
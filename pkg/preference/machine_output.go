@@ -14,6 +14,26 @@ func (o *preferenceInfo) NewPreferenceList() api.PreferenceList {
 
 func toPreferenceItems(prefInfo preferenceInfo) []api.PreferenceItem {
 	settings := prefInfo.astraSettings
+	var (
+		pushTimeout            = settings.PushTimeout
+		pushTimeoutOrigin      = getOrigin(settings.PushTimeout != nil)
+		imageRegistry          = settings.ImageRegistry
+		imageRegistryOrigin    = getOrigin(settings.ImageRegistry != nil)
+		consentTelemetry       = settings.ConsentTelemetry
+		consentTelemetryOrigin = getOrigin(settings.ConsentTelemetry != nil)
+	)
+	if prefInfo.ConsentTelemetryEnv != nil {
+		consentTelemetry = prefInfo.ConsentTelemetryEnv
+		consentTelemetryOrigin = OriginEnv
+	}
+	if prefInfo.Project.PushTimeout != nil {
+		pushTimeout = &prefInfo.Project.PushTimeout.Duration
+		pushTimeoutOrigin = OriginProject
+	}
+	if prefInfo.Project.ImageRegistry != nil {
+		imageRegistry = prefInfo.Project.ImageRegistry
+		imageRegistryOrigin = OriginProject
+	}
 	return []api.PreferenceItem{
 		{
 			Name:        UpdateNotificationSetting,
@@ -21,6 +41,7 @@ func toPreferenceItems(prefInfo preferenceInfo) []api.PreferenceItem {
 			Default:     true,
 			Type:        getType(prefInfo.GetUpdateNotification()), // use the Getter here to determine type
 			Description: UpdateNotificationSettingDescription,
+			Origin:      getOrigin(settings.UpdateNotification != nil),
 		},
 		{
 			Name:        TimeoutSetting,
@@ -28,13 +49,15 @@ func toPreferenceItems(prefInfo preferenceInfo) []api.PreferenceItem {
 			Default:     DefaultTimeout,
 			Type:        getType(prefInfo.GetTimeout()),
 			Description: TimeoutSettingDescription,
+			Origin:      getOrigin(settings.Timeout != nil),
 		},
 		{
 			Name:        PushTimeoutSetting,
-			Value:       settings.PushTimeout,
+			Value:       pushTimeout,
 			Default:     DefaultPushTimeout,
 			Type:        getType(prefInfo.GetPushTimeout()),
 			Description: PushTimeoutSettingDescription,
+			Origin:      pushTimeoutOrigin,
 		},
 		{
 			Name:        RegistryCacheTimeSetting,
@@ -42,13 +65,15 @@ func toPreferenceItems(prefInfo preferenceInfo) []api.PreferenceItem {
 			Default:     DefaultRegistryCacheTime,
 			Type:        getType(prefInfo.GetRegistryCacheTime()),
 			Description: RegistryCacheTimeSettingDescription,
+			Origin:      getOrigin(settings.RegistryCacheTime != nil),
 		},
 		{
 			Name:        ConsentTelemetrySetting,
-			Value:       settings.ConsentTelemetry,
+			Value:       consentTelemetry,
 			Default:     DefaultConsentTelemetrySetting,
 			Type:        getType(prefInfo.GetConsentTelemetry()),
 			Description: ConsentTelemetrySettingDescription,
+			Origin:      consentTelemetryOrigin,
 		},
 		{
 			Name:        EphemeralSetting,
@@ -56,17 +81,27 @@ func toPreferenceItems(prefInfo preferenceInfo) []api.PreferenceItem {
 			Default:     DefaultEphemeralSetting,
 			Type:        getType(prefInfo.GetEphemeral()),
 			Description: EphemeralSettingDescription,
+			Origin:      getOrigin(settings.Ephemeral != nil),
 		},
 		{
 			Name:        ImageRegistrySetting,
-			Value:       settings.ImageRegistry,
+			Value:       imageRegistry,
 			Default:     "",
 			Type:        getType(prefInfo.GetImageRegistry()),
 			Description: ImageRegistrySettingDescription,
+			Origin:      imageRegistryOrigin,
 		},
 	}
 }
 
+// getOrigin returns where the value of a preference not overridden by the project configuration or the environment comes from
+func getOrigin(isSet bool) string {
+	if isSet {
+		return OriginUser
+	}
+	return OriginDefault
+}
+
 func getType(v interface{}) string {
 
 	rv := reflect.ValueOf(v)
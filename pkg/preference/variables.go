@@ -64,6 +64,18 @@ const (
 
 	// DefaultConsentTelemetry is a default value for ConsentTelemetry preference
 	DefaultConsentTelemetrySetting = false
+
+	// OriginDefault indicates that a preference has its default value
+	OriginDefault = "default"
+
+	// OriginUser indicates that a preference is set in the preference file of the user
+	OriginUser = "user"
+
+	// OriginProject indicates that a preference is set in the configuration file of the project
+	OriginProject = "project"
+
+	// OriginEnv indicates that a preference is overridden by an environment variable
+	OriginEnv = "env"
 )
 
 // TimeoutSettingDescription is human-readable description for the timeout setting
@@ -0,0 +1,102 @@
+package preflight
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/kclient"
+)
+
+const namePermissions = "Permissions"
+
+// checkAccesses checks with SelfSubjectAccessReviews that the user is allowed to use the verbs needed on the resources
+func checkAccesses(kubeClient kclient.ClientInterface, namespace string, plan Plan, report *Report) {
+	type key struct {
+		group, resource, subresource, verb string
+	}
+	var keys []key
+	reasons := map[key][]string{}
+	for _, access := range plan.Accesses {
+		for _, verb := range access.Verbs {
+			k := key{group: access.Group, resource: access.Resource, subresource: access.Subresource, verb: verb}
+			if _, found := reasons[k]; !found {
+				keys = append(keys, k)
+			}
+			if access.Reason != "" && !contains(reasons[k], access.Reason) {
+				reasons[k] = append(reasons[k], access.Reason)
+			}
+		}
+	}
+
+	// missing verbs, by resource
+	denied := map[string][]string{}
+	deniedReasons := map[string][]string{}
+	var deniedResources []string
+	for _, k := range keys {
+		status, err := kubeClient.GetSelfSubjectAccess(authorizationv1.ResourceAttributes{
+			Namespace:   namespace,
+			Verb:        k.verb,
+			Group:       k.group,
+			Resource:    k.resource,
+			Subresource: k.subresource,
+		})
+		resource := qualifiedResource(k.group, k.resource, k.subresource)
+		if err != nil {
+			klog.V(4).Infof("unable to check the permission to %s %s: %v", k.verb, resource, err)
+			report.addWarning(namePermissions, "", "unable to check the permission to %s %s: %v", k.verb, resource, err)
+			continue
+		}
+		if status.Allowed {
+			continue
+		}
+		if status.EvaluationError != "" {
+			klog.V(4).Infof("permission to %s %s not determined: %s", k.verb, resource, status.EvaluationError)
+		}
+		if _, found := denied[resource]; !found {
+			deniedResources = append(deniedResources, resource)
+		}
+		denied[resource] = append(denied[resource], k.verb)
+		for _, reason := range reasons[k] {
+			if !contains(deniedReasons[resource], reason) {
+				deniedReasons[resource] = append(deniedReasons[resource], reason)
+			}
+		}
+	}
+
+	sort.Strings(deniedResources)
+	for _, resource := range deniedResources {
+		verbs := strings.Join(denied[resource], ", ")
+		message := fmt.Sprintf("not allowed to %s %s in namespace %q", verbs, resource, namespace)
+		if len(deniedReasons[resource]) != 0 {
+			message += fmt.Sprintf(" (needed for %s)", strings.Join(deniedReasons[resource], ", "))
+		}
+		report.addError(namePermissions,
+			fmt.Sprintf("Ask your cluster administrator for a Role granting %s on %s in the namespace, or use another namespace.", verbs, resource),
+			"%s", message)
+	}
+}
+
+// qualifiedResource returns the resource as displayed by kubectl, for example deployments.apps or pods/exec
+func qualifiedResource(group, resource, subresource string) string {
+	result := resource
+	if group != "" {
+		result += "." + group
+	}
+	if subresource != "" {
+		result += "/" + subresource
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
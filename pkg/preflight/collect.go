@@ -0,0 +1,377 @@
+package preflight
+
+import (
+	"fmt"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+	psaApi "k8s.io/pod-security-admission/api"
+
+	"github\.com/danielpickens/astra/pkg/kclient"
+	"github\.com/danielpickens/astra/pkg/libdevfile"
+	"github\.com/danielpickens/astra/pkg/storage"
+)
+
+// sourceVolumeSize is the size of the volume created by astra dev for the project sources, when not ephemeral
+const sourceVolumeSize = "2Gi"
+
+var (
+	deploymentsResource = schema.GroupResource{Group: "apps", Resource: "deployments"}
+	jobsResource        = schema.GroupResource{Group: "batch", Resource: "jobs"}
+	podsResource        = schema.GroupResource{Resource: "pods"}
+	servicesResource    = schema.GroupResource{Resource: "services"}
+	pvcsResource        = schema.GroupResource{Resource: "persistentvolumeclaims"}
+	secretsResource     = schema.GroupResource{Resource: "secrets"}
+)
+
+// DevOptions are the options of astra dev needed to determine the resources to create
+type DevOptions struct {
+	// ComponentExists is true if the Dev Deployment of the component already exists
+	ComponentExists bool
+	// EphemeralSources is true if the project sources are stored in an emptyDir volume instead of a persistent volume
+	EphemeralSources bool
+	// BuildCommand, RunCommand and DebugCommand are the commands to execute, the default ones if empty
+	BuildCommand string
+	RunCommand   string
+	DebugCommand string
+	Debug        bool
+	// SkipCommands is true if the build, run and debug commands are not executed
+	SkipCommands bool
+}
+
+// NewDevPlan returns the resources astra dev is about to create or modify for the Devfile.
+// The pod and the volumes of the component are not created again if the component already exists.
+func NewDevPlan(kubeClient kclient.ClientInterface, devfileObj parser.DevfileObj, path string, options DevOptions) (Plan, error) {
+	var plan Plan
+	policy := getPolicy(kubeClient)
+	podTemplate, err := generator.GetPodTemplateSpec(devfileObj, generator.PodTemplateParams{
+		PodSecurityAdmissionPolicy: policy,
+	})
+	if err != nil {
+		return Plan{}, err
+	}
+
+	deploymentVerbs := []string{"get", "list", "watch", "create", "update"}
+	if kubeClient.IsSSASupported() {
+		deploymentVerbs = append(deploymentVerbs, "patch")
+	}
+	plan.AddAccess(deploymentsResource, "the Dev Deployment", deploymentVerbs...)
+	plan.AddAccess(podsResource, "the pod of the Dev Deployment", "get", "list", "watch")
+	plan.Accesses = append(plan.Accesses, Access{
+		Resource:    podsResource.Resource,
+		Subresource: "exec",
+		Verbs:       []string{"create"},
+		Reason:      "syncing the sources and running the commands",
+	})
+
+	var hasPorts bool
+	for _, container := range podTemplate.Spec.Containers {
+		hasPorts = hasPorts || len(container.Ports) != 0
+	}
+	if hasPorts {
+		plan.AddAccess(servicesResource, "the Service of the component", "get", "list", "create", "update")
+		plan.Accesses = append(plan.Accesses, Access{
+			Resource:    podsResource.Resource,
+			Subresource: "portforward",
+			Verbs:       []string{"create"},
+			Reason:      "forwarding the ports of the endpoints",
+		})
+	}
+
+	volumes, err := getPersistentVolumes(devfileObj)
+	if err != nil {
+		return Plan{}, err
+	}
+	if !options.EphemeralSources {
+		volumes = append(volumes, Volume{Name: "project sources", Size: resource.MustParse(sourceVolumeSize)})
+	}
+	if len(volumes) != 0 {
+		plan.AddAccess(pvcsResource, "the volumes of the component", "get", "list", "create", "update", "delete")
+	}
+
+	if !options.ComponentExists {
+		plan.Pods = append(plan.Pods, Pod{
+			Description: "the pod of the Dev Deployment",
+			Template:    *podTemplate,
+		})
+		plan.Volumes = volumes
+		plan.AddObjects(deploymentsResource, 1)
+		if hasPorts {
+			plan.AddObjects(servicesResource, 1)
+		}
+	}
+
+	k8sComponents, err := libdevfile.GetK8sAndOcComponentsToPush(devfileObj, false)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	// the commands executed in containers not running in the Dev pod are executed by Jobs
+	running := map[string]bool{}
+	for _, container := range podTemplate.Spec.Containers {
+		running[container.Name] = true
+	}
+	var roots []v1alpha2.Command
+	for _, command := range []struct {
+		name string
+		kind v1alpha2.CommandGroupKind
+		use  bool
+	}{
+		{name: options.BuildCommand, kind: v1alpha2.BuildCommandGroupKind, use: true},
+		{name: options.RunCommand, kind: v1alpha2.RunCommandGroupKind, use: !options.Debug},
+		{name: options.DebugCommand, kind: v1alpha2.DebugCommandGroupKind, use: options.Debug},
+	} {
+		if !command.use || options.SkipCommands {
+			continue
+		}
+		cmd, found, cmdErr := libdevfile.GetCommand(devfileObj, command.name, command.kind)
+		if cmdErr != nil || !found {
+			// the commands are validated later
+			continue
+		}
+		roots = append(roots, cmd)
+	}
+	postStart, err := getCommands(devfileObj, devfileObj.Data.GetEvents().PostStart)
+	if err != nil {
+		return Plan{}, err
+	}
+	roots = append(roots, postStart...)
+
+	execCommands, applyComponents, err := walkCommands(devfileObj, roots)
+	if err != nil {
+		return Plan{}, err
+	}
+	for _, command := range execCommands {
+		if !running[command.Exec.Component] {
+			if err = addJob(&plan, devfileObj, command, policy); err != nil {
+				return Plan{}, err
+			}
+		}
+	}
+
+	k8sComponents, err = appendComponents(devfileObj, k8sComponents, applyComponents)
+	if err != nil {
+		return Plan{}, err
+	}
+	err = addKubernetesComponents(&plan, kubeClient, devfileObj, path, k8sComponents, true)
+	if err != nil {
+		return Plan{}, err
+	}
+	return plan, nil
+}
+
+// NewDeployPlan returns the resources astra deploy is about to create or modify for the Devfile
+func NewDeployPlan(kubeClient kclient.ClientInterface, devfileObj parser.DevfileObj, path string) (Plan, error) {
+	var plan Plan
+
+	k8sComponents, err := libdevfile.GetK8sAndOcComponentsToPush(devfileObj, false)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	var roots []v1alpha2.Command
+	deployCommand, found, err := libdevfile.GetCommand(devfileObj, "", v1alpha2.DeployCommandGroupKind)
+	if err == nil && found {
+		roots = append(roots, deployCommand)
+	}
+	execCommands, applyComponents, err := walkCommands(devfileObj, roots)
+	if err != nil {
+		return Plan{}, err
+	}
+	policy := getPolicy(kubeClient)
+	for _, command := range execCommands {
+		if err = addJob(&plan, devfileObj, command, policy); err != nil {
+			return Plan{}, err
+		}
+	}
+
+	k8sComponents, err = appendComponents(devfileObj, k8sComponents, applyComponents)
+	if err != nil {
+		return Plan{}, err
+	}
+	err = addKubernetesComponents(&plan, kubeClient, devfileObj, path, k8sComponents, false)
+	if err != nil {
+		return Plan{}, err
+	}
+	return plan, nil
+}
+
+func getPolicy(kubeClient kclient.ClientInterface) psaApi.Policy {
+	policy, err := kubeClient.GetCurrentNamespacePolicy()
+	if err != nil {
+		klog.V(4).Infof("unable to get the Pod Security policy of the namespace: %v", err)
+	}
+	return policy
+}
+
+// getPersistentVolumes returns the non-ephemeral volumes of the Devfile
+func getPersistentVolumes(devfileObj parser.DevfileObj) ([]Volume, error) {
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.VolumeComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var volumes []Volume
+	for _, component := range components {
+		if component.Volume.Ephemeral != nil && *component.Volume.Ephemeral {
+			continue
+		}
+		size := component.Volume.Size
+		if size == "" {
+			size = storage.DefaultVolumeSize
+		}
+		quantity, err := resource.ParseQuantity(size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid size %q for volume %q: %w", size, component.Name, err)
+		}
+		volumes = append(volumes, Volume{Name: component.Name, Size: quantity})
+	}
+	return volumes, nil
+}
+
+// getCommands returns the commands of the Devfile with the given ids
+func getCommands(devfileObj parser.DevfileObj, ids []string) ([]v1alpha2.Command, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	commands, err := devfileObj.Data.GetCommands(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]v1alpha2.Command, len(commands))
+	for _, command := range commands {
+		byID[command.Id] = command
+	}
+	var result []v1alpha2.Command
+	for _, id := range ids {
+		if command, found := byID[id]; found {
+			result = append(result, command)
+		}
+	}
+	return result, nil
+}
+
+// walkCommands returns the exec commands and the names of the components applied by the commands, including the sub-commands of composite commands
+func walkCommands(devfileObj parser.DevfileObj, roots []v1alpha2.Command) (execCommands []v1alpha2.Command, applyComponents []string, err error) {
+	visited := map[string]bool{}
+	var walk func(commands []v1alpha2.Command) error
+	walk = func(commands []v1alpha2.Command) error {
+		for _, command := range commands {
+			if visited[command.Id] {
+				continue
+			}
+			visited[command.Id] = true
+			switch {
+			case command.Exec != nil:
+				execCommands = append(execCommands, command)
+			case command.Apply != nil:
+				applyComponents = append(applyComponents, command.Apply.Component)
+			case command.Composite != nil:
+				subCommands, err := getCommands(devfileObj, command.Composite.Commands)
+				if err != nil {
+					return err
+				}
+				if err = walk(subCommands); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	err = walk(roots)
+	return execCommands, applyComponents, err
+}
+
+// appendComponents appends the Kubernetes and OpenShift components with the given names to components, if not already present
+func appendComponents(devfileObj parser.DevfileObj, components []v1alpha2.Component, names []string) ([]v1alpha2.Component, error) {
+	if len(names) == 0 {
+		return components, nil
+	}
+	present := map[string]bool{}
+	for _, component := range components {
+		present[component.Name] = true
+	}
+	all, err := devfileObj.Data.GetComponents(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		for _, component := range all {
+			if component.Name == name && !present[name] && (component.Kubernetes != nil || component.Openshift != nil) {
+				components = append(components, component)
+				present[name] = true
+			}
+		}
+	}
+	return components, nil
+}
+
+// addJob adds the Job executing the command in a new container, as done by component.ExecuteInNewContainer
+func addJob(plan *Plan, devfileObj parser.DevfileObj, command v1alpha2.Command, policy psaApi.Policy) error {
+	podTemplate, err := generator.GetPodTemplateSpec(devfileObj, generator.PodTemplateParams{
+		Options: common.DevfileOptions{
+			FilterByName: command.Exec.Component,
+		},
+		PodSecurityAdmissionPolicy: policy,
+	})
+	if err != nil {
+		return err
+	}
+	podTemplate.Spec.RestartPolicy = corev1.RestartPolicyNever
+	plan.AddAccess(jobsResource, "the Jobs executing the commands", "get", "list", "create", "delete")
+	plan.AddAccess(podsResource, "the logs of the Jobs", "get", "list", "watch")
+	plan.AddObjects(jobsResource, 1)
+	plan.Pods = append(plan.Pods, Pod{
+		Description: fmt.Sprintf("the Job of command %q", command.Id),
+		Template:    *podTemplate,
+	})
+	return nil
+}
+
+// addKubernetesComponents adds the resources defined by the Kubernetes and OpenShift components.
+// The resources supported by the cluster and not existing yet are counted in the objects to create.
+func addKubernetesComponents(plan *Plan, kubeClient kclient.ClientInterface, devfileObj parser.DevfileObj, path string, components []v1alpha2.Component, dev bool) error {
+	verbs := []string{"get", "create", "patch"}
+	if dev {
+		// the resources removed from the Devfile are deleted
+		verbs = append(verbs, "list", "delete")
+	}
+	for _, component := range components {
+		objects, err := libdevfile.GetK8sComponentAsUnstructuredList(devfileObj, component.Name, path, devfilefs.DefaultFs{})
+		if err != nil {
+			return err
+		}
+		for _, u := range objects {
+			reason := fmt.Sprintf("the component %q", component.Name)
+			mapping, err := kubeClient.GetRestMappingFromUnstructured(u)
+			if err != nil {
+				if dev && u.GetKind() == "ServiceBinding" {
+					// without the Service Binding Operator, astra dev binds the services itself, with Secrets
+					if sboSupported, sboErr := kubeClient.IsServiceBindingSupported(); sboErr == nil && !sboSupported {
+						plan.AddAccess(secretsResource, reason, "get", "list", "create", "update", "delete")
+						plan.AddObjects(secretsResource, 1)
+						continue
+					}
+				}
+				// unsupported resources are reported when they are created
+				klog.V(4).Infof("unable to get the resource of %s %q: %v", u.GetKind(), u.GetName(), err)
+				continue
+			}
+			gr := mapping.Resource.GroupResource()
+			plan.AddAccess(gr, reason, verbs...)
+			if _, err = kubeClient.GetDynamicResource(mapping.Resource, u.GetName()); kerrors.IsNotFound(err) {
+				plan.AddObjects(gr, 1)
+			}
+		}
+	}
+	return nil
+}
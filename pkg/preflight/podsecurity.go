@@ -0,0 +1,50 @@
+package preflight
+
+import (
+	"k8s.io/klog"
+	psaApi "k8s.io/pod-security-admission/api"
+	psaPolicy "k8s.io/pod-security-admission/policy"
+
+	"github\.com/danielpickens/astra/pkg/kclient"
+)
+
+const namePodSecurity = "Pod Security"
+
+// checkPodSecurity checks that the pods are accepted by the Pod Security admission, at the enforced level of the namespace,
+// and warns about the violations of the warning level
+func checkPodSecurity(kubeClient kclient.ClientInterface, plan Plan, report *Report) {
+	if len(plan.Pods) == 0 {
+		return
+	}
+	policy, err := kubeClient.GetCurrentNamespacePolicy()
+	if err != nil {
+		klog.V(4).Infof("unable to get the Pod Security policy of the namespace: %v", err)
+		return
+	}
+	evaluator, err := psaPolicy.NewEvaluator(psaPolicy.DefaultChecks())
+	if err != nil {
+		klog.V(4).Infof("unable to create the Pod Security evaluator: %v", err)
+		return
+	}
+
+	for _, pod := range plan.Pods {
+		template := pod.Template
+		result := psaPolicy.AggregateCheckResults(evaluator.EvaluatePod(policy.Enforce, &template.ObjectMeta, &template.Spec))
+		if !result.Allowed {
+			report.addError(namePodSecurity,
+				"Change the security context of the containers in the Devfile (using pod-overrides and container-overrides attributes), or use a namespace with a less restrictive Pod Security level.",
+				"%s is forbidden by the %q level enforced on the namespace: %s",
+				pod.Description, policy.Enforce.Level, result.ForbiddenDetail())
+			continue
+		}
+		if policy.Warn.Level == psaApi.LevelPrivileged || policy.Warn == policy.Enforce {
+			continue
+		}
+		result = psaPolicy.AggregateCheckResults(evaluator.EvaluatePod(policy.Warn, &template.ObjectMeta, &template.Spec))
+		if !result.Allowed {
+			report.addWarning(namePodSecurity, "",
+				"%s does not comply with the %q level of the namespace: %s",
+				pod.Description, policy.Warn.Level, result.ForbiddenDetail())
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Package preflight checks, before creating anything, that the resources of a component can be created in the current namespace:
+// the user must be allowed to manage them, they must fit in the resource quotas and limit ranges of the namespace,
+// and the pods must be accepted by the Pod Security admission.
+package preflight
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/kclient"
+)
+
+// Plan lists the resources astra is about to create or modify in the current namespace
+type Plan struct {
+	// Accesses are the permissions needed on the resources
+	Accesses []Access
+	// Pods are the pods astra is about to create, directly or through Deployments and Jobs
+	Pods []Pod
+	// Volumes are the persistent volume claims astra is about to create
+	Volumes []Volume
+	// Objects counts the objects astra is about to create, by resource
+	Objects map[schema.GroupResource]int
+}
+
+// Access is a permission needed on a resource
+type Access struct {
+	Group       string
+	Resource    string
+	Subresource string
+	Verbs       []string
+	// Reason describes why astra needs this permission
+	Reason string
+}
+
+// Pod is a pod astra is about to create
+type Pod struct {
+	// Description describes the pod in the report, for example "the Dev Deployment"
+	Description string
+	Template    corev1.PodTemplateSpec
+}
+
+// Volume is a persistent volume claim astra is about to create
+type Volume struct {
+	Name string
+	Size resource.Quantity
+}
+
+// AddAccess adds the permission to use the verbs on the resource
+func (o *Plan) AddAccess(gr schema.GroupResource, reason string, verbs ...string) {
+	o.Accesses = append(o.Accesses, Access{
+		Group:    gr.Group,
+		Resource: gr.Resource,
+		Verbs:    verbs,
+		Reason:   reason,
+	})
+}
+
+// AddObjects records that count objects of the resource are about to be created
+func (o *Plan) AddObjects(gr schema.GroupResource, count int) {
+	if o.Objects == nil {
+		o.Objects = make(map[schema.GroupResource]int)
+	}
+	o.Objects[gr] += count
+}
+
+// Run checks the plan against the permissions of the user, the resource quotas, the limit ranges
+// and the Pod Security admission level of the current namespace, and returns the problems found
+func Run(kubeClient kclient.ClientInterface, plan Plan) Report {
+	var report Report
+	namespace := kubeClient.GetCurrentNamespace()
+	klog.V(4).Infof("running preflight checks in namespace %q", namespace)
+
+	checkAccesses(kubeClient, namespace, plan, &report)
+	checkQuotas(kubeClient, plan, &report)
+	checkPodSecurity(kubeClient, plan, &report)
+	return report
+}
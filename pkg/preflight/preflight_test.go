@@ -0,0 +1,211 @@
+package preflight
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	psaApi "k8s.io/pod-security-admission/api"
+	"k8s.io/utils/pointer"
+
+	"github\.com/danielpickens/astra/pkg/kclient"
+)
+
+func newPod(description string, securityContext *corev1.SecurityContext, requests, limits corev1.ResourceList) Pod {
+	return Pod{
+		Description: description,
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:            "runtime",
+					Image:           "registry.access.redhat.com/ubi8/nodejs-16",
+					SecurityContext: securityContext,
+					Resources: corev1.ResourceRequirements{
+						Requests: requests,
+						Limits:   limits,
+					},
+				}},
+			},
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	restricted := psaApi.LevelVersion{Level: psaApi.LevelRestricted, Version: psaApi.LatestVersion()}
+	privileged := psaApi.LevelVersion{Level: psaApi.LevelPrivileged, Version: psaApi.LatestVersion()}
+	restrictedContext := &corev1.SecurityContext{
+		AllowPrivilegeEscalation: pointer.Bool(false),
+		RunAsNonRoot:             pointer.Bool(true),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+		SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	quota := corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute"},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+				corev1.ResourceLimitsCPU:      resource.MustParse("2"),
+				"count/deployments.apps":      resource.MustParse("2"),
+			},
+			Used: corev1.ResourceList{
+				corev1.ResourceRequestsMemory: resource.MustParse("768Mi"),
+				corev1.ResourceLimitsCPU:      resource.MustParse("1"),
+				"count/deployments.apps":      resource.MustParse("1"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		plan        Plan
+		allowed     func(attributes authorizationv1.ResourceAttributes) bool
+		quotas      []corev1.ResourceQuota
+		limitRanges []corev1.LimitRange
+		policy      psaApi.Policy
+		want        []string
+		wantErr     bool
+	}{
+		{
+			name: "all checks pass",
+			plan: Plan{
+				Accesses: []Access{{Group: "apps", Resource: "deployments", Verbs: []string{"get", "create"}}},
+				Pods: []Pod{newPod("the pod of the Dev Deployment", restrictedContext,
+					corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")})},
+				Objects: map[schema.GroupResource]int{{Group: "apps", Resource: "deployments"}: 1},
+			},
+			quotas: []corev1.ResourceQuota{quota},
+			policy: psaApi.Policy{Enforce: restricted, Warn: restricted},
+		},
+		{
+			name: "permissions denied",
+			plan: Plan{
+				Accesses: []Access{
+					{Group: "apps", Resource: "deployments", Verbs: []string{"get", "create"}, Reason: "the Dev Deployment"},
+					{Resource: "pods", Subresource: "exec", Verbs: []string{"create"}, Reason: "syncing the sources"},
+				},
+			},
+			allowed: func(attributes authorizationv1.ResourceAttributes) bool {
+				return attributes.Verb == "get"
+			},
+			want: []string{
+				`not allowed to create deployments.apps in namespace "my-ns" (needed for the Dev Deployment)`,
+				`not allowed to create pods/exec in namespace "my-ns" (needed for syncing the sources)`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "quota exceeded and container without limit",
+			plan: Plan{
+				Pods: []Pod{newPod("the pod of the Dev Deployment", nil,
+					corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")}, nil)},
+				Objects: map[schema.GroupResource]int{{Group: "apps", Resource: "deployments"}: 2},
+			},
+			quotas: []corev1.ResourceQuota{quota},
+			want: []string{
+				`the quota "compute" is exceeded for count/deployments.apps: 2 needed, 1 already used out of 2`,
+				`the quota "compute" requires limits.cpu for container "runtime" of the pod of the Dev Deployment`,
+				`the quota "compute" is exceeded for requests.memory: 512Mi needed, 768Mi already used out of 1Gi`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "defaults of the limit range",
+			plan: Plan{
+				Pods:    []Pod{newPod("the pod of the Dev Deployment", nil, nil, nil)},
+				Volumes: []Volume{{Name: "cache", Size: resource.MustParse("20Gi")}},
+			},
+			quotas: []corev1.ResourceQuota{quota},
+			limitRanges: []corev1.LimitRange{{
+				ObjectMeta: metav1.ObjectMeta{Name: "limits"},
+				Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{
+					{
+						Type:    corev1.LimitTypeContainer,
+						Default: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+					},
+					{
+						Type: corev1.LimitTypePersistentVolumeClaim,
+						Max:  corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				}},
+			}},
+			want: []string{
+				`volume "cache": the storage limit 20Gi is greater than the maximum 10Gi (limit range "limits")`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "pod forbidden by the pod security level",
+			plan: Plan{
+				Pods: []Pod{
+					newPod("the pod of the Dev Deployment", &corev1.SecurityContext{Privileged: pointer.Bool(true)}, nil, nil),
+				},
+			},
+			policy: psaApi.Policy{Enforce: restricted, Warn: restricted},
+			want: []string{
+				`the pod of the Dev Deployment is forbidden by the "restricted" level enforced on the namespace: privileged (container "runtime" must not set securityContext.privileged=true)`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "pod not complying with the warning level",
+			plan: Plan{
+				Pods: []Pod{newPod("the Job of command \"build\"", nil, nil, nil)},
+			},
+			policy: psaApi.Policy{Enforce: privileged, Warn: restricted},
+			want: []string{
+				`the Job of command "build" does not comply with the "restricted" level of the namespace`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+			kubeClient.EXPECT().GetSelfSubjectAccess(gomock.Any()).DoAndReturn(func(attributes authorizationv1.ResourceAttributes) (authorizationv1.SubjectAccessReviewStatus, error) {
+				if attributes.Namespace != "my-ns" {
+					t.Errorf("unexpected namespace %q", attributes.Namespace)
+				}
+				return authorizationv1.SubjectAccessReviewStatus{Allowed: tt.allowed == nil || tt.allowed(attributes)}, nil
+			}).AnyTimes()
+			kubeClient.EXPECT().ListLimitRanges().Return(tt.limitRanges, nil).AnyTimes()
+			kubeClient.EXPECT().ListResourceQuotas().Return(tt.quotas, nil).AnyTimes()
+			kubeClient.EXPECT().GetCurrentNamespacePolicy().Return(tt.policy, nil).AnyTimes()
+
+			report := Run(kubeClient, tt.plan)
+
+			if len(report.Problems) != len(tt.want) {
+				t.Fatalf("got %d problems, want %d: %+v", len(report.Problems), len(tt.want), report.Problems)
+			}
+			for i, problem := range report.Problems {
+				if !strings.HasPrefix(problem.Message, tt.want[i]) {
+					t.Errorf("problem %d = %q, want %q", i, problem.Message, tt.want[i])
+				}
+			}
+			if err := report.Err(); (err != nil) != tt.wantErr {
+				t.Errorf("Err() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRun_UnreadableQuotas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+	kubeClient.EXPECT().ListLimitRanges().Return(nil, errors.New("forbidden"))
+	kubeClient.EXPECT().ListResourceQuotas().Return(nil, errors.New("forbidden"))
+	kubeClient.EXPECT().GetCurrentNamespacePolicy().Return(psaApi.Policy{}, nil)
+
+	report := Run(kubeClient, Plan{Pods: []Pod{newPod("the pod of the Dev Deployment", nil, nil, nil)}})
+	if err := report.Err(); err != nil {
+		t.Errorf("Err() unexpected error: %v", err)
+	}
+}
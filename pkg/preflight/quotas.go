@@ -0,0 +1,220 @@
+package preflight
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/kclient"
+)
+
+const (
+	nameQuotas      = "Resource quotas"
+	nameLimitRanges = "Limit ranges"
+)
+
+// devfileContainerFields are the fields of the Devfile container components defining the compute resources
+var devfileContainerFields = map[string]string{
+	"requests.cpu":    "cpuRequest",
+	"requests.memory": "memoryRequest",
+	"limits.cpu":      "cpuLimit",
+	"limits.memory":   "memoryLimit",
+}
+
+// checkQuotas checks that the resources requested by the plan fit in the resource quotas and limit ranges of the namespace
+func checkQuotas(kubeClient kclient.ClientInterface, plan Plan, report *Report) {
+	limitRanges, err := kubeClient.ListLimitRanges()
+	if err != nil {
+		klog.V(4).Infof("unable to list the limit ranges: %v", err)
+		limitRanges = nil
+	}
+	pods := getPodResources(plan.Pods, limitRanges)
+	checkLimitRangesOfPods(limitRanges, pods, plan.Volumes, report)
+
+	quotas, err := kubeClient.ListResourceQuotas()
+	if err != nil {
+		// the quotas are not readable by all the users
+		klog.V(4).Infof("unable to list the resource quotas: %v", err)
+		return
+	}
+	for _, quota := range quotas {
+		if len(quota.Spec.Scopes) != 0 || quota.Spec.ScopeSelector != nil {
+			klog.V(4).Infof("skipping the resource quota %q with scopes", quota.Name)
+			continue
+		}
+		hard := quota.Status.Hard
+		if hard == nil {
+			hard = quota.Spec.Hard
+		}
+		names := make([]string, 0, len(hard))
+		for name := range hard {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			max := hard[corev1.ResourceName(name)]
+			needed, missing, ok := getNeeded(name, pods, plan)
+			if !ok {
+				continue
+			}
+			for _, m := range missing {
+				hint := fmt.Sprintf("Set %s in the Devfile, or ask your cluster administrator for a LimitRange with a default value.", normalizeComputeResource(name))
+				if field, found := devfileContainerFields[normalizeComputeResource(name)]; found {
+					hint = fmt.Sprintf("Set %s in the container component %q of the Devfile.", field, m.container)
+				}
+				report.addError(nameQuotas, hint, "the quota %q requires %s for container %q of %s", quota.Name, normalizeComputeResource(name), m.container, m.pod)
+			}
+			if needed.IsZero() {
+				continue
+			}
+			used := quota.Status.Used[corev1.ResourceName(name)]
+			total := used.DeepCopy()
+			total.Add(needed)
+			if total.Cmp(max) <= 0 {
+				continue
+			}
+			report.addError(nameQuotas,
+				"Reduce the resources requested by the Devfile, delete unused resources in the namespace, or ask your cluster administrator for a higher quota.",
+				"the quota %q is exceeded for %s: %s needed, %s already used out of %s", quota.Name, name, needed.String(), used.String(), max.String())
+		}
+	}
+}
+
+// missingResource is a container not defining a resource required by a quota
+type missingResource struct {
+	container string
+	pod       string
+}
+
+// getNeeded returns the quantity of the quota resource needed by the plan, and the containers not defining this resource.
+// ok is false if the resource is not supported.
+func getNeeded(name string, pods []podResources, plan Plan) (needed resource.Quantity, missing []missingResource, ok bool) {
+	switch name {
+	case string(corev1.ResourcePods), "count/pods":
+		return *resource.NewQuantity(int64(len(pods)), resource.DecimalSI), nil, true
+	case string(corev1.ResourcePersistentVolumeClaims), "count/persistentvolumeclaims":
+		return *resource.NewQuantity(int64(len(plan.Volumes)), resource.DecimalSI), nil, true
+	case string(corev1.ResourceRequestsStorage):
+		for _, volume := range plan.Volumes {
+			needed.Add(volume.Size)
+		}
+		return needed, nil, true
+	}
+
+	if computeName := normalizeComputeResource(name); strings.HasPrefix(computeName, "requests.") || strings.HasPrefix(computeName, "limits.") {
+		limits := strings.HasPrefix(computeName, "limits.")
+		resourceName := corev1.ResourceName(computeName[strings.Index(computeName, ".")+1:])
+		for _, pod := range pods {
+			total := pod.total(resourceName, limits)
+			needed.Add(total.value)
+			for _, container := range total.missing {
+				missing = append(missing, missingResource{container: container, pod: pod.description})
+			}
+		}
+		return needed, missing, true
+	}
+
+	var gr schema.GroupResource
+	switch name {
+	case string(corev1.ResourceServices), string(corev1.ResourceSecrets), string(corev1.ResourceConfigMaps), string(corev1.ResourceReplicationControllers):
+		gr = schema.GroupResource{Resource: name}
+	default:
+		if !strings.HasPrefix(name, "count/") {
+			return resource.Quantity{}, nil, false
+		}
+		gr = schema.ParseGroupResource(strings.TrimPrefix(name, "count/"))
+	}
+	return *resource.NewQuantity(int64(plan.Objects[gr]), resource.DecimalSI), nil, true
+}
+
+// normalizeComputeResource returns the name of the quota resource prefixed with "requests."
+// for the legacy names cpu, memory and ephemeral-storage
+func normalizeComputeResource(name string) string {
+	switch corev1.ResourceName(name) {
+	case corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceEphemeralStorage:
+		return "requests." + name
+	}
+	return name
+}
+
+// checkLimitRangesOfPods checks that the resources of the containers, pods and volumes are in the ranges defined by the limit ranges
+func checkLimitRangesOfPods(limitRanges []corev1.LimitRange, pods []podResources, volumes []Volume, report *Report) {
+	const hint = "Change the resources requested in the Devfile to fit in the limit range, or ask your cluster administrator to change it."
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			switch item.Type {
+			case corev1.LimitTypeContainer:
+				for _, pod := range pods {
+					for _, container := range append(append([]containerResources{}, pod.containers...), pod.initContainers...) {
+						checkRange(item, container.requests, container.limits, func(format string, a ...interface{}) {
+							report.addError(nameLimitRanges, hint, "container %q of %s: %s (limit range %q)",
+								container.name, pod.description, fmt.Sprintf(format, a...), limitRange.Name)
+						})
+					}
+				}
+			case corev1.LimitTypePod:
+				for _, pod := range pods {
+					requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+					for name := range item.Min {
+						if total := pod.total(name, false); len(total.missing) == 0 {
+							requests[name] = total.value
+						}
+					}
+					for name := range item.Max {
+						if total := pod.total(name, true); len(total.missing) == 0 {
+							limits[name] = total.value
+						}
+					}
+					checkRange(item, requests, limits, func(format string, a ...interface{}) {
+						report.addError(nameLimitRanges, hint, "%s: %s (limit range %q)", pod.description, fmt.Sprintf(format, a...), limitRange.Name)
+					})
+				}
+			case corev1.LimitTypePersistentVolumeClaim:
+				for _, volume := range volumes {
+					size := corev1.ResourceList{corev1.ResourceStorage: volume.Size}
+					checkRange(item, size, size, func(format string, a ...interface{}) {
+						report.addError(nameLimitRanges, hint, "volume %q: %s (limit range %q)", volume.Name, fmt.Sprintf(format, a...), limitRange.Name)
+					})
+				}
+			}
+		}
+	}
+}
+
+// checkRange reports the requests lower than the minimums, and the limits greater than the maximums of the limit range item
+func checkRange(item corev1.LimitRangeItem, requests, limits corev1.ResourceList, report func(format string, a ...interface{})) {
+	for _, name := range sortedNames(item.Min) {
+		min := item.Min[name]
+		request, found := requests[name]
+		if !found {
+			report("no %s request, the minimum is %s", name, min.String())
+		} else if request.Cmp(min) < 0 {
+			report("the %s request %s is lower than the minimum %s", name, request.String(), min.String())
+		}
+	}
+	for _, name := range sortedNames(item.Max) {
+		max := item.Max[name]
+		limit, found := limits[name]
+		if !found {
+			report("no %s limit, the maximum is %s", name, max.String())
+		} else if limit.Cmp(max) > 0 {
+			report("the %s limit %s is greater than the maximum %s", name, limit.String(), max.String())
+		}
+	}
+}
+
+func sortedNames(list corev1.ResourceList) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
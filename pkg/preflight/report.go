@@ -0,0 +1,88 @@
+package preflight
+
+import (
+	"errors"
+	"fmt"
+
+	"github\.com/danielpickens/astra/pkg/log"
+)
+
+// Severity is the severity of a problem found by the preflight checks
+type Severity string
+
+const (
+	// SeverityError is the severity of the problems preventing the resources from being created
+	SeverityError Severity = "error"
+	// SeverityWarning is the severity of the problems which may prevent the resources from being created,
+	// or which could not be checked
+	SeverityWarning Severity = "warning"
+)
+
+// Problem is a problem found by the preflight checks
+type Problem struct {
+	Severity Severity
+	// Check is the name of the check having found the problem
+	Check   string
+	Message string
+	// Hint describes how to fix the problem
+	Hint string
+}
+
+// Report lists the problems found by the preflight checks
+type Report struct {
+	Problems []Problem
+}
+
+func (o *Report) addError(check, hint, format string, a ...interface{}) {
+	o.Problems = append(o.Problems, Problem{
+		Severity: SeverityError,
+		Check:    check,
+		Message:  fmt.Sprintf(format, a...),
+		Hint:     hint,
+	})
+}
+
+func (o *Report) addWarning(check, hint, format string, a ...interface{}) {
+	o.Problems = append(o.Problems, Problem{
+		Severity: SeverityWarning,
+		Check:    check,
+		Message:  fmt.Sprintf(format, a...),
+		Hint:     hint,
+	})
+}
+
+// Print displays the problems found, if any
+func (o Report) Print() {
+	if len(o.Problems) == 0 {
+		return
+	}
+	log.Section("Preflight checks")
+	for _, problem := range o.Problems {
+		message := fmt.Sprintf("%s: %s", problem.Check, problem.Message)
+		if problem.Hint != "" {
+			message += "\n      " + problem.Hint
+		}
+		if problem.Severity == SeverityError {
+			log.Error(message)
+		} else {
+			log.Warning(message)
+		}
+	}
+}
+
+// Err returns an error if some problems prevent the resources from being created
+func (o Report) Err() error {
+	var count int
+	for _, problem := range o.Problems {
+		if problem.Severity == SeverityError {
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	if count == 1 {
+		return errors.New("preflight checks failed with 1 problem, fix it or set astra_SKIP_PREFLIGHT=true to skip the checks")
+	}
+	return fmt.Errorf("preflight checks failed with %d problems, fix them or set astra_SKIP_PREFLIGHT=true to skip the checks", count)
+}
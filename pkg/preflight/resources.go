@@ -0,0 +1,117 @@
+package preflight
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// containerResources are the resources requested by a container, once the defaults of the limit ranges are applied
+type containerResources struct {
+	name     string
+	requests corev1.ResourceList
+	limits   corev1.ResourceList
+}
+
+// podResources are the resources requested by a pod, once the defaults of the limit ranges are applied
+type podResources struct {
+	description    string
+	containers     []containerResources
+	initContainers []containerResources
+}
+
+// getPodResources returns the resources requested by the pods, as computed by the API server:
+// the requests default to the limits, then the defaults of the limit ranges are applied
+func getPodResources(pods []Pod, limitRanges []corev1.LimitRange) []podResources {
+	var result []podResources
+	for _, pod := range pods {
+		spec := pod.Template.Spec
+		result = append(result, podResources{
+			description:    pod.Description,
+			containers:     getContainersResources(spec.Containers, limitRanges),
+			initContainers: getContainersResources(spec.InitContainers, limitRanges),
+		})
+	}
+	return result
+}
+
+func getContainersResources(containers []corev1.Container, limitRanges []corev1.LimitRange) []containerResources {
+	var result []containerResources
+	for _, container := range containers {
+		requests := container.Resources.Requests.DeepCopy()
+		if requests == nil {
+			requests = corev1.ResourceList{}
+		}
+		limits := container.Resources.Limits.DeepCopy()
+		if limits == nil {
+			limits = corev1.ResourceList{}
+		}
+		for name, value := range limits {
+			if _, found := requests[name]; !found {
+				requests[name] = value.DeepCopy()
+			}
+		}
+		for _, limitRange := range limitRanges {
+			for _, item := range limitRange.Spec.Limits {
+				if item.Type != corev1.LimitTypeContainer {
+					continue
+				}
+				for name, value := range item.Default {
+					if _, found := limits[name]; !found {
+						limits[name] = value.DeepCopy()
+					}
+				}
+				// the default request of a limit range defaults to its default limit
+				for _, defaults := range []corev1.ResourceList{item.DefaultRequest, item.Default} {
+					for name, value := range defaults {
+						if _, found := requests[name]; !found {
+							requests[name] = value.DeepCopy()
+						}
+					}
+				}
+			}
+		}
+		result = append(result, containerResources{
+			name:     container.Name,
+			requests: requests,
+			limits:   limits,
+		})
+	}
+	return result
+}
+
+// total returns the sum of the requests or limits of the containers of the pod,
+// or the maximum of those of the init containers if greater
+func (o podResources) total(name corev1.ResourceName, limits bool) (total resourceTotal) {
+	get := func(c containerResources) corev1.ResourceList {
+		if limits {
+			return c.limits
+		}
+		return c.requests
+	}
+	for _, container := range o.containers {
+		value, found := get(container)[name]
+		if !found {
+			total.missing = append(total.missing, container.name)
+			continue
+		}
+		total.value.Add(value)
+	}
+	for _, container := range o.initContainers {
+		value, found := get(container)[name]
+		if !found {
+			total.missing = append(total.missing, container.name)
+			continue
+		}
+		if value.Cmp(total.value) > 0 {
+			total.value = value.DeepCopy()
+		}
+	}
+	return total
+}
+
+// resourceTotal is the total of a resource for a pod
+type resourceTotal struct {
+	value resource.Quantity
+	// missing are the containers not defining the resource
+	missing []string
+}
@@ -0,0 +1,14 @@
+package asker
+
+type Asker interface {
+	// SelectKind takes a list of kinds of services and asks the user to select one; it returns the index of the selected kind
+	SelectKind(options []string) (int, error)
+	// AskServiceName asks for the name of the service to be set
+	AskServiceName(defaultName string) (string, error)
+	// SelectParameters takes a list of optional parameters and asks the user to select the ones to set
+	SelectParameters(options []string) ([]string, error)
+	// AskParameterValue asks for the value of a parameter; if enum is not empty, the user selects one of its values
+	AskParameterValue(name string, description string, enum []string, required bool) (string, error)
+	// AskAddBinding asks if a binding between the component and the service should be added to the devfile
+	AskAddBinding() (bool, error)
+}
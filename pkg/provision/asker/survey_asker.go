@@ -0,0 +1,100 @@
+package asker
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+type Survey struct{}
+
+var _ Asker = (*Survey)(nil)
+
+func NewSurveyAsker() *Survey {
+	return &Survey{}
+}
+
+func (s *Survey) SelectKind(options []string) (int, error) {
+	question := &survey.Select{
+		Message: "Select the kind of service you want to add:",
+		Options: options,
+	}
+	var answer int
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return 0, err
+	}
+	return answer, nil
+}
+
+func (s *Survey) AskServiceName(defaultName string) (string, error) {
+	question := &survey.Input{
+		Message: "Enter the service's name:",
+		Default: defaultName,
+	}
+	var answer string
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return "", err
+	}
+	return answer, nil
+}
+
+func (s *Survey) SelectParameters(options []string) ([]string, error) {
+	if len(options) == 0 {
+		return nil, nil
+	}
+	question := &survey.MultiSelect{
+		Message: "Select the optional parameters you want to set:",
+		Options: options,
+	}
+	var answer []string
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+func (s *Survey) AskParameterValue(name string, description string, enum []string, required bool) (string, error) {
+	message := fmt.Sprintf("Enter the value of %s:", name)
+	if len(enum) > 0 {
+		message = fmt.Sprintf("Select the value of %s:", name)
+	}
+	var opts []survey.AskOpt
+	if required {
+		opts = append(opts, survey.WithValidator(survey.Required))
+	}
+	var question survey.Prompt
+	if len(enum) > 0 {
+		question = &survey.Select{
+			Message: message,
+			Options: enum,
+			Help:    description,
+		}
+	} else {
+		question = &survey.Input{
+			Message: message,
+			Help:    description,
+		}
+	}
+	var answer string
+	err := survey.AskOne(question, &answer, opts...)
+	if err != nil {
+		return "", err
+	}
+	return answer, nil
+}
+
+func (s *Survey) AskAddBinding() (bool, error) {
+	question := &survey.Confirm{
+		Message: "Do you want to bind the component to this service?",
+		Default: true,
+	}
+	var answer bool
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return false, err
+	}
+	return answer, nil
+}
@@ -0,0 +1,121 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	"github.com/go-openapi/spec"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/service"
+)
+
+const (
+	FLAG_KIND  = "kind"
+	FLAG_NAME  = "name"
+	FLAG_PARAM = "param"
+	FLAG_BIND  = "bind"
+)
+
+// FlagsBackend is a backend that will extract all needed information from flags passed to the command
+type FlagsBackend struct{}
+
+var _ AddServiceBackend = (*FlagsBackend)(nil)
+
+func NewFlagsBackend() *FlagsBackend {
+	return &FlagsBackend{}
+}
+
+func (o *FlagsBackend) Validate(flags map[string]string) error {
+	if flags[FLAG_KIND] == "" {
+		return errors.New("missing --kind parameter: please add --kind <kind>[.<apigroup>][/<version>] to specify the kind of service to add")
+	}
+	if flags[FLAG_NAME] != "" {
+		err := dfutil.ValidateK8sResourceName(FLAG_NAME, flags[FLAG_NAME])
+		if err != nil {
+			return err
+		}
+	}
+	_, err := parseParamsFlag(flags[FLAG_PARAM])
+	return err
+}
+
+// SelectKind returns the kind matching the value of the --kind flag, in the form `<kind>[.<apigroup>][/<version>]`.
+// An error is returned if no kind or several kinds are matching.
+func (o *FlagsBackend) SelectKind(flags map[string]string, kinds []api.OperatorBackedKind) (api.OperatorBackedKind, error) {
+	selectedKind, selectedGroup, selectedVersion := parseKind(flags[FLAG_KIND])
+	var matching []api.OperatorBackedKind
+	for _, kind := range kinds {
+		if kind.Kind != selectedKind {
+			continue
+		}
+		if selectedGroup != "" && kind.Group != selectedGroup {
+			continue
+		}
+		if selectedVersion != "" && kind.Version != selectedVersion {
+			continue
+		}
+		matching = append(matching, kind)
+	}
+	switch len(matching) {
+	case 0:
+		return api.OperatorBackedKind{}, fmt.Errorf("kind %q is not provided by the Operators installed in the current namespace", flags[FLAG_KIND])
+	case 1:
+		return matching[0], nil
+	}
+	var names []string
+	for _, kind := range matching {
+		names = append(names, kind.String())
+	}
+	return api.OperatorBackedKind{}, fmt.Errorf("several kinds match %q: %s; please use the form <kind>.<apigroup>/<version> to select one", flags[FLAG_KIND], strings.Join(names, ", "))
+}
+
+func (o *FlagsBackend) AskServiceName(flags map[string]string, defaultName string) (string, error) {
+	if flags[FLAG_NAME] != "" {
+		return flags[FLAG_NAME], nil
+	}
+	return defaultName, nil
+}
+
+func (o *FlagsBackend) SelectParameters(flags map[string]string, schema *spec.Schema) (map[string]string, error) {
+	params, err := parseParamsFlag(flags[FLAG_PARAM])
+	if err != nil {
+		return nil, err
+	}
+	return params, service.ValidateParams(schema, params)
+}
+
+func (o *FlagsBackend) AskAddBinding(flags map[string]string) (bool, error) {
+	return flags[FLAG_BIND] == "true", nil
+}
+
+// parseKind parses a kind in the form `<kind>[.<apigroup>][/<version>]`
+func parseKind(value string) (kind string, group string, version string) {
+	value, version, _ = strings.Cut(value, "/")
+	kind, group, _ = strings.Cut(value, ".")
+	return kind, group, version
+}
+
+// parseParamsFlag parses the value of the repeatable --param flag, formatted as "[name1=value1,name2=value2]"
+func parseParamsFlag(flagVal string) (map[string]string, error) {
+	result := map[string]string{}
+	if flagVal == "" {
+		return result, nil
+	}
+	if !(strings.HasPrefix(flagVal, "[") && strings.HasSuffix(flagVal, "]")) {
+		return nil, fmt.Errorf("malformed value %q", flagVal)
+	}
+	for _, param := range strings.Split(flagVal[1:len(flagVal)-1], ",") {
+		name, value, found := strings.Cut(param, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("malformed parameter %q, the expected format is name=value", param)
+		}
+		if _, ok := result[name]; ok {
+			return nil, fmt.Errorf("parameter %q is set several times", name)
+		}
+		result[name] = value
+	}
+	return result, nil
+}
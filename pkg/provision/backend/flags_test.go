@@ -0,0 +1,107 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+func TestFlagsBackend_SelectKind(t *testing.T) {
+	redisV1 := api.OperatorBackedKind{Operator: "redis-operator.v0.8.0", Group: "redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "Redis"}
+	redisV2 := api.OperatorBackedKind{Operator: "redis-operator.v0.8.0", Group: "redis.redis.opstreelabs.in", Version: "v1beta2", Kind: "Redis"}
+	cluster := api.OperatorBackedKind{Operator: "cloud-native-postgresql.v1.15.1", Group: "postgresql.k8s.enterprisedb.io", Version: "v1", Kind: "Cluster"}
+	kinds := []api.OperatorBackedKind{cluster, redisV1, redisV2}
+
+	tests := []struct {
+		name    string
+		kind    string
+		want    api.OperatorBackedKind
+		wantErr bool
+	}{
+		{
+			name: "kind only",
+			kind: "Cluster",
+			want: cluster,
+		},
+		{
+			name: "kind and group",
+			kind: "Cluster.postgresql.k8s.enterprisedb.io",
+			want: cluster,
+		},
+		{
+			name: "kind, group and version",
+			kind: "Redis.redis.redis.opstreelabs.in/v1beta2",
+			want: redisV2,
+		},
+		{
+			name: "kind and version",
+			kind: "Redis/v1beta1",
+			want: redisV1,
+		},
+		{
+			name:    "several matching kinds",
+			kind:    "Redis",
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind",
+			kind:    "Cluster.other.io",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewFlagsBackend()
+			got, err := o.SelectKind(map[string]string{FLAG_KIND: tt.kind}, kinds)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SelectKind() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("SelectKind() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlagsBackend_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "valid flags",
+			flags: map[string]string{FLAG_KIND: "Redis", FLAG_NAME: "my-redis", FLAG_PARAM: "[kubernetesConfig.image=redis:7,redisExporter.enabled=false]"},
+		},
+		{
+			name:    "missing kind",
+			flags:   map[string]string{FLAG_NAME: "my-redis"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid name",
+			flags:   map[string]string{FLAG_KIND: "Redis", FLAG_NAME: "My_Redis"},
+			wantErr: true,
+		},
+		{
+			name:    "malformed param",
+			flags:   map[string]string{FLAG_KIND: "Redis", FLAG_PARAM: "[image]"},
+			wantErr: true,
+		},
+		{
+			name:    "param set twice",
+			flags:   map[string]string{FLAG_KIND: "Redis", FLAG_PARAM: "[size=1,size=2]"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := NewFlagsBackend().Validate(tt.flags); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
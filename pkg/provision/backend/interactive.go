@@ -0,0 +1,133 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	"github.com/go-openapi/spec"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/log"
+	"github\.com/danielpickens/astra/pkg/provision/asker"
+	"github\.com/danielpickens/astra/pkg/service"
+)
+
+// InteractiveBackend is a backend that will ask information interactively using the `asker` package
+type InteractiveBackend struct {
+	askerClient asker.Asker
+}
+
+var _ AddServiceBackend = (*InteractiveBackend)(nil)
+
+func NewInteractiveBackend(askerClient asker.Asker) *InteractiveBackend {
+	return &InteractiveBackend{
+		askerClient: askerClient,
+	}
+}
+
+func (o *InteractiveBackend) Validate(_ map[string]string) error {
+	return nil
+}
+
+func (o *InteractiveBackend) SelectKind(_ map[string]string, kinds []api.OperatorBackedKind) (api.OperatorBackedKind, error) {
+	options := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		option := fmt.Sprintf("%s (%s/%s) from %s", kind.Kind, kind.Group, kind.Version, kind.Operator)
+		if kind.DisplayName != "" && kind.DisplayName != kind.Kind {
+			option += " - " + kind.DisplayName
+		}
+		options = append(options, option)
+	}
+	i, err := o.askerClient.SelectKind(options)
+	if err != nil {
+		return api.OperatorBackedKind{}, err
+	}
+	return kinds[i], nil
+}
+
+func (o *InteractiveBackend) AskServiceName(_ map[string]string, defaultName string) (string, error) {
+	for {
+		name, err := o.askerClient.AskServiceName(defaultName)
+		if err != nil {
+			return "", err
+		}
+		err = dfutil.ValidateK8sResourceName("service name", name)
+		if err == nil {
+			return name, nil
+		}
+		log.Error(err)
+	}
+}
+
+// SelectParameters asks the values of the required parameters, then the values of the optional parameters selected by the user
+func (o *InteractiveBackend) SelectParameters(_ map[string]string, schema *spec.Schema) (map[string]string, error) {
+	params := service.ListParameters(schema)
+	if len(params) == 0 {
+		log.Info("The spec of this kind of service does not declare any parameter. You can set any field of the spec with --param <path>=<value> flags")
+		return map[string]string{}, nil
+	}
+
+	var optional []string
+	for _, param := range params {
+		if !param.Required || strings.Contains(param.Path, ".") {
+			optional = append(optional, param.Path)
+		}
+	}
+	paths, err := o.askerClient.SelectParameters(optional)
+	if err != nil {
+		return nil, err
+	}
+
+	// The required top-level parameters are always asked, and the required fields of a nested object
+	// are asked if one of its fields is selected
+	var selected []service.Parameter
+	for _, param := range params {
+		if isSelected(param, paths) {
+			selected = append(selected, param)
+		}
+	}
+
+	result := map[string]string{}
+	for _, param := range selected {
+		value, err := o.askParameterValue(param)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			result[param.Path] = value
+		}
+	}
+	return result, service.ValidateParams(schema, result)
+}
+
+func (o *InteractiveBackend) askParameterValue(param service.Parameter) (string, error) {
+	for {
+		value, err := o.askerClient.AskParameterValue(param.Path, param.Description, param.Enum, param.Required)
+		if err != nil {
+			return "", err
+		}
+		err = service.ValidateParam(param, value)
+		if err == nil {
+			return value, nil
+		}
+		log.Error(err)
+	}
+}
+
+func (o *InteractiveBackend) AskAddBinding(_ map[string]string) (bool, error) {
+	return o.askerClient.AskAddBinding()
+}
+
+func isSelected(param service.Parameter, paths []string) bool {
+	i := strings.LastIndex(param.Path, ".")
+	if param.Required && i < 0 {
+		return true
+	}
+	for _, path := range paths {
+		if path == param.Path || (param.Required && strings.HasPrefix(path, param.Path[:i+1])) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package backend
+
+import (
+	"github.com/go-openapi/spec"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+type AddServiceBackend interface {
+	// Validate returns error if the backend failed to validate; mainly useful for flags backend
+	Validate(flags map[string]string) error
+	// SelectKind returns the kind of service to add, from the kinds provided by the Operators installed in the cluster
+	SelectKind(flags map[string]string, kinds []api.OperatorBackedKind) (api.OperatorBackedKind, error)
+	// AskServiceName returns the name to be set for the service
+	AskServiceName(flags map[string]string, defaultName string) (string, error)
+	// SelectParameters returns the values of the parameters of the service spec, validated against its schema
+	SelectParameters(flags map[string]string, schema *spec.Schema) (map[string]string, error)
+	// AskAddBinding returns true if a binding between the component and the service should be added to the devfile
+	AskAddBinding(flags map[string]string) (bool, error)
+}
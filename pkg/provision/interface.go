@@ -0,0 +1,37 @@
+package provision
+
+import (
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/go-openapi/spec"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+type Client interface {
+	// GetFlags gets the necessary flags for adding a service
+	GetFlags(flags map[string]string) map[string]string
+	// ValidateAddService returns error if the backend failed to validate; mainly useful for flags backend
+	ValidateAddService(flags map[string]string) error
+	// ListKinds returns the kinds of services provided by the Operators installed in the current namespace
+	ListKinds() ([]api.OperatorBackedKind, error)
+	// SelectKind returns the kind of service to add
+	SelectKind(flags map[string]string, kinds []api.OperatorBackedKind) (api.OperatorBackedKind, error)
+	// GetKindSpecSchema returns the schema of the spec of the kind, or nil if any field can be set in the spec
+	GetKindSpecSchema(kind api.OperatorBackedKind) (*spec.Schema, error)
+	// AskServiceName returns the name to be set for the service
+	AskServiceName(flags map[string]string, kind api.OperatorBackedKind) (string, error)
+	// SelectParameters returns the values of the parameters of the service spec, validated against the schema
+	SelectParameters(flags map[string]string, schema *spec.Schema) (map[string]string, error)
+	// AddServiceToDevfile builds the service from the parameters and adds it to the devfile as a Kubernetes component.
+	// It returns the updated devfile and the definition of the service
+	AddServiceToDevfile(
+		name string,
+		kind api.OperatorBackedKind,
+		schema *spec.Schema,
+		params map[string]string,
+		obj parser.DevfileObj,
+	) (parser.DevfileObj, unstructured.Unstructured, error)
+	// AskAddBinding returns true if a binding between the component and the service should be added to the devfile
+	AskAddBinding(flags map[string]string) (bool, error)
+}
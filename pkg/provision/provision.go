@@ -0,0 +1,111 @@
+// Package provision adds services provided by the Operators installed in the cluster to the devfile
+package provision
+
+import (
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/go-openapi/spec"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/kclient"
+	"github\.com/danielpickens/astra/pkg/libdevfile"
+	"github\.com/danielpickens/astra/pkg/provision/asker"
+	backendpkg "github\.com/danielpickens/astra/pkg/provision/backend"
+	"github\.com/danielpickens/astra/pkg/service"
+)
+
+type ProvisionClient struct {
+	// Backends
+	flagsBackend       *backendpkg.FlagsBackend
+	interactiveBackend *backendpkg.InteractiveBackend
+
+	// Clients
+	kubernetesClient kclient.ClientInterface
+}
+
+var _ Client = (*ProvisionClient)(nil)
+
+func NewProvisionClient(kubernetesClient kclient.ClientInterface) *ProvisionClient {
+	// We create the asker client and the backends here and not at the CLI level, as we want to hide these details to the CLI
+	askerClient := asker.NewSurveyAsker()
+	return &ProvisionClient{
+		flagsBackend:       backendpkg.NewFlagsBackend(),
+		interactiveBackend: backendpkg.NewInteractiveBackend(askerClient),
+		kubernetesClient:   kubernetesClient,
+	}
+}
+
+// GetFlags gets the flag specific to add service operation so that it can correctly decide on the backend to be used
+// It ignores all the flags except the ones specific to add service operation, for e.g. verbosity flag
+func (o *ProvisionClient) GetFlags(flags map[string]string) map[string]string {
+	serviceFlags := map[string]string{}
+	for flag, value := range flags {
+		if flag == backendpkg.FLAG_KIND ||
+			flag == backendpkg.FLAG_NAME ||
+			flag == backendpkg.FLAG_PARAM ||
+			flag == backendpkg.FLAG_BIND {
+			serviceFlags[flag] = value
+		}
+	}
+	return serviceFlags
+}
+
+func (o *ProvisionClient) getBackend(flags map[string]string) backendpkg.AddServiceBackend {
+	if len(flags) == 0 {
+		return o.interactiveBackend
+	}
+	return o.flagsBackend
+}
+
+func (o *ProvisionClient) ValidateAddService(flags map[string]string) error {
+	return o.getBackend(flags).Validate(flags)
+}
+
+func (o *ProvisionClient) ListKinds() ([]api.OperatorBackedKind, error) {
+	return service.ListOperatorBackedKinds(o.kubernetesClient)
+}
+
+func (o *ProvisionClient) SelectKind(flags map[string]string, kinds []api.OperatorBackedKind) (api.OperatorBackedKind, error) {
+	return o.getBackend(flags).SelectKind(flags, kinds)
+}
+
+func (o *ProvisionClient) GetKindSpecSchema(kind api.OperatorBackedKind) (*spec.Schema, error) {
+	return service.GetKindSpecSchema(o.kubernetesClient, kind)
+}
+
+func (o *ProvisionClient) AskServiceName(flags map[string]string, kind api.OperatorBackedKind) (string, error) {
+	return o.getBackend(flags).AskServiceName(flags, strings.ToLower(kind.Kind))
+}
+
+func (o *ProvisionClient) SelectParameters(flags map[string]string, schema *spec.Schema) (map[string]string, error) {
+	return o.getBackend(flags).SelectParameters(flags, schema)
+}
+
+func (o *ProvisionClient) AddServiceToDevfile(
+	name string,
+	kind api.OperatorBackedKind,
+	schema *spec.Schema,
+	params map[string]string,
+	obj parser.DevfileObj,
+) (parser.DevfileObj, unstructured.Unstructured, error) {
+	u, err := service.BuildServiceFromParams(name, kind, schema, params)
+	if err != nil {
+		return obj, unstructured.Unstructured{}, err
+	}
+	yamlDesc, err := yaml.Marshal(u.UnstructuredContent())
+	if err != nil {
+		return obj, unstructured.Unstructured{}, err
+	}
+	obj, err = libdevfile.AddKubernetesComponentToDevfile(string(yamlDesc), name, obj)
+	if err != nil {
+		return obj, unstructured.Unstructured{}, err
+	}
+	return obj, u, nil
+}
+
+func (o *ProvisionClient) AskAddBinding(flags map[string]string) (bool, error) {
+	return o.getBackend(flags).AskAddBinding(flags)
+}
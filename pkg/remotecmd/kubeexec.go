@@ -10,6 +10,7 @@ import (
 	"k8s.io/klog"
 
 	"github\.com/danielpickens/astra/pkg/exec"
+	"github\.com/danielpickens/astra/pkg/log"
 	"github\.com/danielpickens/astra/pkg/storage"
 	"github\.com/danielpickens/astra/pkg/task"
 )
@@ -53,38 +54,36 @@ func (k *kubeExecProcessHandler) GetProcessInfoForCommand(ctx context.Context, d
 func (k *kubeExecProcessHandler) StartProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string, outputHandler CommandOutputHandler) error {
 	klog.V(4).Infof("StartProcessForCommand for %q", def.Id)
 
-	// deal with environment variables
 	cmdLine := def.CmdLine
-	envCommands := make([]string, 0, len(def.EnvVars))
-	for _, envVar := range def.EnvVars {
-		envCommands = append(envCommands, fmt.Sprintf("%s='%s'", envVar.Key, envVar.Value))
-	}
-	var setEnvCmd string
-	if len(envCommands) != 0 {
-		setEnvCmd = fmt.Sprintf("export %s &&", strings.Join(envCommands, " "))
-	}
-
-	var cdCmd string
-	if def.WorkingDir != "" {
-		// Change to the workdir and execute the command
-		cdCmd = fmt.Sprintf("cd %s &&", def.WorkingDir)
-	}
+	cdCmd, setEnvCmd := getWorkingDirAndEnvCommands(def)
 
 	// since we are using /bin/sh -c, the command needs to be within a single double quote instance,
 	// for example "cd /tmp && pwd"
-	// Full command is: /bin/sh -c "[cd $workingDir && ] echo $$ > $pidFile && (envVar1='value1' envVar2='value2' $cmdLine) 1>>/proc/1/fd/1 2>>/proc/1/fd/2"
+	// Full command is: /bin/sh -c ": > $outputFile && { { /bin/sh -c $nestedCmd | tee -a $outputFile 1>>/proc/1/fd/2; } 3>&1 | tee -a $outputFile 1>>/proc/1/fd/1; }"
+	// where $nestedCmd is the quoted "echo $$ > $pidFile && [cd $workingDir && ] [export envVar1='value1' envVar2='value2' && ] ($cmdLine) 2>&1 1>&3 3>&-; echo $? >> $pidFile"
 	//
-	// "echo $$ > $pidFile" allows to store the /bin/sh parent process PID. It will allow to determine its children later on and kill them when a stop is requested.
+	// The command is run by a nested /bin/sh process, so that the tee processes are not children of the process stored in the PID file.
+	// "echo $$ > $pidFile" allows to store the nested /bin/sh process PID. It will allow to determine its children later on and kill them when a stop is requested.
 	// ($cmdLine) runs the command passed in a subshell (to handle cases where the command does more complex things like running processes in the background),
-	// which will be the child process of the /bin/sh one.
+	// which will be the child process of the nested /bin/sh one.
 	//
-	// Redirecting to /proc/1/fd/* allows to redirect the process output to the output streams of PID 1 process inside the container.
+	// The standard output and error of the command are sent through fds 3 and 1 to two tee processes, which write them to the output file,
+	// so that the output of this command only can be read (see GetOutputCommandForCommand), and to the output streams of PID 1 process inside the container.
 	// This way, returning the container logs with 'astra logs' or 'kubectl logs' would work seamlessly.
 	// See https://stackoverflow.com/questions/58716574/where-exactly-do-the-logs-of-kubernetes-pods-come-from-at-the-container-level
+	// Note that the tee processes, and so the exec, terminate only when all the processes started by the command, including the ones running in the background, have terminated.
+	//
+	// If tee is not available in the container, the output of the command is only redirected to the output streams of PID 1 process,
+	// with $nestedCmd being "echo $$ > $pidFile && [cd $workingDir && ] [export envVar1='value1' envVar2='value2' && ] ($cmdLine) 1>>/proc/1/fd/1 2>>/proc/1/fd/2; echo $? >> $pidFile",
+	// and the output file stays empty.
 	pidFile := getPidFileForCommand(def)
+	outputFile := getOutputFileForCommand(def)
+	nestedCmd := fmt.Sprintf("echo $$ > %[1]s && %s %s (%s) 2>&1 1>&3 3>&-; echo $? >> %[1]s", pidFile, cdCmd, setEnvCmd, cmdLine)
+	nestedCmdWithoutTee := fmt.Sprintf("echo $$ > %[1]s && %s %s (%s) 1>>/proc/1/fd/1 2>>/proc/1/fd/2; echo $? >> %[1]s", pidFile, cdCmd, setEnvCmd, cmdLine)
 	cmd := []string{
 		ShellExecutable, "-c",
-		fmt.Sprintf("echo $$ > %[1]s && %s %s (%s) 1>>/proc/1/fd/1 2>>/proc/1/fd/2; echo $? >> %[1]s", pidFile, cdCmd, setEnvCmd, cmdLine),
+		fmt.Sprintf(": > %[1]s && if %[2]s; then { { %[3]s -c %[4]s | tee -a %[1]s 1>>/proc/1/fd/2; } 3>&1 | tee -a %[1]s 1>>/proc/1/fd/1; }; else %[3]s -c %[5]s; fi",
+			outputFile, teeAvailableCheck, ShellExecutable, shellQuote(nestedCmd), shellQuote(nestedCmdWithoutTee)),
 	}
 
 	//Monitoring go-routine
@@ -124,6 +123,10 @@ func (k *kubeExecProcessHandler) StartProcessForCommand(ctx context.Context, def
 			status = Errored
 		} else {
 			status = processInfo.Status
+			if status == Errored && err == nil {
+				// the exec itself succeeded, the exit code of the command is the one written to the PID file
+				err = &ExitError{ExitCode: processInfo.ExitCode}
+			}
 		}
 
 		eventsChan <- event{
@@ -143,46 +146,108 @@ func (k *kubeExecProcessHandler) StartProcessForCommand(ctx context.Context, def
 // Because of the way this process is launched and its PID stored (see StartProcessForCommand),
 // we need to determine the process children (there should be only one child which is the sub-shell running the command passed to StartProcessForCommand).
 // Then killing those children will exit the parent 'sh' process.
+// The pre-stop command-line of the command, if any, is executed first if the process is still running. Then all the processes are sent the stop signal of the command,
+// and the ones still running after the stop timeout of the command are killed with SIGKILL.
 func (k *kubeExecProcessHandler) StopProcessForCommand(ctx context.Context, def CommandDefinition, podName string, containerName string) error {
 	klog.V(4).Infof("StopProcessForCommand for %q", def.Id)
 
-	kill := func(p int) error {
-		_, _, err := k.execClient.ExecuteCommand(ctx, []string{ShellExecutable, "-c", fmt.Sprintf("kill %d || true", p)}, podName, containerName, false, nil, nil)
-		if err != nil {
-			return err
-		}
+	signal := def.StopSignal
+	if signal == "" {
+		signal = DefaultStopSignal
+	}
+	timeout := def.StopTimeout
+	if timeout == 0 {
+		timeout = DefaultStopTimeout
+	}
 
-		//Because the process we just stopped might take longer to exit (it might have caught the signal and is performing additional cleanup),
-		//retry detecting its actual state till it is stopped or timeout expires
-		var processInfo interface{}
-		processInfo, err = task.NewRetryable(fmt.Sprintf("status for remote process %d", p), func() (bool, interface{}, error) {
+	// waitForExit checks the state of the process until it is stopped or the schedule expires, and returns true if the process is stopped
+	waitForExit := func(p int, schedule []time.Duration) (bool, error) {
+		processInfo, err := task.NewRetryable(fmt.Sprintf("status for remote process %d", p), func() (bool, interface{}, error) {
 			pInfo, e := k.getProcessInfoFromPid(ctx, p, 0, podName, containerName)
 			return e == nil && (pInfo.Status == Stopped || pInfo.Status == Errored), pInfo, e
-		}).RetryWithSchedule([]time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second}, true)
+		}).RetryWithSchedule(schedule, false)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		pInfo, ok := processInfo.(RemoteProcessInfo)
 		if !ok {
 			klog.V(2).Infof("invalid type for remote process (%d) info, expected RemoteProcessInfo", p)
-			return fmt.Errorf("internal error while checking remote process status: %d", p)
+			return false, fmt.Errorf("internal error while checking remote process status: %d", p)
 		}
-		if pInfo.Status != Stopped && pInfo.Status != Errored {
-			return fmt.Errorf("invalid status for remote process %d: %+v", p, processInfo)
+		return pInfo.Status == Stopped || pInfo.Status == Errored, nil
+	}
+
+	// kill sends the stop signal to all the processes first, so that they stop concurrently, then kills with SIGKILL
+	// the processes still running when the deadline expires
+	kill := func(pids []int, deadline time.Time) error {
+		for _, p := range pids {
+			_, _, err := k.execClient.ExecuteCommand(ctx, []string{ShellExecutable, "-c", getKillCommand(p, def.StopSignal)}, podName, containerName, false, nil, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		//Because the processes we just stopped might take longer to exit (they might have caught the signal and are performing additional cleanup),
+		//retry detecting their actual state till they are stopped or the deadline expires
+		var running []int
+		for _, p := range pids {
+			stopped, err := waitForExit(p, getStopSchedule(time.Until(deadline)))
+			if err != nil {
+				return err
+			}
+			if stopped {
+				klog.V(2).Infof("remote process %d for command %q terminated after SIG%s", p, def.Id, signal)
+				continue
+			}
+			running = append(running, p)
+		}
+
+		for _, p := range running {
+			log.Warningf("Process %d for command %q still running %s after SIG%s, killing it with SIGKILL", p, def.Id, timeout, signal)
+			_, _, err := k.execClient.ExecuteCommand(ctx, []string{ShellExecutable, "-c", getKillCommand(p, "KILL")}, podName, containerName, false, nil, nil)
+			if err != nil {
+				return err
+			}
+		}
+		for _, p := range running {
+			stopped, err := waitForExit(p, []time.Duration{time.Second, 2 * time.Second})
+			if err != nil {
+				return err
+			}
+			if !stopped {
+				return fmt.Errorf("remote process %d for command %q still running after SIGKILL", p, def.Id)
+			}
+			klog.V(2).Infof("remote process %d for command %q terminated after SIGKILL", p, def.Id)
 		}
 		return nil
 	}
 
-	ppid, _, err := k.getRemoteProcessPID(ctx, def, podName, containerName)
+	ppid, exitStatus, err := k.getRemoteProcessPID(ctx, def, podName, containerName)
 	if err != nil {
 		return err
 	}
 	if ppid == 0 {
 		return nil
 	}
+
+	if def.PreStopCmdLine != "" {
+		// The PID file is kept after the process exits, the pre-stop command must not be executed if the process is not running anymore
+		processInfo, pErr := k.getProcessInfoFromPid(ctx, ppid, exitStatus, podName, containerName)
+		if pErr != nil {
+			return pErr
+		}
+		if processInfo.Status == Running {
+			k.executePreStopCommand(ctx, def, timeout, podName, containerName)
+		} else {
+			klog.V(2).Infof("remote process %d for command %q not running, not executing the pre-stop command", ppid, def.Id)
+		}
+	}
+
+	// The children and the parent process share the same stop timeout
+	deadline := time.Now().Add(timeout)
 	defer func() {
-		if kErr := kill(ppid); kErr != nil {
+		if kErr := kill([]int{ppid}, deadline); kErr != nil {
 			klog.V(3).Infof("could not kill parent process %d: %v", ppid, kErr)
 		}
 	}()
@@ -200,13 +265,20 @@ func (k *kubeExecProcessHandler) StopProcessForCommand(ctx context.Context, def
 		klog.V(2).Infof("Could not remove file %q: %v", pidFile, err)
 	}
 
-	for _, child := range children {
-		if err = kill(child); err != nil {
-			return err
-		}
-	}
+	return kill(children, deadline)
+}
 
-	return nil
+// executePreStopCommand executes the pre-stop command-line of the command, in its working directory and with its environment variables.
+// The command-line is interrupted if it does not terminate before the timeout. An error is logged but does not prevent stopping the process.
+func (k *kubeExecProcessHandler) executePreStopCommand(ctx context.Context, def CommandDefinition, timeout time.Duration, podName string, containerName string) {
+	klog.V(2).Infof("executing pre-stop command for %q", def.Id)
+	cdCmd, setEnvCmd := getWorkingDirAndEnvCommands(def)
+	preStopCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, _, err := k.execClient.ExecuteCommand(preStopCtx, []string{ShellExecutable, "-c", fmt.Sprintf("%s %s (%s)", cdCmd, setEnvCmd, def.PreStopCmdLine)}, podName, containerName, false, nil, nil)
+	if err != nil {
+		log.Warningf("Pre-stop command for %q failed: %v", def.Id, err)
+	}
 }
 
 func (k *kubeExecProcessHandler) getRemoteProcessPID(ctx context.Context, def CommandDefinition, podName string, containerName string) (int, int, error) {
@@ -281,6 +353,7 @@ func (k *kubeExecProcessHandler) getProcessInfoFromPid(ctx context.Context, pid
 	if killStatus == 0 {
 		process.Status = Running
 	} else {
+		process.ExitCode = lastKnownExitStatus
 		if lastKnownExitStatus == 0 {
 			process.Status = Stopped
 		} else {
@@ -375,3 +448,76 @@ func getPidFileForCommand(def CommandDefinition) string {
 	}
 	return fmt.Sprintf("%s/.astra_cmd_%s.pid", strings.TrimSuffix(parentDir, "/"), def.Id)
 }
+
+// getOutputFileForCommand returns the path to the file in the remote container to which the output of the command is written,
+// next to the PID file
+func getOutputFileForCommand(def CommandDefinition) string {
+	return strings.TrimSuffix(getPidFileForCommand(def), ".pid") + ".log"
+}
+
+// GetOutputCommandForCommand returns the command reading, in the remote container, the output of the last execution of the command
+// started by StartProcessForCommand. If follow is true, the command keeps reading the output written after, including the output
+// of the next executions of the command.
+func GetOutputCommandForCommand(def CommandDefinition, follow bool) []string {
+	outputFile := getOutputFileForCommand(def)
+	if follow {
+		return []string{ShellExecutable, "-c", fmt.Sprintf("tail -n +1 -F %s 2>/dev/null", outputFile)}
+	}
+	return []string{ShellExecutable, "-c", fmt.Sprintf("cat %s 2>/dev/null || true", outputFile)}
+}
+
+// GetTerminatingCommandLine returns the shell command-line executing cmdLine and exiting with its exit status.
+// As for the commands started by StartProcessForCommand, the output of cmdLine is written to the output file of the command
+// (see GetOutputCommandForCommand) and to the output streams of PID 1 process inside the container,
+// or only to the output streams of PID 1 process if tee is not available in the container.
+func GetTerminatingCommandLine(def CommandDefinition, cmdLine string) string {
+	// The exit status of cmdLine is sent through fd 4, as the exit status of the pipeline is the one of the last tee process
+	return fmt.Sprintf(": > %[1]s && if %[2]s; then status=$( { { { %[3]s 2>&1 1>&3 3>&- 4>&-; echo $? >&4; } | tee -a %[1]s 1>>/proc/1/fd/2; } 3>&1 | tee -a %[1]s 1>>/proc/1/fd/1; } 4>&1 ) && exit $status; else { %[3]s; } 1>>/proc/1/fd/1 2>>/proc/1/fd/2; fi",
+		getOutputFileForCommand(def), teeAvailableCheck, cmdLine)
+}
+
+// shellQuote returns s quoted for being passed as a single argument in a shell command-line
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// getWorkingDirAndEnvCommands returns the shell commands changing to the working directory and exporting the environment variables of the command
+func getWorkingDirAndEnvCommands(def CommandDefinition) (cdCmd string, setEnvCmd string) {
+	// deal with environment variables
+	envCommands := make([]string, 0, len(def.EnvVars))
+	for _, envVar := range def.EnvVars {
+		envCommands = append(envCommands, fmt.Sprintf("%s='%s'", envVar.Key, envVar.Value))
+	}
+	if len(envCommands) != 0 {
+		setEnvCmd = fmt.Sprintf("export %s &&", strings.Join(envCommands, " "))
+	}
+
+	if def.WorkingDir != "" {
+		// Change to the workdir and execute the command
+		cdCmd = fmt.Sprintf("cd %s &&", def.WorkingDir)
+	}
+	return cdCmd, setEnvCmd
+}
+
+// getKillCommand returns the shell command sending the signal to the process, or the default signal of kill if signal is empty
+func getKillCommand(pid int, signal string) string {
+	if signal == "" {
+		return fmt.Sprintf("kill %d || true", pid)
+	}
+	return fmt.Sprintf("kill -s %s %d || true", signal, pid)
+}
+
+// getStopSchedule returns the delays between the checks of the state of a stopping process, with a total duration of timeout
+func getStopSchedule(timeout time.Duration) []time.Duration {
+	var schedule []time.Duration
+	delay := 2 * time.Second
+	for remaining := timeout; remaining > 0; {
+		if delay > remaining {
+			delay = remaining
+		}
+		schedule = append(schedule, delay)
+		remaining -= delay
+		delay *= 2
+	}
+	return schedule
+}
@@ -126,8 +126,9 @@ func Test_kubeExecProcessHandler_GetProcessInfoForCommand(t *testing.T) {
 					})
 			},
 			want: RemoteProcessInfo{
-				Pid:    123,
-				Status: Errored,
+				Pid:      123,
+				Status:   Errored,
+				ExitCode: 1,
 			},
 		},
 		{
@@ -212,8 +213,8 @@ func Test_kubeExecProcessHandler_StartProcessForCommand(t *testing.T) {
 			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
 				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
 					gomock.Eq([]string{ShellExecutable, "-c",
-						fmt.Sprintf("echo $$ > %[1]s &&   (%s) 1>>/proc/1/fd/1 2>>/proc/1/fd/2; echo $? >> %[1]s",
-							getPidFileForCommand(execCmdWithoutWorkingDir), execCmdWithoutWorkingDir.CmdLine)}),
+						fmt.Sprintf(": > %[1]s && if command -v tee >/dev/null 2>&1; then { { /bin/sh -c 'echo $$ > %[2]s &&   (%[3]s) 2>&1 1>&3 3>&-; echo $? >> %[2]s' | tee -a %[1]s 1>>/proc/1/fd/2; } 3>&1 | tee -a %[1]s 1>>/proc/1/fd/1; }; else /bin/sh -c 'echo $$ > %[2]s &&   (%[3]s) 1>>/proc/1/fd/1 2>>/proc/1/fd/2; echo $? >> %[2]s'; fi",
+							"/opt/astra/.astra_cmd_my-exec-cmd.log", "/opt/astra/.astra_cmd_my-exec-cmd.pid", execCmdWithoutWorkingDir.CmdLine)}),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
 						_, err := stdout.Write([]byte("Hello"))
@@ -242,8 +243,8 @@ func Test_kubeExecProcessHandler_StartProcessForCommand(t *testing.T) {
 			kubeClientCustomizer: func(kclient *kclient.MockClientInterface) {
 				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
 					gomock.Eq([]string{ShellExecutable, "-c",
-						fmt.Sprintf("echo $$ > %[1]s && cd %s && export ENV_VAR1='value1' ENV_VAR2='value2' && (%s) 1>>/proc/1/fd/1 2>>/proc/1/fd/2; echo $? >> %[1]s",
-							getPidFileForCommand(fullExecCmd), fullExecCmd.WorkingDir, fullExecCmd.CmdLine)}),
+						fmt.Sprintf(": > %[1]s && if command -v tee >/dev/null 2>&1; then { { /bin/sh -c 'echo $$ > %[2]s && cd %[3]s && export ENV_VAR1='\\''value1'\\'' ENV_VAR2='\\''value2'\\'' && (%[4]s) 2>&1 1>&3 3>&-; echo $? >> %[2]s' | tee -a %[1]s 1>>/proc/1/fd/2; } 3>&1 | tee -a %[1]s 1>>/proc/1/fd/1; }; else /bin/sh -c 'echo $$ > %[2]s && cd %[3]s && export ENV_VAR1='\\''value1'\\'' ENV_VAR2='\\''value2'\\'' && (%[4]s) 1>>/proc/1/fd/1 2>>/proc/1/fd/2; echo $? >> %[2]s'; fi",
+							"/opt/astra/.astra_cmd_my-exec-cmd.log", "/opt/astra/.astra_cmd_my-exec-cmd.pid", fullExecCmd.WorkingDir, fullExecCmd.CmdLine)}),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("error while running command"))
 				kclient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
@@ -487,6 +488,208 @@ func Test_kubeExecProcessHandler_StopProcessForCommand(t *testing.T) {
 	}
 }
 
+func Test_kubeExecProcessHandler_StopProcessForCommand_WithStopOptions(t *testing.T) {
+	cmdDef := CommandDefinition{
+		Id:             "my-run",
+		WorkingDir:     "/projects",
+		StopSignal:     "INT",
+		StopTimeout:    10 * time.Millisecond,
+		PreStopCmdLine: "./cleanup.sh",
+	}
+	execCmd := func(cmd string) []string {
+		return []string{ShellExecutable, "-c", cmd}
+	}
+	output := func(out string) func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+		return func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+			_, err := stdout.Write([]byte(out))
+			return err
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd(fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef)))),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(output("500"))
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd("cat /proc/*/stat || true")),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(output(statFile))
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd(fmt.Sprintf("rm -f %s", getPidFileForCommand(cmdDef)))),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+	gomock.InOrder(
+		// the process is running, so the pre-stop command is executed
+		kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd("kill -0 500; echo $?")),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(output("0")),
+		kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd("cd /projects &&  (./cleanup.sh)")),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil),
+		// the process ignores the stop signal
+		kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd("kill -s INT 500 || true")),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil),
+		kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd("kill -0 500; echo $?")),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(output("0")),
+		// and is killed after the stop timeout
+		kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd("kill -s KILL 500 || true")),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil),
+		kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd("kill -0 500; echo $?")),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(output("1")),
+	)
+
+	k := NewKubeExecProcessHandler(exec.NewExecClient(kubeClient))
+	err := k.StopProcessForCommand(context.Background(), cmdDef, _podName, _containerName)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func Test_kubeExecProcessHandler_StopProcessForCommand_PreStopNotExecutedIfNotRunning(t *testing.T) {
+	cmdDef := CommandDefinition{
+		Id:             "my-run",
+		StopTimeout:    10 * time.Millisecond,
+		PreStopCmdLine: "./cleanup.sh",
+	}
+	execCmd := func(cmd string) []string {
+		return []string{ShellExecutable, "-c", cmd}
+	}
+	output := func(out string) func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+		return func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+			_, err := stdout.Write([]byte(out))
+			return err
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	// the PID file of the exited process is still present
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd(fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef)))),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(output("500\n0"))
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd("kill -0 500; echo $?")),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(output("1")).Times(2)
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd("cat /proc/*/stat || true")),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(output(statFile))
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd(fmt.Sprintf("rm -f %s", getPidFileForCommand(cmdDef)))),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd("kill 500 || true")),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	k := NewKubeExecProcessHandler(exec.NewExecClient(kubeClient))
+	err := k.StopProcessForCommand(context.Background(), cmdDef, _podName, _containerName)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func Test_kubeExecProcessHandler_StopProcessForCommand_SharedTimeout(t *testing.T) {
+	cmdDef := CommandDefinition{
+		Id:          "my-run",
+		StopTimeout: 10 * time.Millisecond,
+	}
+	execCmd := func(cmd string) []string {
+		return []string{ShellExecutable, "-c", cmd}
+	}
+	output := func(out string) func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+		return func(ctx context.Context, containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+			_, err := stdout.Write([]byte(out))
+			return err
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd(fmt.Sprintf("cat %s || true", getPidFileForCommand(cmdDef)))),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(output("81"))
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd("cat /proc/*/stat || true")),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(output(statFile))
+	kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+		gomock.Eq(execCmd(fmt.Sprintf("rm -f %s", getPidFileForCommand(cmdDef)))),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+	children := []int{333, 334, 222, 223, 87}
+	var calls []*gomock.Call
+	// all the children are signaled before waiting for any of them
+	for _, p := range children {
+		calls = append(calls, kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd(fmt.Sprintf("kill %d || true", p))),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil))
+	}
+	for _, p := range children {
+		calls = append(calls, kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd(fmt.Sprintf("kill -0 %d; echo $?", p))),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(output("1")))
+	}
+	calls = append(calls,
+		kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd("kill 81 || true")),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil),
+		kubeClient.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Eq(_containerName), gomock.Eq(_podName),
+			gomock.Eq(execCmd("kill -0 81; echo $?")),
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(output("1")),
+	)
+	gomock.InOrder(calls...)
+
+	k := NewKubeExecProcessHandler(exec.NewExecClient(kubeClient))
+	start := time.Now()
+	err := k.StopProcessForCommand(context.Background(), cmdDef, _podName, _containerName)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	// the processes are stopped within the single stop timeout, and not one stop timeout per process
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("stopping the processes took %s, expected less than the stop timeout of each process cumulated", elapsed)
+	}
+}
+
+func Test_getStopSchedule(t *testing.T) {
+	for _, tt := range []struct {
+		timeout time.Duration
+		want    []time.Duration
+	}{
+		{timeout: DefaultStopTimeout, want: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second}},
+		{timeout: 30 * time.Second, want: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second}},
+		{timeout: 5 * time.Second, want: []time.Duration{2 * time.Second, 3 * time.Second}},
+		{timeout: time.Second, want: []time.Duration{time.Second}},
+	} {
+		t.Run(tt.timeout.String(), func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, getStopSchedule(tt.timeout)); diff != "" {
+				t.Errorf("getStopSchedule() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_kubeExecProcessHandler_getProcessInfoFromPid(t *testing.T) {
 	cmdProvider := func(p int) []string {
 		return []string{ShellExecutable, "-c", fmt.Sprintf("kill -0 %d; echo $?", p)}
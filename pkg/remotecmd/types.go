@@ -1,5 +1,10 @@
 package remotecmd
 
+import (
+	"fmt"
+	"time"
+)
+
 // RemoteProcessStatus is an enum type for representing process statuses.
 type RemoteProcessStatus string
 
@@ -23,6 +28,17 @@ const (
 const (
 	// ShellExecutable is the shell executable
 	ShellExecutable = "/bin/sh"
+
+	// DefaultStopSignal is the signal sent to stop a process, when no signal is defined for its command
+	DefaultStopSignal = "TERM"
+
+	// DefaultStopTimeout is the duration to wait for a process to exit after the stop signal, before killing it,
+	// when no timeout is defined for its command
+	DefaultStopTimeout = 14 * time.Second
+
+	// teeAvailableCheck is the shell condition checking that tee is available in the container,
+	// to capture the output of a command in its output file
+	teeAvailableCheck = "command -v tee >/dev/null 2>&1"
 )
 
 // RemoteProcessInfo represents a given remote process linked to a given Devfile command
@@ -32,6 +48,9 @@ type RemoteProcessInfo struct {
 
 	// Status of the process
 	Status RemoteProcessStatus
+
+	// ExitCode is the last known exit code of the process, meaningful only if the process is not running
+	ExitCode int
 }
 
 // CommandDefinition represents the structure of any given command that would be handled by implementations of RemoteProcessHandler.
@@ -51,6 +70,17 @@ type CommandDefinition struct {
 
 	// CmdLine is the command-line that will get executed.
 	CmdLine string
+
+	// StopSignal is the name of the signal sent to stop the process, without the SIG prefix (e.g. INT).
+	// DefaultStopSignal is used if empty.
+	StopSignal string
+
+	// StopTimeout is the duration to wait for the process to exit after the stop signal, before killing it with SIGKILL.
+	// DefaultStopTimeout is used if zero.
+	StopTimeout time.Duration
+
+	// PreStopCmdLine is a command-line executed in the working directory before sending the stop signal, if not empty.
+	PreStopCmdLine string
 }
 
 // CommandEnvVar represents an environment variable used as part of running any CommandDefinition.
@@ -65,3 +95,18 @@ type CommandEnvVar struct {
 
 // CommandOutputHandler is a function that is expected to handle the output and error returned by a command executed.
 type CommandOutputHandler func(status RemoteProcessStatus, stdout []string, stderr []string, err error)
+
+// ExitError is the error passed to the CommandOutputHandler when a process started by StartProcessForCommand
+// has exited with a non-zero status code.
+type ExitError struct {
+	ExitCode int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("process exited with status %d", e.ExitCode)
+}
+
+// ExitStatus returns the exit code of the process
+func (e *ExitError) ExitStatus() int {
+	return e.ExitCode
+}
@@ -0,0 +1,3 @@
+// Package secrets resolves the values of Devfile variables referencing secrets, as {{secret:<provider>:<reference>}},
+// from the environment, local files, the OS keyring or external commands
+package secrets
@@ -0,0 +1,93 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+// envProvider gets the value of a secret from the environment variable named ref
+type envProvider struct{}
+
+func (o envProvider) Get(_ context.Context, ref string) (string, error) {
+	value, found := os.LookupEnv(ref)
+	if !found {
+		return "", fmt.Errorf("environment variable %q is not defined", ref)
+	}
+	return value, nil
+}
+
+// fileProvider gets the value of a secret from the content of the local file ref, without its trailing newline
+type fileProvider struct{}
+
+func (o fileProvider) Get(_ context.Context, ref string) (string, error) {
+	path := ref
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// keyringProvider gets the value of a secret from the OS keyring, for a ref in the form <service>/<user>
+type keyringProvider struct{}
+
+func (o keyringProvider) Get(_ context.Context, ref string) (string, error) {
+	i := strings.LastIndex(ref, "/")
+	if i <= 0 || i == len(ref)-1 {
+		return "", fmt.Errorf("invalid keyring reference %q, expected <service>/<user>", ref)
+	}
+	return keyring.Get(ref[:i], ref[i+1:])
+}
+
+// execProvider gets the value of a secret from the standard output of a command, executed with ref as last argument
+type execProvider struct {
+	command []string
+}
+
+// NewExecProvider returns a provider getting the value of a secret from the standard output of command,
+// executed with the reference of the secret as last argument
+func NewExecProvider(command []string) Provider {
+	return execProvider{command: command}
+}
+
+func (o execProvider) Get(ctx context.Context, ref string) (string, error) {
+	args := append(append([]string{}, o.command[1:]...), ref)
+	out, err := exec.CommandContext(ctx, o.command[0], args...).Output() // #nosec G204
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) != 0 {
+			return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", err
+	}
+	return strings.TrimRight(string(out), "\r\n"), nil
+}
+
+// RegisterExecProviders registers the exec providers defined in the form <name>=<command line>.
+// The values of the secrets are read from the standard output of the command line, executed with the reference of the secret as last argument.
+func (o *Resolver) RegisterExecProviders(definitions []string) error {
+	for _, definition := range definitions {
+		name, commandLine, _ := strings.Cut(definition, "=")
+		name = strings.TrimSpace(name)
+		command := strings.Fields(commandLine)
+		if name == "" || len(command) == 0 {
+			return fmt.Errorf("invalid secret provider definition %q, expected <name>=<command line>", definition)
+		}
+		o.Register(name, NewExecProvider(command))
+	}
+	return nil
+}
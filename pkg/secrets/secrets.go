@@ -0,0 +1,74 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github\.com/danielpickens/astra/pkg/log"
+)
+
+// ReferencePrefix is the prefix of the names of the Devfile variables referencing a secret,
+// in the form secret:<provider>:<reference>
+const ReferencePrefix = "secret:"
+
+// Provider gets the values of secrets from a specific source
+type Provider interface {
+	// Get returns the value of the secret designated by ref
+	Get(ctx context.Context, ref string) (string, error)
+}
+
+// Resolver resolves references to secrets using the registered providers
+type Resolver struct {
+	providers map[string]Provider
+}
+
+// NewResolver returns a Resolver with the env, file and keyring providers registered
+func NewResolver() *Resolver {
+	return &Resolver{
+		providers: map[string]Provider{
+			"env":     envProvider{},
+			"file":    fileProvider{},
+			"keyring": keyringProvider{},
+		},
+	}
+}
+
+// Register registers the provider under name, replacing any provider already registered with this name
+func (o *Resolver) Register(name string, provider Provider) {
+	o.providers[name] = provider
+}
+
+// IsReference returns true if the name of the variable references a secret
+func IsReference(variable string) bool {
+	return strings.HasPrefix(variable, ReferencePrefix)
+}
+
+// Resolve returns the value of the secret referenced by the name of the variable, in the form secret:<provider>:<reference>.
+// The value is registered to be redacted from the outputs of astra.
+func (o *Resolver) Resolve(ctx context.Context, variable string) (string, error) {
+	name, ref, found := strings.Cut(strings.TrimPrefix(variable, ReferencePrefix), ":")
+	if !IsReference(variable) || !found || name == "" || ref == "" {
+		return "", fmt.Errorf("invalid secret reference %q, expected secret:<provider>:<reference>", variable)
+	}
+	provider, ok := o.providers[name]
+	if !ok {
+		return "", fmt.Errorf("unknown secret provider %q in %q, available providers are: %s", name, variable, strings.Join(o.names(), ", "))
+	}
+	value, err := provider.Get(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the value of secret %q: %w", variable, err)
+	}
+	log.AddSecret(value)
+	return value, nil
+}
+
+func (o *Resolver) names() []string {
+	names := make([]string, 0, len(o.providers))
+	for name := range o.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
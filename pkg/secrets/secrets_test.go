@@ -0,0 +1,89 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github\.com/danielpickens/astra/pkg/log"
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	t.Setenv("ASTRA_TEST_TOKEN", "env-token")
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		providers []string
+		variable  string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:     "environment variable",
+			variable: "secret:env:ASTRA_TEST_TOKEN",
+			want:     "env-token",
+		},
+		{
+			name:     "undefined environment variable",
+			variable: "secret:env:ASTRA_TEST_UNDEFINED",
+			wantErr:  true,
+		},
+		{
+			name:     "file without its trailing newline",
+			variable: "secret:file:" + tokenFile,
+			want:     "file-token",
+		},
+		{
+			name:      "exec provider",
+			providers: []string{"vault=echo value-of"},
+			variable:  "secret:vault:db/password",
+			want:      "value-of db/password",
+		},
+		{
+			name:     "invalid keyring reference",
+			variable: "secret:keyring:myapp",
+			wantErr:  true,
+		},
+		{
+			name:     "unknown provider",
+			variable: "secret:vault:db/password",
+			wantErr:  true,
+		},
+		{
+			name:     "missing reference",
+			variable: "secret:env",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := NewResolver()
+			if err := resolver.RegisterExecProviders(tt.providers); err != nil {
+				t.Fatalf("RegisterExecProviders() unexpected error: %v", err)
+			}
+			got, err := resolver.Resolve(context.Background(), tt.variable)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+			if got != "" && log.Redact("token: "+got) != "token: ******" {
+				t.Errorf("the value of the secret is not redacted: %q", log.Redact("token: "+got))
+			}
+		})
+	}
+}
+
+func TestResolver_RegisterExecProviders(t *testing.T) {
+	for _, definition := range []string{"vault", "=vault read", "vault=  "} {
+		if err := NewResolver().RegisterExecProviders([]string{definition}); err == nil {
+			t.Errorf("RegisterExecProviders(%q) expected an error", definition)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package segment
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	scontext "github\.com/danielpickens/astra/pkg/segment/context"
+)
+
+// Record is a line of the file written by the file sink
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	TelemetryData
+}
+
+// FileSink writes the telemetry data to a local JSON Lines file, one Record per line.
+// The file is rotated when its size exceeds maxSize, keeping at most maxBackups previous files,
+// named after the file with the suffixes .1, .2, etc.
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+}
+
+// NewFileSink returns a sink writing to the file at path
+func NewFileSink(path string, maxSize int64, maxBackups int) *FileSink {
+	return &FileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+}
+
+// Upload appends the data to the file, if the user has consented for telemetry
+func (o *FileSink) Upload(ctx context.Context, data TelemetryData) error {
+	if !scontext.GetTelemetryStatus(ctx) {
+		return nil
+	}
+	line, err := json.Marshal(Record{
+		Timestamp:     time.Now().UTC(),
+		TelemetryData: sanitizeData(data),
+	})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if err = os.MkdirAll(filepath.Dir(o.path), 0o750); err != nil {
+		return err
+	}
+	if err = o.rotate(int64(len(line))); err != nil {
+		return fmt.Errorf("unable to rotate the telemetry file %q: %w", o.path, err)
+	}
+	f, err := os.OpenFile(o.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(line)
+	return err
+}
+
+// rotate renames the file if writing size more bytes would exceed the maximum size
+func (o *FileSink) rotate(size int64) error {
+	if o.maxSize <= 0 {
+		return nil
+	}
+	info, err := os.Stat(o.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 || info.Size()+size <= o.maxSize {
+		return nil
+	}
+	if o.maxBackups <= 0 {
+		return os.Remove(o.path)
+	}
+	for i := o.maxBackups - 1; i >= 1; i-- {
+		err = os.Rename(backupPath(o.path, i), backupPath(o.path, i+1))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return os.Rename(o.path, backupPath(o.path, 1))
+}
+
+// Close does nothing, as the data is written by Upload
+func (o *FileSink) Close() error {
+	return nil
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
+
+// ReadRecords returns the records of the file written by the file sink and of its backups, from the oldest to the newest.
+// The lines which cannot be parsed are ignored.
+func ReadRecords(path string) ([]Record, error) {
+	var paths []string
+	for i := 1; ; i++ {
+		if _, err := os.Stat(backupPath(path, i)); err != nil {
+			break
+		}
+		paths = append([]string{backupPath(path, i)}, paths...)
+	}
+	paths = append(paths, path)
+
+	var records []Record
+	for _, p := range paths {
+		fileRecords, err := readRecordsFile(p)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, fileRecords...)
+	}
+	return records, nil
+}
+
+func readRecordsFile(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var record Record
+		if err = json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// sanitizeData removes the internal properties and any PII(Personally Identifiable Information) from the data
+// before storing it outside of Segment
+func sanitizeData(data TelemetryData) TelemetryData {
+	properties := make(map[string]interface{}, len(data.Properties.CmdProperties))
+	for k, v := range data.Properties.CmdProperties {
+		if k != scontext.TelemetryStatus {
+			properties[k] = v
+		}
+	}
+	data.Properties.CmdProperties = properties
+	if data.Properties.Error != "" {
+		data.Properties.Error = sanitize(data.Properties.Error)
+	}
+	return data
+}
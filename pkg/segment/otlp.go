@@ -0,0 +1,83 @@
+package segment
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github\.com/danielpickens/astra/pkg/otlp"
+	scontext "github\.com/danielpickens/astra/pkg/segment/context"
+)
+
+// OTLPSink sends the telemetry data to an OpenTelemetry collector, as log records using OTLP over HTTP with the JSON encoding.
+// Each astra command is sent as a log record with attributes describing its duration and result,
+// from which the collector can derive metrics.
+type OTLPSink struct {
+	client *otlp.Client
+}
+
+// NewOTLPSink returns a sink sending the data to the collector at endpoint (for example http://collector:4318).
+// headers are additional HTTP headers in the form key=value, for example to authenticate to the collector.
+func NewOTLPSink(endpoint string, headers []string) (*OTLPSink, error) {
+	client, err := otlp.NewClient(endpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+	return &OTLPSink{
+		client: client,
+	}, nil
+}
+
+// Upload sends the data to the collector, if the user has consented for telemetry
+func (o *OTLPSink) Upload(ctx context.Context, data TelemetryData) error {
+	if !scontext.GetTelemetryStatus(ctx) {
+		return nil
+	}
+	return o.client.Send(ctx, otlp.LogsPath, newOTLPLogs(sanitizeData(data), time.Now()))
+}
+
+// Close does nothing, as the data is sent by Upload
+func (o *OTLPSink) Close() error {
+	return nil
+}
+
+// newOTLPLogs returns the log record describing the execution of the command
+func newOTLPLogs(data TelemetryData, timestamp time.Time) otlp.Logs {
+	attributes := []otlp.KeyValue{
+		otlp.String("astra.command", data.Event),
+		otlp.Int("astra.duration_ms", data.Properties.Duration),
+		otlp.Bool("astra.success", data.Properties.Success),
+		otlp.Bool("astra.tty", data.Properties.Tty),
+	}
+	severityNumber, severityText := otlp.SeverityInfo, "INFO"
+	if data.Properties.Error != "" {
+		severityNumber, severityText = otlp.SeverityError, "ERROR"
+		attributes = append(attributes,
+			otlp.String("error.type", data.Properties.ErrorType),
+			otlp.String("astra.error", data.Properties.Error))
+	}
+	names := make([]string, 0, len(data.Properties.CmdProperties))
+	for name := range data.Properties.CmdProperties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		attributes = append(attributes, otlp.Any("astra."+name, data.Properties.CmdProperties[name]))
+	}
+
+	return otlp.Logs{
+		ResourceLogs: []otlp.ResourceLogs{{
+			Resource: otlp.NewResource(data.Properties.Version),
+			ScopeLogs: []otlp.ScopeLogs{{
+				Scope: otlp.Scope{Name: TelemetryClient},
+				LogRecords: []otlp.LogRecord{{
+					TimeUnixNano:   otlp.UnixNano(timestamp),
+					SeverityNumber: severityNumber,
+					SeverityText:   severityText,
+					Body:           otlp.AnyValue{StringValue: &data.Event},
+					Attributes:     attributes,
+				}},
+			}},
+		}},
+	}
+}
@@ -142,21 +142,7 @@ func (c *Client) Upload(ctx context.Context, data TelemetryData) error {
 	}
 
 	// add information to the data
-	properties := analytics.NewProperties()
-	for k, v := range data.Properties.CmdProperties {
-		if k != scontext.TelemetryStatus {
-			properties = properties.Set(k, v)
-		}
-	}
-
-	properties = properties.Set("version", data.Properties.Version).
-		Set("success", data.Properties.Success).
-		Set("duration(ms)", data.Properties.Duration).
-		Set("tty", data.Properties.Tty)
-	// in case the command executed unsuccessfully, add information about the error in the data
-	if data.Properties.Error != "" {
-		properties = properties.Set("error", data.Properties.Error).Set("error-type", data.Properties.ErrorType)
-	}
+	properties := analytics.Properties(getProperties(data))
 
 	// send the Identify message data that helps identify the user on segment
 	err := c.SegmentClient.Enqueue(analytics.Identify{
@@ -178,6 +164,27 @@ func (c *Client) Upload(ctx context.Context, data TelemetryData) error {
 	})
 }
 
+// getProperties returns the properties of the telemetry data, as sent to the sinks
+func getProperties(data TelemetryData) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for k, v := range data.Properties.CmdProperties {
+		if k != scontext.TelemetryStatus {
+			properties[k] = v
+		}
+	}
+
+	properties["version"] = data.Properties.Version
+	properties["success"] = data.Properties.Success
+	properties["duration(ms)"] = data.Properties.Duration
+	properties["tty"] = data.Properties.Tty
+	// in case the command executed unsuccessfully, add information about the error in the data
+	if data.Properties.Error != "" {
+		properties["error"] = data.Properties.Error
+		properties["error-type"] = data.Properties.ErrorType
+	}
+	return properties
+}
+
 // addConfigTraits adds information about the system
 func addConfigTraits() analytics.Traits {
 	traits := analytics.NewTraits().Set("os", runtime.GOOS)
@@ -234,8 +241,11 @@ func SetError(err error) (errString string) {
 	if err == nil {
 		return ""
 	}
-	errString = err.Error()
+	return sanitize(err.Error())
+}
 
+// sanitize removes any PII(Personally Identifiable Information) from the error string
+func sanitize(errString string) string {
 	// Sanitize user information
 	errString = sanitizeUserInfo(errString)
 
@@ -271,6 +281,11 @@ func RunningInTerminal() bool {
 // IsTelemetryEnabled returns true if user has consented to telemetry
 func IsTelemetryEnabled(cfg preference.Client, envConfig config.Configuration) bool {
 	klog.V(4).Info("Checking telemetry enable status")
+	if envConfig.TelemetrySink == SinkNone {
+		klog.V(4).Infof("Sending telemetry disabled by %q env variable\n", TelemetrySinkEnv)
+		return false
+	}
+
 	// The env variable gets precedence in this decision.
 	// In case a non-bool value was passed to the env var, we ignore it
 
@@ -0,0 +1,80 @@
+package segment
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github\.com/danielpickens/astra/pkg/config"
+)
+
+// TelemetrySinkEnv is the name of the environment variable selecting where the telemetry data is sent
+const TelemetrySinkEnv = "astra_TELEMETRY_SINK"
+
+// Supported values of TelemetrySinkEnv
+const (
+	// SinkSegment sends the telemetry data to Segment
+	SinkSegment = "segment"
+	// SinkOTLP sends the telemetry data to an OpenTelemetry collector, using OTLP over HTTP
+	SinkOTLP = "otlp"
+	// SinkFile writes the telemetry data to a local JSON Lines file
+	SinkFile = "file"
+	// SinkNone disables the telemetry
+	SinkNone = "none"
+)
+
+// Sink receives the telemetry data of the astra commands
+type Sink interface {
+	// Upload sends the data, if the user has consented for telemetry
+	Upload(ctx context.Context, data TelemetryData) error
+	// Close flushes the pending data and releases the resources of the sink
+	Close() error
+}
+
+var (
+	_ Sink = (*Client)(nil)
+	_ Sink = (*OTLPSink)(nil)
+	_ Sink = (*FileSink)(nil)
+	_ Sink = noneSink{}
+)
+
+// NewSink returns the sink selected by the astra_TELEMETRY_SINK environment variable
+func NewSink(envConfig config.Configuration) (Sink, error) {
+	switch envConfig.TelemetrySink {
+	case SinkSegment, "":
+		return NewClient()
+	case SinkOTLP:
+		if envConfig.TelemetryOTLPEndpoint == "" {
+			return nil, fmt.Errorf("astra_TELEMETRY_OTLP_ENDPOINT must be set when %s is %q", TelemetrySinkEnv, SinkOTLP)
+		}
+		return NewOTLPSink(envConfig.TelemetryOTLPEndpoint, envConfig.TelemetryOTLPHeaders)
+	case SinkFile:
+		return NewFileSink(GetTelemetryDataFilePath(envConfig), int64(envConfig.TelemetryFileMaxSize)*1024*1024, envConfig.TelemetryFileMaxBackups), nil
+	case SinkNone:
+		return noneSink{}, nil
+	default:
+		return nil, fmt.Errorf("invalid value for %s: %q, must be one of %q, %q, %q or %q",
+			TelemetrySinkEnv, envConfig.TelemetrySink, SinkSegment, SinkOTLP, SinkFile, SinkNone)
+	}
+}
+
+// GetTelemetryDataFilePath returns the path of the file written by the file sink
+func GetTelemetryDataFilePath(envConfig config.Configuration) string {
+	if envConfig.TelemetryFile != "" {
+		return envConfig.TelemetryFile
+	}
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".astra", "telemetry.jsonl")
+}
+
+// noneSink discards the telemetry data
+type noneSink struct{}
+
+func (noneSink) Upload(ctx context.Context, data TelemetryData) error {
+	return nil
+}
+
+func (noneSink) Close() error {
+	return nil
+}
@@ -0,0 +1,169 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sethvargo/go-envconfig"
+
+	"github\.com/danielpickens/astra/pkg/config"
+	"github\.com/danielpickens/astra/pkg/otlp"
+	scontext "github\.com/danielpickens/astra/pkg/segment/context"
+)
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "telemetry.jsonl")
+	ctx := scontext.NewContext(context.Background())
+	scontext.SetTelemetryStatus(ctx, true)
+
+	data := fakeTelemetryData("astra dev", errors.New("unable to read /home/user/project/devfile.yaml"), ctx)
+	line, err := json.Marshal(Record{Timestamp: time.Now().UTC(), TelemetryData: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the file is rotated every 2 records, keeping 2 backups
+	sink := NewFileSink(path, int64(2*len(line)+len(line)/2), 2)
+	for i := 0; i < 7; i++ {
+		if err = sink.Upload(ctx, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, p := range []string{path, path + ".1", path + ".2"} {
+		if _, err = os.Stat(p); err != nil {
+			t.Errorf("expected file %q: %v", p, err)
+		}
+	}
+	if _, err = os.Stat(path + ".3"); err == nil {
+		t.Errorf("unexpected file %q", path+".3")
+	}
+
+	records, err := ReadRecords(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the first 2 records have been removed with the oldest backup
+	if len(records) != 5 {
+		t.Fatalf("got %d records, want 5", len(records))
+	}
+	for i, record := range records {
+		if record.Event != "astra dev" || record.Properties.Success {
+			t.Errorf("unexpected record %d: %+v", i, record)
+		}
+		if record.Properties.Error != "unable to read "+Sanitizer {
+			t.Errorf("record %d: error %q has not been sanitized", i, record.Properties.Error)
+		}
+		if _, found := record.Properties.CmdProperties[scontext.TelemetryStatus]; found {
+			t.Errorf("record %d: unexpected property %q", i, scontext.TelemetryStatus)
+		}
+		if i > 0 && record.Timestamp.Before(records[i-1].Timestamp) {
+			t.Errorf("record %d is older than the previous one", i)
+		}
+	}
+}
+
+func TestFileSinkWithoutConsent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "telemetry.jsonl")
+	ctx := scontext.NewContext(context.Background())
+	scontext.SetTelemetryStatus(ctx, false)
+
+	if err := NewFileSink(path, 0, 0).Upload(ctx, fakeTelemetryData("astra dev", nil, ctx)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Errorf("unexpected file %q", path)
+	}
+}
+
+func TestOTLPSink(t *testing.T) {
+	var (
+		logs          otlp.Logs
+		authorization string
+		path          string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		authorization = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&logs); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	ctx := scontext.NewContext(context.Background())
+	scontext.SetTelemetryStatus(ctx, true)
+	scontext.SetClusterType(ctx, nil)
+
+	sink, err := NewOTLPSink(server.URL+"/", []string{"Authorization=Bearer secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = sink.Upload(ctx, fakeTelemetryData("astra deploy", errors.New("some error occurred"), ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	if path != otlp.LogsPath {
+		t.Errorf("got path %q, want %q", path, otlp.LogsPath)
+	}
+	if authorization != "Bearer secret" {
+		t.Errorf("got Authorization header %q", authorization)
+	}
+	if len(logs.ResourceLogs) != 1 || len(logs.ResourceLogs[0].ScopeLogs) != 1 || len(logs.ResourceLogs[0].ScopeLogs[0].LogRecords) != 1 {
+		t.Fatalf("expected a single log record, got %+v", logs)
+	}
+	record := logs.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	if record.SeverityText != "ERROR" {
+		t.Errorf("got severity %q, want ERROR", record.SeverityText)
+	}
+	attributes := make(map[string]otlp.AnyValue)
+	for _, attribute := range record.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+	checkString := func(key, want string) {
+		if v := attributes[key].StringValue; v == nil || *v != want {
+			t.Errorf("attribute %q: got %v, want %q", key, v, want)
+		}
+	}
+	checkString("astra.command", "astra deploy")
+	checkString("error.type", "*errors.errorString")
+	checkString("astra.error", "some error occurred")
+	checkString("astra."+scontext.ClusterType, scontext.NOTFOUND)
+	if v := attributes["astra.duration_ms"].IntValue; v == nil || *v != "1000" {
+		t.Errorf("attribute astra.duration_ms: got %v, want 1000", v)
+	}
+	if v := attributes["astra.success"].BoolValue; v == nil || *v {
+		t.Errorf("attribute astra.success: got %v, want false", v)
+	}
+	if _, found := attributes["astra."+scontext.TelemetryStatus]; found {
+		t.Errorf("unexpected attribute %q", "astra."+scontext.TelemetryStatus)
+	}
+}
+
+func TestNewSink(t *testing.T) {
+	for _, sink := range []string{"", SinkSegment, SinkFile, SinkNone} {
+		if _, err := NewSink(newEnvConfig(t, map[string]string{TelemetrySinkEnv: sink})); err != nil {
+			t.Errorf("sink %q: unexpected error %v", sink, err)
+		}
+	}
+	if _, err := NewSink(newEnvConfig(t, map[string]string{TelemetrySinkEnv: SinkOTLP})); err == nil {
+		t.Errorf("expected an error for the otlp sink without endpoint")
+	}
+	if _, err := NewSink(newEnvConfig(t, map[string]string{TelemetrySinkEnv: "stdout"})); err == nil {
+		t.Errorf("expected an error for an unknown sink")
+	}
+}
+
+func newEnvConfig(t *testing.T, env map[string]string) config.Configuration {
+	envConfig, err := config.GetConfigurationWith(envconfig.MapLookuper(env))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *envConfig
+}
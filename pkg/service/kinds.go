@@ -0,0 +1,80 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"k8s.io/klog"
+
+	"github\.com/danielpickens/astra/pkg/api"
+	"github\.com/danielpickens/astra/pkg/kclient"
+)
+
+// ListOperatorBackedKinds returns the kinds of services provided by the Operators installed in the current namespace,
+// sorted by kind and group
+func ListOperatorBackedKinds(client kclient.ClientInterface) ([]api.OperatorBackedKind, error) {
+	csvSupported, err := client.IsCSVSupported()
+	if err != nil {
+		return nil, err
+	}
+	if !csvSupported {
+		return nil, nil
+	}
+
+	csvs, err := client.ListClusterServiceVersions()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list operators: %w", err)
+	}
+
+	var result []api.OperatorBackedKind
+	for _, csv := range csvs.Items {
+		clusterServiceVersion := csv
+		for _, cr := range *client.GetCustomResourcesFromCSV(&clusterServiceVersion) {
+			customResource := cr
+			gvr := kclient.GetGVRFromCR(&customResource)
+			result = append(result, api.OperatorBackedKind{
+				Operator:    csv.Name,
+				Group:       gvr.Group,
+				Version:     gvr.Version,
+				Kind:        cr.Kind,
+				DisplayName: cr.DisplayName,
+				Description: cr.Description,
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Kind != result[j].Kind {
+			return result[i].Kind < result[j].Kind
+		}
+		return result[i].Group < result[j].Group
+	})
+	return result, nil
+}
+
+// GetKindSpecSchema returns the OpenAPI schema of the spec of the resources of the kind.
+// The schema published by the cluster is used if available, otherwise it is built from the spec descriptors of the Operator.
+// A nil schema is returned if the spec is free-form, as it is for Operators based on Helm charts,
+// whose spec contains the values passed to the chart.
+func GetKindSpecSchema(client kclient.ClientInterface, kind api.OperatorBackedKind) (*spec.Schema, error) {
+	schema, err := client.GetResourceSpecDefinition(kind.Group, kind.Version, kind.Kind)
+	if err != nil {
+		klog.V(3).Infof("unable to get the definition of %s from the cluster: %v", kind, err)
+	}
+	if schema != nil && len(schema.Properties) > 0 {
+		return schema, nil
+	}
+
+	csv, err := client.GetCSVWithCR(kind.Kind)
+	if err != nil {
+		return nil, err
+	}
+	for _, cr := range *client.GetCustomResourcesFromCSV(csv) {
+		if cr.Kind == kind.Kind && cr.Version == kind.Version && strings.HasSuffix(cr.Name, "."+kind.Group) {
+			customResource := cr
+			return kclient.ToOpenAPISpec(&customResource), nil
+		}
+	}
+	return nil, nil
+}
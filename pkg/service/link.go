@@ -1,7 +1,10 @@
 package service
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/devfile/library/v2/pkg/devfile/generator"
 	appsv1 "k8s.io/api/apps/v1"
@@ -9,12 +12,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	authv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"k8s.io/klog"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github\.com/danielpickens/astra/pkg/kclient"
 	astralabels "github\.com/danielpickens/astra/pkg/labels"
+	"github\.com/danielpickens/astra/pkg/log"
 
 	sboApi "github.com/daniel-pickens/service-binding-operator/apis/binding/v1alpha1"
 	sboKubernetes "github.com/daniel-pickens/service-binding-operator/pkg/client/kubernetes"
@@ -22,8 +27,31 @@ import (
 	sboContext "github.com/daniel-pickens/service-binding-operator/pkg/reconcile/pipeline/context"
 )
 
+// ErrNotBindableWithoutOperator is returned when a ServiceBinding cannot be processed without the Service Binding Operator
+var ErrNotBindableWithoutOperator = errors.New("unable to bind without the Service Binding Operator")
+
 // pushLinksWithoutOperator creates links (if service binding operator is not installed) between components and services
 func pushLinksWithoutOperator(client kclient.ClientInterface, u unstructured.Unstructured, labels map[string]string) error {
+	// Obtain the component deployment to set as owner reference; this ensures the secret gets deleted when deployment does
+	deployment, err := client.GetOneDeploymentFromSelector(astralabels.GetSelector(astralabels.GetComponentName(labels), astralabels.GetAppName(labels), astralabels.ComponentAnyMode, true))
+	if err != nil {
+		return err
+	}
+	ownerReference := generator.GetOwnerReference(deployment)
+	err = BindWithoutOperator(client, u, labels, &ownerReference)
+	if errors.Is(err, ErrNotBindableWithoutOperator) {
+		// do not prevent the component from running, as the binding can be processed once the operator is installed
+		log.Warningf("%v", err)
+		return nil
+	}
+	return err
+}
+
+// BindWithoutOperator binds the workload and the service referenced by the ServiceBinding u using the
+// Service Binding library, when the Service Binding Operator is not installed.
+// The binding data is collected in a secret projected into the workload, and labelled so that the binding can be listed and described.
+// If ownerReference is not nil, it is set on the secret.
+func BindWithoutOperator(client kclient.ClientInterface, u unstructured.Unstructured, labels map[string]string, ownerReference *metav1.OwnerReference) error {
 
 	// check csv support before proceeding
 	csvSupport, err := client.IsCSVSupported()
@@ -54,18 +82,19 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, u unstructured.Uns
 	}
 
 	if len(serviceBinding.Spec.Services) != 1 {
-		klog.V(4).Infof("cannot create the link; serviceBinding.Spec.Services != 1; ServiceBinding: %v", serviceBinding)
-		return nil
+		return fmt.Errorf("%w: the ServiceBinding %q must reference exactly one service, %d found",
+			ErrNotBindableWithoutOperator, serviceBinding.Name, len(serviceBinding.Spec.Services))
 	}
 
-	if !csvSupport && !isLinkResource(serviceBinding.Spec.Services[0].Kind) {
-		// ignore service binding objects linked to services if csv support is not present on the cluster
-		klog.V(4).Infof("cannot create the link; CSV is not supported")
-		return nil
+	if !csvSupport && !isBindableWithoutOLM(client, serviceBinding.Spec.Services[0]) {
+		// operator backed services without binding hints can be bound only if csv support is present on the cluster
+		return fmt.Errorf("%w: the service %q of the ServiceBinding %q does not declare its binding data; add service.binding annotations to it, or install the Service Binding Operator",
+			ErrNotBindableWithoutOperator, serviceBinding.Spec.Services[0].Name, serviceBinding.Name)
 	}
 
 	// set the labels and namespace
-	serviceBinding.SetLabels(labels)
+	secretLabels := mergeMaps(labels)
+	serviceBinding.SetLabels(secretLabels)
 	serviceBinding.Namespace = client.GetCurrentNamespace()
 	ns := client.GetCurrentNamespace()
 	serviceBinding.Spec.Services[0].Namespace = &ns
@@ -94,7 +123,7 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, u unstructured.Uns
 	if err != nil {
 		return err
 	}
-	sbSecret.Labels = labels
+	sbSecret.Labels = secretLabels
 	sbSecret.Labels[LinkLabel] = serviceBinding.Name
 	if _, ok := serviceCompMap[serviceBinding.Spec.Services[0].Name]; ok {
 		sbSecret.Labels[ServiceLabel] = serviceCompMap[serviceBinding.Spec.Services[0].Name]
@@ -108,13 +137,23 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, u unstructured.Uns
 		sbSecret.Labels[ServiceLabel] = fmt.Sprintf("%v-%v", serviceBinding.Spec.Services[0].Kind, serviceBinding.Spec.Services[0].Name)
 	}
 
-	// Obtain the component deployment to set as owner reference; this ensures the secret gets deleted when deployment does
-	deployment, err := client.GetOneDeploymentFromSelector(astralabels.GetSelector(astralabels.GetComponentName(labels), astralabels.GetAppName(labels), astralabels.ComponentAnyMode, true))
+	// keep the spec of the binding, as there is no ServiceBinding resource in the cluster to describe it
+	apiBinding, err := kclient.APIServiceBindingFromBinding(serviceBinding)
+	if err != nil {
+		return err
+	}
+	spec, err := json.Marshal(apiBinding.Spec)
 	if err != nil {
 		return err
 	}
-	ownerReferences := generator.GetOwnerReference(deployment)
-	sbSecret.SetOwnerReferences([]metav1.OwnerReference{ownerReferences})
+	if sbSecret.Annotations == nil {
+		sbSecret.Annotations = map[string]string{}
+	}
+	sbSecret.Annotations[BindingSpecAnnotation] = string(spec)
+
+	if ownerReference != nil {
+		sbSecret.SetOwnerReferences([]metav1.OwnerReference{*ownerReference})
+	}
 
 	_, err = client.UpdateSecret(sbSecret, client.GetCurrentNamespace())
 	if err != nil {
@@ -124,6 +163,38 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, u unstructured.Uns
 	return nil
 }
 
+// isBindableWithoutOLM returns true if the service can be bound by the Service Binding library when OLM is not installed
+// on the cluster, that is if the service is a core resource (a Secret or a Service), or a custom resource
+// exposing binding hints
+func isBindableWithoutOLM(client kclient.ClientInterface, service sboApi.Service) bool {
+	if service.Group == "" {
+		return true
+	}
+	gvr, err := client.GetGVRFromGVK(schema.GroupVersionKind{Group: service.Group, Version: service.Version, Kind: service.Kind})
+	if err != nil {
+		klog.V(4).Infof("unable to get the resource for service %q: %v", service.Name, err)
+		return false
+	}
+	u, err := client.GetDynamicResource(gvr, service.Name)
+	if err != nil {
+		klog.V(4).Infof("unable to get service %q: %v", service.Name, err)
+		return false
+	}
+	return HasBindingHints(*u)
+}
+
+// HasBindingHints returns true if the resource declares the binding data it exposes, either with
+// service.binding annotations, or as a Provisioned Service referencing a secret in its status
+func HasBindingHints(u unstructured.Unstructured) bool {
+	for key := range u.GetAnnotations() {
+		if key == bindingAnnotationPrefix || strings.HasPrefix(key, bindingAnnotationPrefix+"/") {
+			return true
+		}
+	}
+	secretName, found, _ := unstructured.NestedString(u.Object, "status", "binding", "name")
+	return found && secretName != ""
+}
+
 // UnbindWithLibrary unbinds the component and service using the ServiceBinding library; it does not delete the secret
 func UnbindWithLibrary(kubeClient kclient.ClientInterface, secretToUnbind unstructured.Unstructured, deployment *appsv1.Deployment) error {
 	var processingPipeline sboPipeline.Pipeline
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+// Parameter is a parameter of the spec of a service, which can be set with a scalar value
+type Parameter struct {
+	// Path is the path of the field in the spec, using dots as separators (e.g. `storage.size`)
+	Path        string
+	Description string
+	Type        string
+	Enum        []string
+	// Required indicates that the field is required when its parent object is defined
+	Required bool
+}
+
+// ListParameters returns the scalar parameters defined in the schema of a spec, sorted by path.
+// The parameters of nested objects are returned with their full path.
+func ListParameters(schema *spec.Schema) []Parameter {
+	if isFreeForm(schema) {
+		return nil
+	}
+	var result []Parameter
+	listParameters(schema, "", &result)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result
+}
+
+func listParameters(schema *spec.Schema, prefix string, result *[]Parameter) {
+	required := map[string]bool{}
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	for name, property := range schema.Properties {
+		path := prefix + name
+		if len(property.Properties) > 0 {
+			prop := property
+			listParameters(&prop, path+".", result)
+			continue
+		}
+		if property.Type.Contains("object") || property.Type.Contains("array") {
+			// only scalar values can be set with parameters
+			continue
+		}
+		param := Parameter{
+			Path:        path,
+			Description: property.Description,
+			Required:    required[name],
+		}
+		if len(property.Type) > 0 {
+			param.Type = property.Type[0]
+		}
+		for _, v := range property.Enum {
+			param.Enum = append(param.Enum, fmt.Sprint(v))
+		}
+		*result = append(*result, param)
+	}
+}
+
+// ValidateParams validates the values of the parameters against the schema of the spec.
+// An error is returned if a parameter is not defined in the schema, if a value does not match the type of the field,
+// or if a required field is not set
+func ValidateParams(schema *spec.Schema, params map[string]string) error {
+	if isFreeForm(schema) {
+		return nil
+	}
+	var errs []string
+	for path, value := range params {
+		if err := validateParam(schema, path, path, value); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	errs = append(errs, missingRequiredParams(schema, "", params)...)
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// ValidateParam validates the value of a single parameter
+func ValidateParam(param Parameter, value string) error {
+	if value == "" {
+		if param.Required {
+			return fmt.Errorf("a value is required for parameter %q", param.Path)
+		}
+		return nil
+	}
+	prop := spec.Schema{}
+	if param.Type != "" {
+		prop.Type = spec.StringOrArray{param.Type}
+	}
+	for _, v := range param.Enum {
+		prop.Enum = append(prop.Enum, v)
+	}
+	return validateValue(prop, param.Path, value)
+}
+
+func validateParam(schema *spec.Schema, path string, name string, value string) error {
+	if isFreeForm(schema) {
+		return nil
+	}
+	property, rest, nested := strings.Cut(path, ".")
+	prop, found := schema.Properties[property]
+	if !found {
+		return fmt.Errorf("unknown parameter %q", name)
+	}
+	if nested {
+		if len(prop.Type) > 0 && !prop.Type.Contains("object") {
+			return fmt.Errorf("parameter %q is not valid, %q is not an object", name, strings.TrimSuffix(name, "."+rest))
+		}
+		return validateParam(&prop, rest, name, value)
+	}
+	if len(prop.Properties) > 0 || prop.Type.Contains("object") || prop.Type.Contains("array") {
+		return fmt.Errorf("parameter %q is not a scalar value, set the fields it contains instead", name)
+	}
+	return validateValue(prop, name, value)
+}
+
+func validateValue(prop spec.Schema, name string, value string) error {
+	if len(prop.Enum) > 0 {
+		var values []string
+		for _, v := range prop.Enum {
+			if fmt.Sprint(v) == value {
+				return nil
+			}
+			values = append(values, fmt.Sprint(v))
+		}
+		return fmt.Errorf("value %q is not valid for parameter %q. Possible values are: %s", value, name, strings.Join(values, ", "))
+	}
+	if len(prop.Type) == 0 || prop.Type.Contains("string") {
+		return nil
+	}
+	if prop.Type.Contains("integer") {
+		if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return nil
+		}
+	}
+	if prop.Type.Contains("number") {
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return nil
+		}
+	}
+	if prop.Type.Contains("boolean") {
+		if _, err := strconv.ParseBool(value); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("value %q is not valid for parameter %q, expected type is %s", value, name, strings.Join(prop.Type, " or "))
+}
+
+// missingRequiredParams returns an error message for each required field not set by the parameters.
+// The required fields of a nested object are checked only if the object is set
+func missingRequiredParams(schema *spec.Schema, prefix string, params map[string]string) []string {
+	var errs []string
+	for _, name := range schema.Required {
+		if !isSet(prefix+name, params) {
+			errs = append(errs, fmt.Sprintf("missing required parameter %q", prefix+name))
+		}
+	}
+	for name, property := range schema.Properties {
+		if len(property.Properties) == 0 || !isSet(prefix+name, params) {
+			continue
+		}
+		prop := property
+		errs = append(errs, missingRequiredParams(&prop, prefix+name+".", params)...)
+	}
+	return errs
+}
+
+// isSet returns true if a parameter sets the field at path, or one of its fields
+func isSet(path string, params map[string]string) bool {
+	for param := range params {
+		if param == path || strings.HasPrefix(param, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// isFreeForm returns true if any field can be set in the spec
+func isFreeForm(schema *spec.Schema) bool {
+	return schema == nil || len(schema.Properties) == 0
+}
+
+// BuildServiceFromParams validates the parameters against the schema of the spec,
+// and returns the definition of a service of the kind, with the name and the spec built from the parameters
+func BuildServiceFromParams(name string, kind api.OperatorBackedKind, schema *spec.Schema, params map[string]string) (unstructured.Unstructured, error) {
+	err := ValidateParams(schema, params)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	if isFreeForm(schema) {
+		// let BuildCRDFromParams guess the types of the values
+		schema = nil
+	}
+	crd, err := BuildCRDFromParams(params, schema, kind.Group, kind.Version, kind.Kind)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	u := unstructured.Unstructured{Object: crd}
+	u.SetName(name)
+	return u, nil
+}
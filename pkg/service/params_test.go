@@ -0,0 +1,163 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/api"
+)
+
+func getRedisSpecSchema() *spec.Schema {
+	storage := spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type:     []string{"object"},
+			Required: []string{"size"},
+			Properties: map[string]spec.Schema{
+				"size":  *spec.StringProperty().WithDescription("Size of the volume"),
+				"class": *spec.StringProperty(),
+			},
+		},
+	}
+	mode := *spec.StringProperty()
+	mode.Enum = []interface{}{"standalone", "cluster"}
+	return &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type:     []string{"object"},
+			Required: []string{"mode"},
+			Properties: map[string]spec.Schema{
+				"mode":     mode,
+				"replicas": *spec.Int32Property(),
+				"tls":      *spec.BoolProperty(),
+				"storage":  storage,
+				"labels":   *spec.MapProperty(spec.StringProperty()),
+			},
+		},
+	}
+}
+
+func TestListParameters(t *testing.T) {
+	got := ListParameters(getRedisSpecSchema())
+	want := []Parameter{
+		{Path: "mode", Type: "string", Enum: []string{"standalone", "cluster"}, Required: true},
+		{Path: "replicas", Type: "integer"},
+		{Path: "storage.class", Type: "string"},
+		{Path: "storage.size", Type: "string", Description: "Size of the volume", Required: true},
+		{Path: "tls", Type: "boolean"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListParameters() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got = ListParameters(nil); got != nil {
+		t.Errorf("ListParameters() expected no parameters for a free-form spec, got %v", got)
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  *spec.Schema
+		params  map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "valid parameters",
+			schema: getRedisSpecSchema(),
+			params: map[string]string{"mode": "cluster", "replicas": "3", "tls": "true", "storage.size": "1Gi"},
+		},
+		{
+			name:   "nested required field not checked if the object is not set",
+			schema: getRedisSpecSchema(),
+			params: map[string]string{"mode": "standalone"},
+		},
+		{
+			name:    "missing required field",
+			schema:  getRedisSpecSchema(),
+			params:  map[string]string{"replicas": "3"},
+			wantErr: true,
+		},
+		{
+			name:    "missing required nested field",
+			schema:  getRedisSpecSchema(),
+			params:  map[string]string{"mode": "cluster", "storage.class": "fast"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown parameter",
+			schema:  getRedisSpecSchema(),
+			params:  map[string]string{"mode": "cluster", "size": "1Gi"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid integer",
+			schema:  getRedisSpecSchema(),
+			params:  map[string]string{"mode": "cluster", "replicas": "three"},
+			wantErr: true,
+		},
+		{
+			name:    "value not in enum",
+			schema:  getRedisSpecSchema(),
+			params:  map[string]string{"mode": "sentinel"},
+			wantErr: true,
+		},
+		{
+			name:    "object set as a scalar",
+			schema:  getRedisSpecSchema(),
+			params:  map[string]string{"mode": "cluster", "storage": "1Gi"},
+			wantErr: true,
+		},
+		{
+			name:    "field of a scalar",
+			schema:  getRedisSpecSchema(),
+			params:  map[string]string{"mode.name": "cluster"},
+			wantErr: true,
+		},
+		{
+			name:   "free-form spec",
+			params: map[string]string{"image.tag": "7.0", "replicaCount": "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParams(tt.schema, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildServiceFromParams(t *testing.T) {
+	kind := api.OperatorBackedKind{
+		Group:   "redis.redis.opstreelabs.in",
+		Version: "v1beta1",
+		Kind:    "Redis",
+	}
+	got, err := BuildServiceFromParams("my-redis", kind, nil, map[string]string{"replicaCount": "2", "image.tag": "latest"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]interface{}{
+		"apiVersion": "redis.redis.opstreelabs.in/v1beta1",
+		"kind":       "Redis",
+		"metadata": map[string]interface{}{
+			"name": "my-redis",
+		},
+		"spec": map[string]interface{}{
+			"replicaCount": int64(2),
+			"image": map[string]interface{}{
+				"tag": "latest",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got.Object); diff != "" {
+		t.Errorf("BuildServiceFromParams() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = BuildServiceFromParams("my-redis", kind, getRedisSpecSchema(), map[string]string{"replicas": "2"})
+	if err == nil {
+		t.Errorf("BuildServiceFromParams() expected an error for invalid parameters")
+	}
+}
@@ -29,6 +29,13 @@ const ServiceLabel = "app.kubernetes.io/service-name"
 // ServiceKind is the kind of the service in the service binding object
 const ServiceKind = "app.kubernetes.io/service-kind"
 
+// BindingSpecAnnotation is the annotation of the secret created when binding without the operator,
+// containing the spec of the binding
+const BindingSpecAnnotation = "astra.dev/binding-spec"
+
+// bindingAnnotationPrefix is the prefix of the annotations declaring the binding data exposed by a service
+const bindingAnnotationPrefix = "service.binding"
+
 // IsLinkSecret helps in identifying if a secret is related to Service Binding
 func IsLinkSecret(labels map[string]string) bool {
 	_, hasLinkLabel := labels[LinkLabel]
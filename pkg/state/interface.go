@@ -22,8 +22,18 @@ type Client interface {
 	// SetAPIServerPort sets the port where API server is listening in the state file and saves it to the file, updating the metadata
 	SetAPIServerPort(ctx context.Context, port int) error
 
+	// SetAPIServerCredentials sets the token required by the API server and the fingerprint of its certificate (if TLS is enabled)
+	// in the state file and saves it to the file, updating the metadata
+	SetAPIServerCredentials(ctx context.Context, token string, certFingerprint string) error
+
 	// GetAPIServerPorts returns the port where the API servers are listening, possibly per platform.
 	GetAPIServerPorts(ctx context.Context) ([]api.DevControlPlane, error)
 
 	GetOrphanFiles(ctx context.Context) ([]string, error)
+
+	// GetDevSessions returns the astra dev sessions running in the given directory
+	GetDevSessions(ctx context.Context, directory string) ([]api.DevSession, error)
+
+	// PruneDevSessions removes the state files of the astra dev sessions of the given directory whose process does not exist anymore
+	PruneDevSessions(ctx context.Context, directory string) error
 }
@@ -93,6 +93,8 @@ func (o *State) SaveExit(ctx context.Context) error {
 	o.content.PID = 0
 	o.content.Platform = ""
 	o.content.APIServerPort = 0
+	o.content.APIServerToken = ""
+	o.content.APIServerCertFingerprint = ""
 	err := o.delete(pid)
 	if err != nil {
 		return err
@@ -111,6 +113,18 @@ func (o *State) SetAPIServerPort(ctx context.Context, port int) error {
 	return o.save(ctx, pid)
 }
 
+func (o *State) SetAPIServerCredentials(ctx context.Context, token string, certFingerprint string) error {
+	var (
+		pid      = astracontext.GetPID(ctx)
+		platform = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+
+	o.content.APIServerToken = token
+	o.content.APIServerCertFingerprint = certFingerprint
+	o.content.Platform = platform
+	return o.save(ctx, pid)
+}
+
 func (o *State) GetAPIServerPorts(ctx context.Context) ([]api.DevControlPlane, error) {
 	var (
 		result    []api.DevControlPlane
@@ -135,9 +149,12 @@ func (o *State) GetAPIServerPorts(ctx context.Context) ([]api.DevControlPlane, e
 			continue
 		}
 		controlPlane := api.DevControlPlane{
-			Platform:      platform,
-			LocalPort:     content.APIServerPort,
-			APIServerPath: "/api/v1/",
+			Platform:        platform,
+			LocalPort:       content.APIServerPort,
+			APIServerPath:   "/api/v1/",
+			Secure:          content.APIServerCertFingerprint != "",
+			Token:           content.APIServerToken,
+			CertFingerprint: content.APIServerCertFingerprint,
 		}
 		if feature.IsEnabled(ctx, feature.UIServer) {
 			controlPlane.WebInterfacePath = "/"
@@ -174,7 +191,13 @@ func (o *State) writeStateFile(path string) error {
 	if err != nil {
 		return err
 	}
-	return o.fs.WriteFile(path, jsonContent, 0644)
+	// the file contains the token of the API server, it must not be readable by other users,
+	// including when it has been created by a previous version of astra with broader permissions
+	err = o.fs.WriteFile(path, jsonContent, 0600)
+	if err != nil {
+		return err
+	}
+	return o.fs.Chmod(filepath.Clean(path), 0600)
 }
 
 // read returns the content of the devstate.${PID}.json file for the given platform
@@ -391,6 +414,109 @@ func (o *State) GetOrphanFiles(ctx context.Context) ([]string, error) {
 	return result, nil
 }
 
+func (o *State) GetDevSessions(ctx context.Context, directory string) ([]api.DevSession, error) {
+	var result []api.DevSession
+
+	re := regexp.MustCompile(`^devstate\.[0-9]+\.json$`)
+	dirpath := filepath.Join(directory, _dirpath)
+	entries, err := o.fs.ReadDir(dirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// No file found => no session
+			return nil, nil
+		}
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !re.MatchString(entry.Name()) {
+			continue
+		}
+		jsonContent, err := o.fs.ReadFile(filepath.Join(dirpath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var content Content
+		// Ignore error, to handle empty file
+		_ = json.Unmarshal(jsonContent, &content)
+		if content.PID == 0 {
+			continue
+		}
+
+		exists, err := o.system.PidExists(content.PID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			klog.V(4).Infof("process %d does not exist, ignoring state file %q", content.PID, entry.Name())
+			continue
+		}
+
+		session := api.DevSession{
+			PID:       content.PID,
+			Directory: directory,
+			Platform:  content.Platform,
+		}
+		if content.APIServerPort != 0 {
+			session.APIServer = &api.DevControlPlane{
+				Platform:        content.Platform,
+				LocalPort:       content.APIServerPort,
+				APIServerPath:   "/api/v1/",
+				Secure:          content.APIServerCertFingerprint != "",
+				Token:           content.APIServerToken,
+				CertFingerprint: content.APIServerCertFingerprint,
+			}
+			if feature.IsEnabled(ctx, feature.UIServer) {
+				session.APIServer.WebInterfacePath = "/"
+			}
+		}
+		result = append(result, session)
+	}
+	return result, nil
+}
+
+func (o *State) PruneDevSessions(ctx context.Context, directory string) error {
+	re := regexp.MustCompile(`^devstate\.[0-9]+\.json$`)
+	dirpath := filepath.Join(directory, _dirpath)
+	entries, err := o.fs.ReadDir(dirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// No file found => no session
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if !re.MatchString(entry.Name()) {
+			continue
+		}
+		filename := filepath.Join(dirpath, entry.Name())
+		jsonContent, err := o.fs.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		var content Content
+		// Ignore error, to handle empty file
+		_ = json.Unmarshal(jsonContent, &content)
+		if content.PID == 0 {
+			continue
+		}
+
+		exists, err := o.system.PidExists(content.PID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		klog.V(4).Infof("process %d does not exist, removing state file %q", content.PID, filename)
+		err = o.fs.Remove(filename)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
 func getFullFilename(entry fs.FileInfo) (string, error) {
 	return filepath.Abs(filepath.Join(_dirpath, entry.Name()))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -11,6 +12,7 @@ import (
 	"github\.com/danielpickens/astra/pkg/api"
 	astracontext "github\.com/danielpickens/astra/pkg/astra/context"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
+	"github\.com/danielpickens/astra/pkg/testingutil/system"
 )
 
 func TestState_SetForwardedPorts(t *testing.T) {
@@ -281,3 +283,90 @@ func TestState_GetForwardedPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestState_GetDevSessions(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	writeContent := func(filename string, content Content) {
+		jsonContent, err := json.Marshal(content)
+		if err != nil {
+			t.Fatalf("Error marshaling data")
+		}
+		err = fs.WriteFile(filepath.Join("/project", _dirpath, filename), jsonContent, 0644)
+		if err != nil {
+			t.Fatalf("Error saving content to file")
+		}
+	}
+	writeContent("devstate.json", Content{PID: 0})
+	writeContent(fmt.Sprintf("devstate.%d.json", 1), Content{PID: 1, Platform: "cluster", APIServerPort: 20000, APIServerToken: "token"})
+	writeContent(fmt.Sprintf("devstate.%d.json", 2), Content{PID: 2, Platform: "podman"})
+	// process 3 does not exist anymore
+	writeContent(fmt.Sprintf("devstate.%d.json", 3), Content{PID: 3, Platform: "cluster"})
+
+	o := State{
+		fs: fs,
+		system: system.Fake{
+			PidTable: map[int]string{1: "astra", 2: "astra"},
+		},
+	}
+	got, err := o.GetDevSessions(context.Background(), "/project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []api.DevSession{
+		{
+			PID:       1,
+			Directory: "/project",
+			Platform:  "cluster",
+			APIServer: &api.DevControlPlane{
+				Platform:         "cluster",
+				LocalPort:        20000,
+				APIServerPath:    "/api/v1/",
+				WebInterfacePath: "/",
+				Token:            "token",
+			},
+		},
+		{
+			PID:       2,
+			Directory: "/project",
+			Platform:  "podman",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetDevSessions() mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err = o.GetDevSessions(context.Background(), "/other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no session, got %v", got)
+	}
+}
+
+func TestState_SetAPIServerCredentials_permissions(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	// state files written by a previous version of astra, readable by other users
+	for _, path := range []string{_filepath, getFilename(1)} {
+		if err := fs.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	o := State{
+		fs:     fs,
+		system: system.Fake{},
+	}
+	ctx := astracontext.WithPID(context.Background(), 1)
+	if err := o.SetAPIServerCredentials(ctx, "a-token", ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{_filepath, getFilename(1)} {
+		info, err := fs.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm := info.Mode().Perm(); perm != 0600 {
+			t.Errorf("permissions of %q are %o, want 600", path, perm)
+		}
+	}
+}
@@ -12,4 +12,9 @@ type Content struct {
 	// ForwardedPorts are the ports forwarded during astra dev session
 	ForwardedPorts []api.ForwardedPort `json:"forwardedPorts"`
 	APIServerPort  int                 `json:"apiServerPort,omitempty"`
+	// APIServerToken is the token required by the API server to modify the state of the session
+	APIServerToken string `json:"apiServerToken,omitempty"`
+	// APIServerCertFingerprint is the SHA-256 fingerprint of the self-signed certificate of the API server,
+	// empty if the API server does not use TLS
+	APIServerCertFingerprint string `json:"apiServerCertFingerprint,omitempty"`
 }
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github\.com/danielpickens/astra/pkg/otlp"
+)
+
+// scopeName is the name of the instrumentation scope of the spans
+const scopeName = "astra"
+
+// ToOTLP returns the spans in the OTLP format
+func ToOTLP(spans []*Span, traceID [16]byte, version string) otlp.Traces {
+	var result []otlp.Span
+	for _, span := range spans {
+		otlpSpan := otlp.Span{
+			TraceID:           hex.EncodeToString(traceID[:]),
+			SpanID:            hex.EncodeToString(span.id[:]),
+			Name:              span.name,
+			Kind:              otlp.SpanKindInternal,
+			StartTimeUnixNano: otlp.UnixNano(span.start),
+			EndTimeUnixNano:   otlp.UnixNano(span.end),
+		}
+		if span.parentID != ([8]byte{}) {
+			otlpSpan.ParentSpanID = hex.EncodeToString(span.parentID[:])
+		}
+		for _, attribute := range span.attributes {
+			otlpSpan.Attributes = append(otlpSpan.Attributes, otlp.Any(attribute.Key, attribute.Value))
+		}
+		if span.unfinished {
+			otlpSpan.Attributes = append(otlpSpan.Attributes, otlp.Bool("astra.unfinished", true))
+		}
+		if span.err != "" {
+			otlpSpan.Status = otlp.Status{Code: otlp.StatusCodeError, Message: span.err}
+		}
+		result = append(result, otlpSpan)
+	}
+	return otlp.Traces{
+		ResourceSpans: []otlp.ResourceSpans{{
+			Resource: otlp.NewResource(version),
+			ScopeSpans: []otlp.ScopeSpans{{
+				Scope: otlp.Scope{Name: scopeName},
+				Spans: result,
+			}},
+		}},
+	}
+}
+
+// TraceID returns the identifier of the trace recorded by the tracer
+func (o *Tracer) TraceID() [16]byte {
+	return o.traceID
+}
+
+// Send sends the traces to an OpenTelemetry collector
+func Send(ctx context.Context, client *otlp.Client, traces otlp.Traces) error {
+	return client.Send(ctx, otlp.TracesPath, traces)
+}
+
+// WriteFile appends the traces to the file, as a line of JSON in the OTLP format.
+// The file can be read by the OTLP JSON file receiver of the OpenTelemetry collector.
+func WriteFile(path string, traces otlp.Traces) error {
+	line, err := json.Marshal(traces)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(line)
+	return err
+}
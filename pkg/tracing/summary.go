@@ -0,0 +1,140 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// barWidth is the width of the bar representing the duration of a root span
+const barWidth = 30
+
+// summaryNode aggregates the spans with the same name and the same parent operations
+type summaryNode struct {
+	name     string
+	duration time.Duration
+	count    int
+	failures int
+	children []*summaryNode
+}
+
+func (o *summaryNode) child(name string) *summaryNode {
+	for _, c := range o.children {
+		if c.name == name {
+			return c
+		}
+	}
+	c := &summaryNode{name: name}
+	o.children = append(o.children, c)
+	return c
+}
+
+// getSummaryTree returns the tree of the operations, aggregating the spans with the same name and the same parent operations.
+// The children of a node are ordered by the start time of their first span.
+func getSummaryTree(spans []*Span) *summaryNode {
+	root := &summaryNode{}
+	nodes := make(map[[8]byte]*summaryNode, len(spans))
+	for _, span := range spans {
+		parent, ok := nodes[span.parentID]
+		if !ok {
+			parent = root
+		}
+		node := parent.child(span.name)
+		node.duration += span.Duration()
+		node.count++
+		if span.err != "" {
+			node.failures++
+		}
+		nodes[span.id] = node
+	}
+	return root
+}
+
+// summaryRow is a line of the summary
+type summaryRow struct {
+	name     string
+	duration string
+	percent  string
+	bar      string
+}
+
+// PrintSummary displays the time spent in each operation, as a tree of operations
+// with bars proportional to their duration relative to the whole command
+func PrintSummary(w io.Writer, spans []*Span, traceID [16]byte) error {
+	root := getSummaryTree(spans)
+	if len(root.children) == 0 {
+		return nil
+	}
+	var rows []summaryRow
+	for _, top := range root.children {
+		rows = appendRows(rows, top, 0, top.duration)
+	}
+	var nameWidth, durationWidth, percentWidth int
+	for _, row := range rows {
+		nameWidth = max(nameWidth, utf8.RuneCountInString(row.name))
+		durationWidth = max(durationWidth, len(row.duration))
+		percentWidth = max(percentWidth, len(row.percent))
+	}
+
+	if _, err := fmt.Fprintf(w, "\nTrace %s:\n", hex.EncodeToString(traceID[:])); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		padding := strings.Repeat(" ", nameWidth-utf8.RuneCountInString(row.name))
+		_, err := fmt.Fprintf(w, "  %s%s  %*s  %*s  %s\n", row.name, padding, durationWidth, row.duration, percentWidth, row.percent, row.bar)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendRows(rows []summaryRow, node *summaryNode, depth int, total time.Duration) []summaryRow {
+	name := strings.Repeat("  ", depth) + node.name
+	if node.count > 1 {
+		name += fmt.Sprintf(" (x%d)", node.count)
+	}
+	if node.failures > 0 {
+		name += fmt.Sprintf(" [%d failed]", node.failures)
+	}
+	var ratio float64
+	if total > 0 {
+		ratio = float64(node.duration) / float64(total)
+	}
+	rows = append(rows, summaryRow{
+		name:     name,
+		duration: formatDuration(node.duration),
+		percent:  fmt.Sprintf("%.1f%%", 100*ratio),
+		bar:      bar(ratio),
+	})
+	for _, child := range node.children {
+		rows = appendRows(rows, child, depth+1, total)
+	}
+	return rows
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func bar(ratio float64) string {
+	n := int(math.Round(math.Min(ratio, 1) * barWidth))
+	if n == 0 && ratio > 0 {
+		return "▏"
+	}
+	return strings.Repeat("█", n)
+}
+
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(100 * time.Millisecond).String()
+}
@@ -0,0 +1,147 @@
+// Package tracing records spans describing the time spent by astra in its operations
+// (Devfile parsing, image builds, resources creation, files synchronization, commands execution, etc.).
+//
+// The spans are recorded only if a Tracer has been added to the context with WithTracer;
+// otherwise Start returns a nil Span, on which all the methods are no-ops.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+)
+
+type (
+	tracerKeyType struct{}
+	spanKeyType   struct{}
+)
+
+var (
+	tracerKey tracerKeyType
+	spanKey   spanKeyType
+)
+
+// Tracer records the spans of a single trace
+type Tracer struct {
+	lock    sync.Mutex
+	traceID [16]byte
+	spans   []*Span
+}
+
+// NewTracer returns a tracer recording the spans of a new trace
+func NewTracer() *Tracer {
+	result := &Tracer{}
+	_, _ = rand.Read(result.traceID[:])
+	return result
+}
+
+// WithTracer returns a context in which the spans are recorded by tracer
+func WithTracer(ctx context.Context, tracer *Tracer) context.Context {
+	return context.WithValue(ctx, tracerKey, tracer)
+}
+
+// Span is an operation of astra
+type Span struct {
+	tracer     *Tracer
+	id         [8]byte
+	parentID   [8]byte
+	name       string
+	start      time.Time
+	end        time.Time
+	attributes []Attribute
+	err        string
+	// unfinished is true if the span has not been ended before the end of the trace
+	unfinished bool
+}
+
+// Attribute describes a span
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String returns a string attribute
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int returns an integer attribute
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Bool returns a boolean attribute
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Start starts a span as a child of the span of ctx, and returns a context containing the new span.
+// It returns ctx and a nil span if no tracer has been added to ctx.
+func Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, *Span) {
+	tracer, ok := ctx.Value(tracerKey).(*Tracer)
+	if !ok || tracer == nil {
+		return ctx, nil
+	}
+	span := &Span{
+		tracer:     tracer,
+		name:       name,
+		start:      time.Now(),
+		attributes: attributes,
+	}
+	_, _ = rand.Read(span.id[:])
+	if parent, ok := ctx.Value(spanKey).(*Span); ok && parent != nil {
+		span.parentID = parent.id
+	}
+
+	tracer.lock.Lock()
+	tracer.spans = append(tracer.spans, span)
+	tracer.lock.Unlock()
+	return context.WithValue(ctx, spanKey, span), span
+}
+
+// SetAttributes adds attributes to the span
+func (o *Span) SetAttributes(attributes ...Attribute) {
+	if o == nil {
+		return
+	}
+	o.tracer.lock.Lock()
+	defer o.tracer.lock.Unlock()
+	o.attributes = append(o.attributes, attributes...)
+}
+
+// End ends the span. If err points to a non-nil error, the span is marked as failed.
+// It is typically called with a deferred call, passing the address of the named error returned by the function.
+func (o *Span) End(err *error) {
+	if o == nil {
+		return
+	}
+	o.tracer.lock.Lock()
+	defer o.tracer.lock.Unlock()
+	if !o.end.IsZero() {
+		return
+	}
+	o.end = time.Now()
+	if err != nil && *err != nil {
+		o.err = (*err).Error()
+	}
+}
+
+// Finish ends the spans not ended yet, marking them as unfinished, and returns all the spans of the trace
+func (o *Tracer) Finish() []*Span {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	now := time.Now()
+	for _, span := range o.spans {
+		if span.end.IsZero() {
+			span.end = now
+			span.unfinished = true
+		}
+	}
+	return append([]*Span(nil), o.spans...)
+}
+
+// Duration returns the duration of the span
+func (o *Span) Duration() time.Duration {
+	return o.end.Sub(o.start)
+}
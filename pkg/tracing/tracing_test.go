@@ -0,0 +1,172 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github\.com/danielpickens/astra/pkg/otlp"
+)
+
+func TestStart(t *testing.T) {
+	tracer := NewTracer()
+	ctx := WithTracer(context.Background(), tracer)
+
+	ctx, root := Start(ctx, "astra dev")
+	_, child := Start(ctx, "sync files", String("astra.container", "runtime"))
+	childErr := errors.New("sync failed")
+	child.End(&childErr)
+	_, unfinished := Start(ctx, "port forwarding")
+	root.End(nil)
+	// ending a span twice must not change it
+	otherErr := errors.New("other error")
+	root.End(&otherErr)
+
+	spans := tracer.Finish()
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+	if root.parentID != ([8]byte{}) {
+		t.Errorf("root span should not have a parent")
+	}
+	if child.parentID != root.id || unfinished.parentID != root.id {
+		t.Errorf("spans should be children of the root span")
+	}
+	if root.err != "" || root.unfinished {
+		t.Errorf("root span should be ended without error, got err=%q unfinished=%v", root.err, root.unfinished)
+	}
+	if child.err != "sync failed" {
+		t.Errorf("expected child span to be failed, got err=%q", child.err)
+	}
+	if !unfinished.unfinished || unfinished.end.IsZero() {
+		t.Errorf("span not ended should be marked as unfinished")
+	}
+}
+
+func TestStart_WithoutTracer(t *testing.T) {
+	ctx := context.Background()
+	newCtx, span := Start(ctx, "astra dev")
+	if span != nil {
+		t.Fatalf("expected a nil span")
+	}
+	if newCtx != ctx {
+		t.Errorf("context should not be modified")
+	}
+	// methods of a nil span are no-ops
+	span.SetAttributes(Bool("key", true))
+	err := errors.New("an error")
+	span.End(&err)
+}
+
+func TestToOTLP(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	traceID := [16]byte{0x01, 0x02}
+	spans := []*Span{
+		{
+			id:         [8]byte{0x0a},
+			name:       "astra deploy",
+			start:      start,
+			end:        start.Add(2 * time.Second),
+			attributes: []Attribute{String("astra.image", "quay.io/user/app"), Int("astra.replicas", 1)},
+		},
+		{
+			id:         [8]byte{0x0b},
+			parentID:   [8]byte{0x0a},
+			name:       "build image",
+			start:      start,
+			end:        start.Add(time.Second),
+			err:        "build failed",
+			unfinished: true,
+		},
+	}
+
+	got := ToOTLP(spans, traceID, "v3.17.0")
+
+	image, replicas, unfinished := "quay.io/user/app", "1", true
+	want := []otlp.Span{
+		{
+			TraceID:           "01020000000000000000000000000000",
+			SpanID:            "0a00000000000000",
+			Name:              "astra deploy",
+			Kind:              otlp.SpanKindInternal,
+			StartTimeUnixNano: "1700000000000000000",
+			EndTimeUnixNano:   "1700000002000000000",
+			Attributes: []otlp.KeyValue{
+				{Key: "astra.image", Value: otlp.AnyValue{StringValue: &image}},
+				{Key: "astra.replicas", Value: otlp.AnyValue{IntValue: &replicas}},
+			},
+		},
+		{
+			TraceID:           "01020000000000000000000000000000",
+			SpanID:            "0b00000000000000",
+			ParentSpanID:      "0a00000000000000",
+			Name:              "build image",
+			Kind:              otlp.SpanKindInternal,
+			StartTimeUnixNano: "1700000000000000000",
+			EndTimeUnixNano:   "1700000001000000000",
+			Attributes: []otlp.KeyValue{
+				{Key: "astra.unfinished", Value: otlp.AnyValue{BoolValue: &unfinished}},
+			},
+			Status: otlp.Status{Code: otlp.StatusCodeError, Message: "build failed"},
+		},
+	}
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("expected a single resource and scope, got %+v", got)
+	}
+	if diff := cmp.Diff(want, got.ResourceSpans[0].ScopeSpans[0].Spans); diff != "" {
+		t.Errorf("ToOTLP() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPrintSummary(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	newSpan := func(id, parentID byte, name string, offset, duration time.Duration, err string) *Span {
+		return &Span{
+			id:       [8]byte{id},
+			parentID: [8]byte{parentID},
+			name:     name,
+			start:    start.Add(offset),
+			end:      start.Add(offset + duration),
+			err:      err,
+		}
+	}
+	spans := []*Span{
+		newSpan(1, 0, "astra dev", 0, 10*time.Second, ""),
+		newSpan(2, 1, "parse devfile", 0, 500*time.Millisecond, ""),
+		newSpan(3, 1, "sync files", time.Second, 2*time.Second, ""),
+		newSpan(4, 1, "sync files", 5*time.Second, 3*time.Second, "sync failed"),
+		newSpan(5, 4, "exec command", 5*time.Second, 5*time.Millisecond, ""),
+	}
+
+	var out bytes.Buffer
+	err := PrintSummary(&out, spans, [16]byte{0xff})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `
+Trace ff000000000000000000000000000000:
+  astra dev                       10s  100.0%  ██████████████████████████████
+    parse devfile               500ms    5.0%  ██
+    sync files (x2) [1 failed]     5s   50.0%  ███████████████
+      exec command                5ms    0.1%  ▏
+`
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("PrintSummary() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPrintSummary_NoSpans(t *testing.T) {
+	var out bytes.Buffer
+	err := PrintSummary(&out, nil, [16]byte{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no output, got %q", out.String())
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	dfutil "github.com/devfile/library/v2/pkg/util"
 
+	"github\.com/danielpickens/astra/pkg/config"
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 
 	gitignore "github.com/sabhiram/go-gitignore"
@@ -96,13 +97,45 @@ func GetIndexFileRelativeToContext() string {
 	return filepath.Join(DotastraDirectory, fileIndexName)
 }
 
-// AddastraFileIndex adds astra-file-index.json to .gitignore
+// AddastraDirectory adds the content of the .astra directory to .gitignore,
+// except the configuration file of the project, which is intended to be committed
 func AddastraDirectory(gitIgnoreFile string) error {
 	return addastraDirectory(gitIgnoreFile, filesystem.DefaultFs{})
 }
 
 func addastraDirectory(gitIgnoreFile string, fs filesystem.Filesystem) error {
-	return addFileToIgnoreFile(gitIgnoreFile, DotastraDirectory, fs)
+	content, err := fs.ReadFile(gitIgnoreFile)
+	if err != nil {
+		return err
+	}
+	entries := []string{
+		"/" + DotastraDirectory + "/*",
+		"!/" + filepath.ToSlash(config.GetProjectConfigurationPath("")),
+	}
+
+	lines := strings.Split(string(content), "\n")
+	changed := false
+	for i := 0; i < len(lines); i++ {
+		switch strings.TrimSpace(lines[i]) {
+		case DotastraDirectory, "/" + DotastraDirectory, DotastraDirectory + "/", "/" + DotastraDirectory + "/":
+			// the whole directory was ignored by previous versions, preventing the configuration file of the project to be committed
+			lines = append(lines[:i], append(append([]string{}, entries...), lines[i+1:]...)...)
+			i += len(entries) - 1
+			changed = true
+		}
+	}
+	if !changed && !gitignore.CompileIgnoreLines(lines...).MatchesPath(GetIndexFileRelativeToContext()) {
+		lines = append(lines, entries...)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	err = fs.WriteFile(gitIgnoreFile, []byte(strings.Join(lines, "\n")), dfutil.ModeReadWriteFile)
+	if err != nil {
+		return fmt.Errorf("failed to add %v to %v file: %w", DotastraDirectory, gitIgnoreFile, err)
+	}
+	return nil
 }
 
 // TouchGitIgnoreFile checks .gitignore file exists or not, if not then creates it.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	gitignore "github.com/sabhiram/go-gitignore"
 
 	"github\.com/danielpickens/astra/pkg/testingutil/filesystem"
 )
@@ -78,48 +79,63 @@ func TestCheckGitIgnoreFile(t *testing.T) {
 }
 
 func TestAddastraDirectory(t *testing.T) {
-
-	// create a fake fs in memory
-	fs := filesystem.NewFakeFs()
-	// create a context directory on fake fs
-	contextDir, err := fs.TempDir(os.TempDir(), "context")
-	if err != nil {
-		t.Error(err)
-	}
-
-	gitignorePath := filepath.Join(contextDir, DotGitIgnoreFile)
-
 	tests := []struct {
-		testName        string
-		create          bool
-		gitIgnoreCreate func(create bool, contextDir string, fs filesystem.Filesystem) error
-		directory       string
-		wantErr         bool
+		testName string
+		content  string
+		want     string
 	}{
 		{
-			testName:        "Test when .astra added to .gitignore",
-			create:          false,
-			gitIgnoreCreate: mockDirectoryInfo,
-			directory:       gitignorePath,
-			wantErr:         false,
+			testName: "Test when .astra added to an empty .gitignore",
+			content:  "",
+			want:     "\n/.astra/*\n!/.astra/config.yaml",
+		},
+		{
+			testName: "Test when .astra added to an existing .gitignore",
+			content:  "node_modules/\n",
+			want:     "node_modules/\n\n/.astra/*\n!/.astra/config.yaml",
+		},
+		{
+			testName: "Test when .astra directory was entirely ignored",
+			content:  "node_modules/\n.astra\ndist/\n",
+			want:     "node_modules/\n/.astra/*\n!/.astra/config.yaml\ndist/\n",
+		},
+		{
+			testName: "Test when .astra content already ignored",
+			content:  "/.astra/*\n!/.astra/config.yaml\n",
+			want:     "/.astra/*\n!/.astra/config.yaml\n",
 		},
 	}
 
 	for _, tt := range tests {
-
-		err := tt.gitIgnoreCreate(tt.create, tt.directory, fs)
-		if err != nil {
-			t.Error(err)
-		}
-
 		t.Run(tt.testName, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			gitignorePath := filepath.Join("/context", DotGitIgnoreFile)
+			err := fs.WriteFile(gitignorePath, []byte(tt.content), 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
 
-			err := addastraDirectory(tt.directory, fs)
+			err = addastraDirectory(gitignorePath, fs)
+			if err != nil {
+				t.Errorf("addastraDirectory unexpected error %v", err)
+			}
 
-			if !tt.wantErr == (err != nil) {
-				t.Errorf("addastraFileIndex unexpected error %v, wantErr %v", err, tt.wantErr)
+			got, err := fs.ReadFile(gitignorePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected .gitignore content %q, got %q", tt.want, string(got))
 			}
 
+			rules := strings.Split(string(got), "\n")
+			matcher := gitignore.CompileIgnoreLines(rules...)
+			if !matcher.MatchesPath(GetIndexFileRelativeToContext()) {
+				t.Errorf("expected %q to be ignored", GetIndexFileRelativeToContext())
+			}
+			if matcher.MatchesPath(".astra/config.yaml") {
+				t.Errorf("expected .astra/config.yaml not to be ignored")
+			}
 		})
 	}
 }
@@ -13,14 +13,26 @@ import (
 // For a KEY entry, the value will be obtained from the environment, and if the value is not defined in the environment, the entry KEY will be ignored
 // An empty filename will skip the extraction of pairs from file
 func GetVariables(fs filesystem.Filesystem, filename string, override []string, lookupEnv func(string) (string, bool)) (map[string]string, error) {
+	var filenames []string
+	if len(filename) > 0 {
+		filenames = []string{filename}
+	}
+	return GetVariablesFromFiles(fs, filenames, override, lookupEnv)
+}
+
+// GetVariablesFromFiles returns a map of key/value from pairs defined in the files and in the list of strings, as GetVariables does.
+// The pairs defined in a file override the ones defined in the previous files
+func GetVariablesFromFiles(fs filesystem.Filesystem, filenames []string, override []string, lookupEnv func(string) (string, bool)) (map[string]string, error) {
 
 	result := map[string]string{}
-	var err error
-	if len(filename) > 0 {
-		result, err = parseKeyValueFile(fs, filename, lookupEnv)
+	for _, filename := range filenames {
+		fileVars, err := parseKeyValueFile(fs, filename, lookupEnv)
 		if err != nil {
 			return nil, err
 		}
+		for k, v := range fileVars {
+			result[k] = v
+		}
 	}
 	overrideVars, err := parseKeyValueStrings(override, lookupEnv)
 	if err != nil {
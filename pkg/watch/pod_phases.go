@@ -16,7 +16,9 @@ func NewPodPhases() PodPhases {
 	return map[metav1.Time]corev1.PodPhase{}
 }
 
-func (o *PodPhases) Add(out io.Writer, k metav1.Time, pod *corev1.Pod) {
+// Add sets the phase of the pod created at k, and displays the phases of the pods if the phase has changed.
+// It returns the phase of the pod, and true if the phase has changed
+func (o *PodPhases) Add(out io.Writer, k metav1.Time, pod *corev1.Pod) (corev1.PodPhase, bool) {
 	v := pod.Status.Phase
 	if pod.GetDeletionTimestamp() != nil {
 		v = "Terminating"
@@ -29,14 +31,19 @@ func (o *PodPhases) Add(out io.Writer, k metav1.Time, pod *corev1.Pod) {
 	if display {
 		o.Display(out)
 	}
+	return v, display
 }
 
-func (o *PodPhases) Delete(out io.Writer, pod *corev1.Pod) {
+// Delete removes the pod, and displays the phases of the remaining pods.
+// It returns true if the pod was known
+func (o *PodPhases) Delete(out io.Writer, pod *corev1.Pod) bool {
 	k := pod.GetCreationTimestamp()
 	if _, ok := (*o)[k]; ok {
 		delete(*o, k)
 		o.Display(out)
+		return true
 	}
+	return false
 }
 
 func (o PodPhases) Display(out io.Writer) {
@@ -12,6 +12,7 @@ import (
 
 	"github\.com/danielpickens/astra/pkg/dev"
 	"github\.com/danielpickens/astra/pkg/dev/common"
+	astraevents "github\.com/danielpickens/astra/pkg/events"
 	"github\.com/danielpickens/astra/pkg/informer"
 
 	"github\.com/danielpickens/astra/pkg/kclient"
@@ -34,11 +35,15 @@ import (
 const (
 	// PushErrorString is the string that is printed when an error occurs during watch's Push operation
 	PushErrorString = "Error occurred on Push"
+
+	// heartbeatInterval is the interval at which the Deployment of the component is annotated to indicate that the session is still alive
+	heartbeatInterval = 5 * time.Minute
 )
 
 type WatchClient struct {
 	kubeClient     kclient.ClientInterface
 	informerClient *informer.InformerClient
+	eventsClient   *astraevents.EventsClient
 
 	sourcesWatcher    *fsnotify.Watcher
 	deploymentWatcher watch.Interface
@@ -50,6 +55,8 @@ type WatchClient struct {
 	// true to force sync, used when manual sync
 	forceSync bool
 
+	// deploymentName is the name of the latest observed Deployment
+	deploymentName string
 	// deploymentGeneration indicates the generation of the latest observed Deployment
 	deploymentGeneration int64
 	readyReplicas        int32
@@ -60,10 +67,12 @@ var _ Client = (*WatchClient)(nil)
 func NewWatchClient(
 	kubeClient kclient.ClientInterface,
 	informerClient *informer.InformerClient,
+	eventsClient *astraevents.EventsClient,
 ) *WatchClient {
 	return &WatchClient{
 		kubeClient:     kubeClient,
 		informerClient: informerClient,
+		eventsClient:   eventsClient,
 	}
 }
 
@@ -214,6 +223,14 @@ func (o *WatchClient) eventWatcher(
 	deployTimer := time.NewTimer(time.Millisecond)
 	<-deployTimer.C
 
+	// heartbeat regularly indicates on the Deployment that the session is still alive
+	var heartbeat <-chan time.Time
+	if parameters.WatchCluster {
+		heartbeatTicker := time.NewTicker(heartbeatInterval)
+		defer heartbeatTicker.Stop()
+		heartbeat = heartbeatTicker.C
+	}
+
 	podsPhases := NewPodPhases()
 
 	for {
@@ -242,11 +259,22 @@ func (o *WatchClient) eventWatcher(
 
 			componentStatus.SetState(StateSyncOutdated)
 			fmt.Fprintf(out, "Pushing files...\n\n")
+			syncData := astraevents.SyncData{
+				ChangedFiles: len(changedFiles),
+				DeletedFiles: len(deletedPaths),
+				Forced:       o.forceSync,
+			}
+			o.eventsClient.Publish(astraevents.SyncStarted, syncData)
+			syncStart := time.Now()
 			err := processEventsHandler(ctx, parameters, changedFiles, deletedPaths, &componentStatus)
 			o.forceSync = false
 			if err != nil {
 				return err
 			}
+			if componentStatus.GetState() == StateReady {
+				syncData.DurationMs = time.Since(syncStart).Milliseconds()
+				o.eventsClient.Publish(astraevents.SyncCompleted, syncData)
+			}
 			// empty the events to receive new events
 			if componentStatus.GetState() == StateReady {
 				events = []fsnotify.Event{} // empty the events slice to capture new events
@@ -270,6 +298,7 @@ func (o *WatchClient) eventWatcher(
 			case *appsv1.Deployment:
 				klog.V(4).Infof("deployment watcher Event: Type: %s, name: %s, rv: %s, generation: %d, pods: %d\n",
 					ev.Type, obj.GetName(), obj.GetResourceVersion(), obj.GetGeneration(), obj.Status.ReadyReplicas)
+				o.deploymentName = obj.GetName()
 				if obj.GetGeneration() > o.deploymentGeneration || obj.Status.ReadyReplicas != o.readyReplicas {
 					o.deploymentGeneration = obj.GetGeneration()
 					o.readyReplicas = obj.Status.ReadyReplicas
@@ -286,6 +315,17 @@ func (o *WatchClient) eventWatcher(
 				return err
 			}
 
+		case <-heartbeat:
+			if o.deploymentName == "" {
+				break
+			}
+			annotations := map[string]string{}
+			labels.SetHeartbeat(annotations, time.Now())
+			err := o.kubeClient.PatchDeploymentAnnotations(o.deploymentName, annotations)
+			if err != nil {
+				klog.V(4).Infof("unable to update the heartbeat of the Deployment %q: %v", o.deploymentName, err)
+			}
+
 		case <-o.devfileWatcher.Events:
 			devfileTimer.Reset(100 * time.Millisecond)
 
@@ -303,13 +343,23 @@ func (o *WatchClient) eventWatcher(
 				if !ok {
 					return errors.New("unable to decode watch event")
 				}
-				podsPhases.Delete(out, pod)
+				if podsPhases.Delete(out, pod) {
+					o.eventsClient.Publish(astraevents.PodPhaseChanged, astraevents.PodPhaseData{
+						Pod:   pod.GetName(),
+						Phase: "Deleted",
+					})
+				}
 			case watch.Added, watch.Modified:
 				pod, ok := ev.Object.(*corev1.Pod)
 				if !ok {
 					return errors.New("unable to decode watch event")
 				}
-				podsPhases.Add(out, pod.GetCreationTimestamp(), pod)
+				if phase, changed := podsPhases.Add(out, pod.GetCreationTimestamp(), pod); changed {
+					o.eventsClient.Publish(astraevents.PodPhaseChanged, astraevents.PodPhaseData{
+						Pod:   pod.GetName(),
+						Phase: string(phase),
+					})
+				}
 			}
 
 		case ev := <-o.warningsWatcher.ResultChan():
@@ -323,6 +373,11 @@ func (o *WatchClient) eventWatcher(
 				}
 				if matching {
 					log.Fwarning(out, kevent.Message)
+					o.eventsClient.Publish(astraevents.Warning, astraevents.WarningData{
+						Reason:  kevent.Reason,
+						Message: kevent.Message,
+						Object:  kevent.InvolvedObject.Kind + "/" + podName,
+					})
 				}
 			}
 
@@ -430,7 +485,9 @@ func (o *WatchClient) processEvents(
 	}
 	oldStatus := *componentStatus
 	err := parameters.DevfileWatchHandler(ctx, pushParams, componentStatus)
+	o.publishStatusChanges(oldStatus, *componentStatus)
 	if err != nil {
+		o.eventsClient.PublishError(err)
 		if isFatal(err) {
 			return err
 		}
@@ -460,6 +517,15 @@ func (o *WatchClient) processEvents(
 	return nil
 }
 
+// publishStatusChanges publishes the change of state between two status of the component
+func (o *WatchClient) publishStatusChanges(oldStatus, newStatus ComponentStatus) {
+	if oldStatus.GetState() != newStatus.GetState() {
+		o.eventsClient.Publish(astraevents.StateChanged, astraevents.StateChangedData{
+			State: string(newStatus.GetState()),
+		})
+	}
+}
+
 func shouldIgnoreEvent(event fsnotify.Event) (ignoreEvent bool) {
 	if !(event.Op&fsnotify.Remove == fsnotify.Remove || event.Op&fsnotify.Rename == fsnotify.Rename) {
 		stat, err := os.Lstat(event.Name)
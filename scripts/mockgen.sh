@@ -103,3 +103,7 @@ $mockgen -source=pkg/configAutomount/interface.go \
 $mockgen -source=pkg/platform/interface.go \
     -package platform \
     -destination pkg/platform/mock.go
+
+$mockgen -source=pkg/devsessions/interface.go \
+    -package devsessions \
+    -destination pkg/devsessions/mock.go
@@ -1,7 +1,9 @@
 package helper
 
 import (
+	"bytes"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
 	"time"
@@ -118,6 +120,8 @@ type DevSession struct {
 	ErrOut            string
 	Endpoints         map[string]string
 	APIServerEndpoint string
+	// APIServerToken is the token required by the API server for requests modifying the state of the session
+	APIServerToken string
 }
 
 type DevSessionOpts struct {
@@ -210,6 +214,7 @@ func StartDevMode(options DevSessionOpts) (devSession DevSession, err error) {
 	result.Endpoints = getPorts(string(outContents), options.CustomAddress)
 	if options.StartAPIServer {
 		result.APIServerEndpoint = getAPIServerPort(string(outContents))
+		result.APIServerToken = getAPIServerToken(string(outContents))
 	}
 	return result, nil
 
@@ -406,3 +411,19 @@ func getAPIServerPort(s string) string {
 	matches := re.FindStringSubmatch(s)
 	return matches[1]
 }
+
+// getAPIServerToken returns the token required by the api server
+func getAPIServerToken(s string) string {
+	re := regexp.MustCompile(`API token \(required to modify the state of the session\): ([0-9a-f]+)`)
+	matches := re.FindStringSubmatch(s)
+	return matches[1]
+}
+
+// NewAPIServerRequest returns a request to the api server of the session, authenticated with the token of the session
+func (o DevSession) NewAPIServerRequest(method string, path string, body []byte) *http.Request {
+	req, err := http.NewRequest(method, fmt.Sprintf("http://%s%s", o.APIServerEndpoint, path), bytes.NewBuffer(body))
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+o.APIServerToken)
+	return req
+}
@@ -212,8 +212,8 @@ var _ = Describe("astra dev command with api server tests", Label(helper.LabelSk
 
 				When("/component/command endpoint is POSTed", func() {
 					BeforeEach(func() {
-						url := fmt.Sprintf("http://%s/component/command", devSession.APIServerEndpoint)
-						resp, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(`{"name": "push"}`)))
+						req := devSession.NewAPIServerRequest(http.MethodPost, "/component/command", []byte(`{"name": "push"}`))
+						resp, err := http.DefaultClient.Do(req)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(resp.StatusCode).To(BeEquivalentTo(http.StatusOK))
 					})
@@ -224,14 +224,20 @@ var _ = Describe("astra dev command with api server tests", Label(helper.LabelSk
 					})
 				})
 
+				When("/component/command endpoint is POSTed without token", func() {
+					It("should be rejected", func() {
+						url := fmt.Sprintf("http://%s/component/command", devSession.APIServerEndpoint)
+						resp, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(`{"name": "push"}`)))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(resp.StatusCode).To(BeEquivalentTo(http.StatusUnauthorized))
+					})
+				})
+
 				When("/instance endpoint is DELETEd", func() {
 
 					BeforeEach(func() {
-						url := fmt.Sprintf("http://%s/instance", devSession.APIServerEndpoint)
-						req, err := http.NewRequest(http.MethodDelete, url, bytes.NewBuffer([]byte{}))
-						Expect(err).ToNot(HaveOccurred())
-						client := &http.Client{}
-						resp, err := client.Do(req)
+						req := devSession.NewAPIServerRequest(http.MethodDelete, "/instance", nil)
+						resp, err := http.DefaultClient.Do(req)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(resp.StatusCode).To(BeEquivalentTo(http.StatusOK))
 					})
@@ -273,8 +279,8 @@ var _ = Describe("astra dev command with api server tests", Label(helper.LabelSk
 					When("/component/command endpoint is POSTed", func() {
 
 						BeforeEach(func() {
-							url := fmt.Sprintf("http://%s/component/command", devSession.APIServerEndpoint)
-							resp, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(`{"name": "push"}`)))
+							req := devSession.NewAPIServerRequest(http.MethodPost, "/component/command", []byte(`{"name": "push"}`))
+							resp, err := http.DefaultClient.Do(req)
 							Expect(err).ToNot(HaveOccurred())
 							Expect(resp.StatusCode).To(BeEquivalentTo(http.StatusOK))
 						})